@@ -3,28 +3,36 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
 	"time"
 
 	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/namnv2496/crawler/internal/controller"
 	"github.com/namnv2496/crawler/internal/entity"
 	"github.com/namnv2496/crawler/internal/pkg/logging"
 	"github.com/namnv2496/crawler/internal/repository"
+	"github.com/namnv2496/crawler/internal/repository/idempotency"
 	"github.com/namnv2496/crawler/internal/repository/schedulerservice"
 	"github.com/namnv2496/crawler/internal/service"
 	"github.com/namnv2496/crawler/internal/service/mq"
-	"github.com/segmentio/kafka-go"
+	schedulerv1 "github.com/namnv2496/crawler/pkg/generated/pkg/proto"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 )
 
 var CrawlerWorkerCmd = &cobra.Command{
 	Use:   "crawler-worker",
 	Short: "A simple web crawler worker",
 	Run: func(cmd *cobra.Command, args []string) {
+		// Run serves until SIGINT or SIGTERM, then stops the lifecycle hooks
 		InvokeCrawlerWorker(
 			startCrawlerWorker,
 			// startTest,
-		)
+		).Run()
 	},
 }
 
@@ -32,18 +40,23 @@ func InvokeCrawlerWorker(invokers ...any) *fx.App {
 	config := configs.LoadConfig()
 	app := fx.New(
 		fx.StartTimeout(time.Second*10),
-		fx.StopTimeout(time.Second*10),
+		// the crawls in flight are drained on stop
+		fx.StopTimeout(config.KafkaConsumerConfig.DrainTimeout+time.Second*5),
 		fx.Provide(
 			fx.Annotate(mq.NewKafkaConsumer, fx.As(new(mq.IConsumer))),
+			fx.Annotate(mq.NewDeadLetterProducer, fx.As(new(mq.IDeadLetterProducer))),
+			fx.Annotate(service.NewQueueDispatcher, fx.As(new(service.IQueueDispatcher))),
 			// fx.Annotate(mq.NewKafkaProducer, fx.As(new(mq.IProducer))), // for public result event after
 			fx.Annotate(service.NewCrawlerService, fx.As(new(service.ICrawlerService))),
 			fx.Annotate(service.NewTeleService, fx.As(new(service.ITeleService))),
 			fx.Annotate(repository.NewDatabase, fx.As(new(repository.IDatabase))),
 			fx.Annotate(repository.NewResultRepository, fx.As(new(repository.IResultRepository))),
 			fx.Annotate(service.NewWorkerPool, fx.As(new(service.IWorkerPool))),
+			fx.Annotate(idempotency.NewIdempotency, fx.As(new(idempotency.IIdempotency))),
 
 			fx.Annotate(mq.NewAsynqProducer, fx.As(new(mq.IAsynqProducer))),
 			fx.Annotate(schedulerservice.NewSchedulerService, fx.As(new(schedulerservice.ISchedulerService))),
+			fx.Annotate(controller.NewCrawlerInternalController, fx.As(new(schedulerv1.CrawlerInternalServiceServer))),
 		),
 		fx.Supply(
 			config,
@@ -56,49 +69,87 @@ func InvokeCrawlerWorker(invokers ...any) *fx.App {
 func startCrawlerWorker(
 	lc fx.Lifecycle,
 	config *configs.Config,
-	consumer mq.IConsumer,
-	crawlerService service.ICrawlerService,
-) {
-	startConsumer(consumer, crawlerService)
-	select {}
+	dispatcher service.IQueueDispatcher,
+	internalController schedulerv1.CrawlerInternalServiceServer,
+) error {
+	if err := startInternalServer(lc, config, internalController); err != nil {
+		return err
+	}
+	if err := startStatsServer(lc, config, dispatcher); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(stopped)
+				dispatcher.Run(ctx)
+			}()
+			return nil
+		},
+		// the fetches stop, the crawls in flight finish and their messages are committed
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-stopped:
+				return nil
+			case <-stopCtx.Done():
+				return fmt.Errorf("crawls in flight are not drained: %w", stopCtx.Err())
+			}
+		},
+	})
+	return nil
 }
 
-func startConsumer(
-	consumer mq.IConsumer,
-	crawlerService service.ICrawlerService,
-) {
-	for _, consumer := range consumer.GetConsumer() {
-		go func(consumer *kafka.Reader) {
-			ctx := logging.InjectTraceId(context.Background())
-			logging.ResetPrefix(ctx, "startConsumer")
-			rateLimiter := time.Tick(time.Second / 10) // 10 requests per second
-			defer consumer.Close()
-			for {
-				select {
-				case <-rateLimiter:
-					var err error
-					var m kafka.Message
-					m, err = consumer.ReadMessage(ctx)
-					defer consumer.CommitMessages(ctx, m)
-					if err != nil {
-						return
-					}
-					var url entity.CrawlerEvent
-					if err := json.Unmarshal(m.Value, &url); err != nil {
-						return
-					}
-					if err := crawlerService.Crawl(ctx, url); err != nil {
-						logging.Error(ctx, err.Error())
-						return
-					}
-					logging.Debug(ctx, "message at topic:%v partition:%v offset:%v\t%s = %s\n", m.Topic, m.Partition, m.Offset, string(m.Key), string(m.Value))
+// startInternalServer serves the internal RPCs of the scheduler on gRPC only
+func startInternalServer(
+	lc fx.Lifecycle,
+	config *configs.Config,
+	internalController schedulerv1.CrawlerInternalServiceServer,
+) error {
+	listener, err := net.Listen("tcp", config.AppConfig.GRPCPort)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", config.AppConfig.GRPCPort, err)
+	}
+	server := grpc.NewServer()
+	schedulerv1.RegisterCrawlerInternalServiceServer(server, internalController)
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Printf("gRPC server stopped: %v", err)
+		}
+	}()
+	lc.Append(fx.StopHook(server.GracefulStop))
+	fmt.Printf("gRPC server is running on %s\n", config.AppConfig.GRPCPort)
+	return nil
+}
 
-				case <-ctx.Done():
-					return
-				}
-			}
-		}(consumer)
+// startStatsServer serves the stats of the queues as JSON on GET /queues
+func startStatsServer(
+	lc fx.Lifecycle,
+	config *configs.Config,
+	dispatcher service.IQueueDispatcher,
+) error {
+	listener, err := net.Listen("tcp", config.AppConfig.HTTPPort)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", config.AppConfig.HTTPPort, err)
 	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /queues", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(dispatcher.Stats()); err != nil {
+			log.Printf("failed to write queue stats: %v", err)
+		}
+	})
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Printf("HTTP server stopped: %v", err)
+		}
+	}()
+	lc.Append(fx.StopHook(server.Shutdown))
+	fmt.Printf("HTTP server is running on %s\n", config.AppConfig.HTTPPort)
+	return nil
 }
 
 func startTest(
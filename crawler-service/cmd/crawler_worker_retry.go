@@ -6,6 +6,8 @@ import (
 
 	"github.com/namnv2496/crawler/internal/configs"
 	"github.com/namnv2496/crawler/internal/repository"
+	"github.com/namnv2496/crawler/internal/repository/idempotency"
+	"github.com/namnv2496/crawler/internal/repository/schedulerservice"
 	"github.com/namnv2496/crawler/internal/service"
 	"github.com/namnv2496/crawler/internal/service/mq"
 	"github.com/spf13/cobra"
@@ -33,7 +35,10 @@ func InvokeCrawlerWorkerRetry(invokers ...any) *fx.App {
 			fx.Annotate(repository.NewDatabase, fx.As(new(repository.IDatabase))),
 			fx.Annotate(repository.NewResultRepository, fx.As(new(repository.IResultRepository))),
 			fx.Annotate(service.NewWorkerPool, fx.As(new(service.IWorkerPool))),
+			fx.Annotate(idempotency.NewIdempotency, fx.As(new(idempotency.IIdempotency))),
 			fx.Annotate(mq.NewAsynqProducer, fx.As(new(mq.IAsynqProducer))),
+			fx.Annotate(mq.NewDeadLetterProducer, fx.As(new(mq.IDeadLetterProducer))),
+			fx.Annotate(schedulerservice.NewSchedulerService, fx.As(new(schedulerservice.ISchedulerService))),
 
 			fx.Annotate(mq.NewAsynqConsumer, fx.As(new(mq.IAsynqConsumer))),
 			fx.Annotate(service.NewRetryWorker, fx.As(new(service.IRetryWorker))),
@@ -7,10 +7,17 @@ import (
 )
 
 type AppConfig struct {
-	GRPCPort string   `env:"grpc_port" envDefault:":9090"`
-	HTTPPort string   `env:"http_port" envDefault:":8080"`
+	// GRPCPort serves the internal RPCs of the scheduler, the scheduler itself listens on :9090
+	GRPCPort string `env:"grpc_port" envDefault:":9091"`
+	// HTTPPort serves the stats of the queues, the scheduler gateway listens on :8080
+	HTTPPort string   `env:"http_port" envDefault:":8081"`
 	Domains  []string `env:"domains" envDefault:"phone_cellphones,phone_thegioididong"` // gold,diamond
 	Workers  int      `env:"workers" envDefault:"100"`
+	// RespectRobots checks the robots.txt of the target before a crawl, a disallowed target fails without a retry
+	RespectRobots bool `env:"respect_robots" envDefault:"false"`
+	// AllowPrivateIPs lets the crawls connect to the private, loopback and link-local addresses, it
+	// matches allow_private_ips of the targets of the validation rules of the scheduler
+	AllowPrivateIPs bool `env:"allow_private_ips" envDefault:"false"`
 }
 type KafkaProducerConfig struct {
 	Brokers string   `env:"producer_broker" envDefault:"localhost:29092"`
@@ -21,6 +28,27 @@ type KafkaConsumerConfig struct {
 	Brokers []string `env:"consumer_broker" envDefault:"localhost:29092"`
 	Topic   []string `env:"consumer_topic" envDefault:"normal,priority"`
 	GroupID string   `env:"consumer_group_id" envDefault:"crawler-local"`
+	// Queues are "<topic>=<weight>/<concurrency>" entries of the topics. The workers are shared: the
+	// backlogged topics get the free workers in proportion to their weight, up to their concurrency.
+	// They are used until the queues are loaded from the scheduler, which is the source of the queues.
+	Queues []string `env:"consumer_queues" envDefault:"normal=1/5,priority=4/10"`
+	// QueueRefreshInterval is how often the queues are reloaded from the scheduler
+	QueueRefreshInterval time.Duration `env:"consumer_queue_refresh_interval" envDefault:"30s"`
+	// Workers is the number of crawls running at once, all the topics together
+	Workers int `env:"consumer_workers" envDefault:"10"`
+	// PartitionConcurrency is the number of messages of a partition crawled at once, the offset of
+	// the partition is committed up to the oldest message not done
+	PartitionConcurrency int `env:"consumer_partition_concurrency" envDefault:"5"`
+	// HeldMessages is the number of messages of a queue held while their partition runs as many messages
+	// as its concurrency, the other partitions are fetched meanwhile. The fetch pauses when it is reached.
+	HeldMessages int `env:"consumer_held_messages" envDefault:"100"`
+	// CommitInterval batches the commits of the offsets, 0 commits every message synchronously
+	CommitInterval time.Duration `env:"consumer_commit_interval" envDefault:"1s"`
+	// DrainTimeout is how long SIGTERM waits for the crawls in flight to finish and commit
+	DrainTimeout time.Duration `env:"consumer_drain_timeout" envDefault:"30s"`
+	// DeadLetterTopic receives the poison messages, which cannot be read or crash the crawl, and the
+	// events still failing after their retries. The scheduler stores them, they are dropped when it is empty.
+	DeadLetterTopic string `env:"consumer_dead_letter_topic" envDefault:"dead-letters"`
 }
 
 type DatabaseConfig struct {
@@ -34,7 +62,9 @@ type DatabaseConfig struct {
 }
 
 type SchedulerService struct {
-	Host    string        `env:"db_host" envDefault:"localhost:8080`
+	GRPCHost string `env:"scheduler_service_grpc_host" envDefault:"localhost:9090"`
+	// APIKey must match internal_api_key of the scheduler, it authenticates the calls of both sides
+	APIKey  string        `env:"scheduler_api_key" envDefault:""`
 	Timeout time.Duration `env:"timeout" envDefault:"5s"`
 }
 
@@ -44,6 +74,23 @@ type Redis struct {
 	DB       int    `env:"redis_db" envDefault:"0"`
 }
 
+type Idempotency struct {
+	TTL time.Duration `env:"idempotency_ttl" envDefault:"24h"`
+}
+
+// Retry is the retry policy of the events which do not set one, or the fields they leave at 0
+type Retry struct {
+	// MaxAttempts counts the first crawl, 1 never retries
+	MaxAttempts  int           `env:"retry_max_attempts" envDefault:"4"`
+	InitialDelay time.Duration `env:"retry_initial_delay" envDefault:"1m"`
+	Multiplier   float64       `env:"retry_multiplier" envDefault:"2"`
+	MaxDelay     time.Duration `env:"retry_max_delay" envDefault:"30m"`
+	// Jitter is the share of the delay added or removed at random
+	Jitter float64 `env:"retry_jitter" envDefault:"0.2"`
+	// RetryOn are the error classes which are retried
+	RetryOn []string `env:"retry_on" envDefault:"timeout,connection,server_error,rate_limited,unknown"`
+}
+
 type Telegram struct {
 	Enable      bool   `env:"telegram_enable" envDefault:"false"`
 	APIKey      string `env:"telegram_api_key" envDefault:""`
@@ -59,6 +106,8 @@ type Config struct {
 	Telegram            Telegram
 	Redis               Redis
 	SchedulerService    SchedulerService
+	Idempotency         Idempotency
+	Retry               Retry
 }
 
 func LoadConfig() *Config {
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/namnv2496/crawler/internal/entity"
+	"github.com/namnv2496/crawler/internal/pkg/logging"
+	"github.com/namnv2496/crawler/internal/service"
+	schedulerv1 "github.com/namnv2496/crawler/pkg/generated/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// CrawlerInternalController serves the RPCs of the scheduler, they need the internal API key
+type CrawlerInternalController struct {
+	schedulerv1.UnimplementedCrawlerInternalServiceServer
+	conf           *configs.Config
+	crawlerService service.ICrawlerService
+}
+
+func NewCrawlerInternalController(
+	conf *configs.Config,
+	crawlerService service.ICrawlerService,
+) schedulerv1.CrawlerInternalServiceServer {
+	return &CrawlerInternalController{
+		conf:           conf,
+		crawlerService: crawlerService,
+	}
+}
+
+func (_self *CrawlerInternalController) PreviewCrawl(
+	ctx context.Context,
+	req *schedulerv1.CrawlPreviewRequest,
+) (*schedulerv1.CrawlPreview, error) {
+	ctx = logging.InjectTraceId(ctx)
+	logging.ResetPrefix(ctx, "PreviewCrawl")
+	if err := checkInternal(ctx, _self.conf.SchedulerService.APIKey); err != nil {
+		return nil, err
+	}
+	if req.Url == "" || req.Method == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url and method are required")
+	}
+	preview := _self.crawlerService.Preview(ctx, entity.CrawlerEvent{
+		Url:      req.Url,
+		Method:   req.Method,
+		Queue:    req.Queue,
+		Domain:   req.Domain,
+		IsActive: true,
+	})
+	return &schedulerv1.CrawlPreview{
+		StatusCode:   int32(preview.StatusCode),
+		FetchMs:      preview.FetchDuration.Milliseconds(),
+		ExtractMs:    preview.ExtractDuration.Milliseconds(),
+		DurationMs:   preview.Duration.Milliseconds(),
+		Record:       preview.Record,
+		Notification: preview.Notification,
+		Violations:   preview.Violations,
+		Error:        preview.Error,
+	}, nil
+}
+
+// checkInternal accepts the calls with the API key shared with the scheduler, none without a key
+func checkInternal(ctx context.Context, apiKey string) error {
+	if apiKey == "" {
+		return status.Errorf(codes.PermissionDenied, "internal RPCs are disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		bearer, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(bearer), []byte(apiKey)) == 1 {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "internal token is required")
+}
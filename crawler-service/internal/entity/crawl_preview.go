@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// CrawlPreview is one run of the fetch and the extraction of an event, nothing is stored, notified or retried
+type CrawlPreview struct {
+	// StatusCode of the response, 0 when no response was received
+	StatusCode      int
+	FetchDuration   time.Duration
+	ExtractDuration time.Duration
+	Duration        time.Duration
+	// Record is what the extraction found, for CURL the result which would be stored
+	Record map[string]string
+	// Notification is the message which would be sent to Telegram
+	Notification string
+	// Violations are the robots.txt and scope rules broken by the event
+	Violations []string
+	Error      string
+}
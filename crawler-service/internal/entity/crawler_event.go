@@ -20,9 +20,28 @@ type CrawlerEvent struct {
 	Quantity    int64  `json:"quantity"`
 	Domain      string `json:"domain"`
 	IsActive    bool   `json:"is_active"`
-	Retrytime   int64
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	SchedulerAt int64  `json:"scheduler_at"`
+	// RunId identifies the scheduled slot, duplicate deliveries of the same run are skipped
+	RunId     string `json:"run_id"`
+	Retrytime int64
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// RetryPolicy of the failed crawls, nil takes the defaults of the worker
+	RetryPolicy *RetryPolicy `json:"retry_policy"`
+}
+
+// DedupKey is unique per run and retry attempt, so a redelivered message is a no-op
+// while a scheduled retry still executes
+func (_self CrawlerEvent) DedupKey() string {
+	runId := _self.RunId
+	if runId == "" {
+		if _self.SchedulerAt == 0 {
+			return ""
+		}
+		runId = fmt.Sprintf("%d-%d", _self.Id, _self.SchedulerAt)
+	}
+	return fmt.Sprintf("%s.%d", runId, _self.Retrytime)
 }
 
 func (_self CrawlerEvent) HashKey(key any) string {
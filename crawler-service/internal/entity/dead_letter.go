@@ -0,0 +1,14 @@
+package entity
+
+// DeadLetter is a message given up by the worker, the scheduler stores it for inspection and replay.
+// Topic, Partition and Offset locate the message of the queue, they are empty for the failed retries.
+type DeadLetter struct {
+	Queue     string
+	Topic     string
+	Partition int
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Error     string
+	Attempts  int64
+}
@@ -0,0 +1,28 @@
+package entity
+
+// Queue is a consumed topic, its weight shares the workers with the other queues,
+// its concurrency caps the crawls of the queue running at once and its rate limit
+// is the number of messages read from the topic per second
+type Queue struct {
+	Name        string `json:"name"`
+	Topic       string `json:"topic"`
+	Weight      int    `json:"weight"`
+	Concurrency int    `json:"concurrency"`
+	RateLimit   int    `json:"rate_limit"`
+}
+
+// QueueStats shows how far the consumption of a queue is behind
+type QueueStats struct {
+	Queue
+	// Lag is the number of messages not processed yet: behind in kafka, fetched and waiting for a worker
+	Lag int64 `json:"lag"`
+	// Waiting are the fetched messages waiting for a worker
+	Waiting int `json:"waiting"`
+	// Held are the fetched messages waiting for a slot of their partition
+	Held      int   `json:"held"`
+	InFlight  int   `json:"in_flight"`
+	Processed int64 `json:"processed"`
+	// Pending are the fetched messages not committed yet, the crawled ones wait for an older message
+	// of their partition
+	Pending int `json:"pending"`
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// error classes of a failed crawl, the retry policy of the event names the ones which are retried
+const (
+	ErrorClassTimeout          = "timeout"
+	ErrorClassConnection       = "connection"
+	ErrorClassServerError      = "server_error"
+	ErrorClassRateLimited      = "rate_limited"
+	ErrorClassNotFound         = "not_found"
+	ErrorClassClientError      = "client_error"
+	ErrorClassRobotsDisallowed = "robots_disallowed"
+	ErrorClassInvalid          = "invalid"
+	ErrorClassUnknown          = "unknown"
+)
+
+// CrawlError is a failed crawl with the class of its error
+type CrawlError struct {
+	Class string
+	Err   error
+}
+
+func NewCrawlError(class string, err error) error {
+	return &CrawlError{Class: class, Err: err}
+}
+
+func (_self *CrawlError) Error() string {
+	return _self.Err.Error()
+}
+
+func (_self *CrawlError) Unwrap() error {
+	return _self.Err
+}
+
+// ErrorClassOf is the class of the first CrawlError of the chain, unknown when there is none
+func ErrorClassOf(err error) string {
+	var crawlErr *CrawlError
+	if errors.As(err, &crawlErr) {
+		return crawlErr.Class
+	}
+	return ErrorClassUnknown
+}
+
+// RetryPolicy is set by the scheduler on the event, the fields left at 0 and a nil Jitter take the
+// defaults of the worker. The n-th retry waits InitialDelayMs * Multiplier^(n-1), moved by up to Jitter
+// of itself and capped by MaxDelayMs. Only the error classes of RetryOn are retried.
+type RetryPolicy struct {
+	// MaxAttempts counts the first crawl, 1 never retries
+	MaxAttempts    int32    `json:"max_attempts,omitempty"`
+	InitialDelayMs int64    `json:"initial_delay_ms,omitempty"`
+	Multiplier     float64  `json:"multiplier,omitempty"`
+	MaxDelayMs     int64    `json:"max_delay_ms,omitempty"`
+	Jitter         *float64 `json:"jitter,omitempty"`
+	RetryOn        []string `json:"retry_on,omitempty"`
+}
+
+// WithDefaults fills the fields left at 0 from defaults, a nil policy is the defaults
+func (_self *RetryPolicy) WithDefaults(defaults RetryPolicy) RetryPolicy {
+	if _self == nil {
+		return defaults
+	}
+	policy := *_self
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+	if policy.InitialDelayMs <= 0 {
+		policy.InitialDelayMs = defaults.InitialDelayMs
+	}
+	if policy.Multiplier <= 0 {
+		policy.Multiplier = defaults.Multiplier
+	}
+	if policy.MaxDelayMs <= 0 {
+		policy.MaxDelayMs = defaults.MaxDelayMs
+	}
+	// a jitter of 0 is set on purpose, it turns the jitter off
+	if policy.Jitter == nil {
+		policy.Jitter = defaults.Jitter
+	}
+	if len(policy.RetryOn) == 0 {
+		policy.RetryOn = defaults.RetryOn
+	}
+	return policy
+}
+
+// Retryable tells whether the error is retried after the given attempts, the first crawl included
+func (_self RetryPolicy) Retryable(err error, attempts int64) bool {
+	return attempts < int64(_self.MaxAttempts) && slices.Contains(_self.RetryOn, ErrorClassOf(err))
+}
+
+// Delay is the wait before the n-th retry, n starts at 1
+func (_self RetryPolicy) Delay(retry int64) time.Duration {
+	delay := float64(_self.InitialDelayMs) * math.Pow(math.Max(_self.Multiplier, 1), float64(retry-1))
+	if _self.Jitter != nil && *_self.Jitter > 0 {
+		// up to Jitter of the delay, earlier or later
+		delay += delay * *_self.Jitter * (2*rand.Float64() - 1)
+	}
+	// the cap holds with the jitter
+	if _self.MaxDelayMs > 0 {
+		delay = math.Min(delay, float64(_self.MaxDelayMs))
+	}
+	return time.Duration(delay) * time.Millisecond
+}
@@ -1,8 +1,16 @@
 package entity
 
-type UpdateSchedulerEventRequest struct {
-	Id    string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Event *SchedulerEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+import "time"
+
+// RunResult is the outcome of a run reported to the scheduler, RunId links it to the run history of the scheduler
+type RunResult struct {
+	EventId  int64
+	RunId    string
+	Status   StatusEnum
+	Duration time.Duration
+	Error    string
+	// ResultId is the id of the stored crawl result, empty when nothing is stored
+	ResultId string
 }
 
 type SchedulerEvent struct {
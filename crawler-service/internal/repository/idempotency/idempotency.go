@@ -0,0 +1,41 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "crawler.run."
+
+type IIdempotency interface {
+	// Acquire records the key and reports false when it has already been recorded
+	Acquire(ctx context.Context, key string) (bool, error)
+}
+
+type idempotency struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewIdempotency(
+	conf *configs.Config,
+) IIdempotency {
+	client := redis.NewClient(&redis.Options{
+		Addr:     conf.Redis.Addr,
+		Password: conf.Redis.Password,
+		DB:       conf.Redis.DB,
+	})
+	return &idempotency{
+		client: client,
+		ttl:    conf.Idempotency.TTL,
+	}
+}
+
+var _ IIdempotency = &idempotency{}
+
+func (_self *idempotency) Acquire(ctx context.Context, key string) (bool, error) {
+	return _self.client.SetNX(ctx, keyPrefix+key, time.Now().Format(time.RFC3339Nano), _self.ttl).Result()
+}
@@ -1,98 +1,148 @@
 package schedulerservice
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"time"
 
 	"github.com/namnv2496/crawler/internal/configs"
 	"github.com/namnv2496/crawler/internal/entity"
 	"github.com/namnv2496/crawler/internal/pkg/logging"
+	schedulerv1 "github.com/namnv2496/crawler/pkg/generated/pkg/proto"
 	"github.com/sony/gobreaker/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type ISchedulerService interface {
-	UpdateSchedulerEvent(ctx context.Context, req *entity.UpdateSchedulerEventRequest) error
+	// ReportRunResult finishes the run on the scheduler, it is retried when the scheduler is unavailable
+	ReportRunResult(ctx context.Context, result *entity.RunResult) error
+	// EventExists is false when the event is deleted from the scheduler
+	EventExists(ctx context.Context, id int64) (bool, error)
+	// GetQueues returns the queues to consume
+	GetQueues(ctx context.Context) ([]entity.Queue, error)
 }
 
+// schedulerService calls the internal gRPC API of the scheduler with the API key of the workers
 type schedulerService struct {
-	client  *http.Client
-	host    string
-	breaker *gobreaker.CircuitBreaker[int]
+	client  schedulerv1.SchedulerInternalServiceClient
+	apiKey  string
+	timeout time.Duration
+	breaker *gobreaker.CircuitBreaker[struct{}]
 }
 
-func NewSchedulerService(conf *configs.Config) ISchedulerService {
-	breaker := gobreaker.NewCircuitBreaker[int](gobreaker.Settings{
-		Name:    "UpdateSchedulerEventCB",
+// retryPolicy retries the calls rejected before the scheduler handled them, the RPCs are idempotent
+const retryPolicy = `{
+	"methodConfig": [{
+		"name": [{"service": "scheduler.v1.SchedulerInternalService"}],
+		"retryPolicy": {
+			"maxAttempts": 5,
+			"initialBackoff": "0.2s",
+			"maxBackoff": "5s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE", "RESOURCE_EXHAUSTED"]
+		}
+	}]
+}`
+
+func NewSchedulerService(conf *configs.Config) (ISchedulerService, error) {
+	breaker := gobreaker.NewCircuitBreaker[struct{}](gobreaker.Settings{
+		Name:    "SchedulerServiceCB",
 		Timeout: 10 * time.Second,
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
 			failureRate := float64(counts.TotalFailures) / float64(counts.Requests)
 			return counts.Requests >= 5 && failureRate >= 0.5
 		},
 	})
+	conn, err := grpc.NewClient(
+		conf.SchedulerService.GRPCHost,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(retryPolicy),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scheduler client: %w", err)
+	}
 	return &schedulerService{
-		host: conf.SchedulerService.Host,
-		client: &http.Client{
-			Timeout: conf.SchedulerService.Timeout,
-		},
+		client:  schedulerv1.NewSchedulerInternalServiceClient(conn),
+		apiKey:  conf.SchedulerService.APIKey,
+		timeout: conf.SchedulerService.Timeout,
 		breaker: breaker,
-	}
+	}, nil
 }
 
 var _ ISchedulerService = &schedulerService{}
 
-func (_self *schedulerService) UpdateSchedulerEvent(ctx context.Context, req *entity.UpdateSchedulerEventRequest) error {
-	deferFunc := logging.AppendPrefix("UpdateSchedulerEvent")
+func (_self *schedulerService) ReportRunResult(ctx context.Context, result *entity.RunResult) error {
+	deferFunc := logging.AppendPrefix("ReportRunResult")
 	defer deferFunc()
 
-	payload, err := json.Marshal(req)
-	if err != nil {
+	return _self.call(ctx, func(ctx context.Context) error {
+		_, err := _self.client.ReportRunResult(ctx, &schedulerv1.ReportRunResultRequest{
+			EventId:    result.EventId,
+			RunId:      result.RunId,
+			Status:     string(result.Status),
+			DurationMs: result.Duration.Milliseconds(),
+			Error:      result.Error,
+			ResultId:   result.ResultId,
+		})
 		return err
-	}
+	})
+}
 
-	// CB operation → must return (int, error)
-	operation := func() (int, error) {
-		httpReq, err := http.NewRequestWithContext(
-			ctx,
-			http.MethodPut,
-			_self.host+"/api/v1/events/"+req.Id,
-			bytes.NewReader(payload),
-		)
-		if err != nil {
-			return 0, err
-		}
+func (_self *schedulerService) EventExists(ctx context.Context, id int64) (bool, error) {
+	deferFunc := logging.AppendPrefix("EventExists")
+	defer deferFunc()
 
-		resp, err := _self.client.Do(httpReq)
+	var exists bool
+	err := _self.call(ctx, func(ctx context.Context) error {
+		resp, err := _self.client.EventExists(ctx, &schedulerv1.EventExistsRequest{EventId: id})
 		if err != nil {
-			return 0, err
+			return err
 		}
-		defer resp.Body.Close()
+		exists = resp.Exists
+		return nil
+	})
+	return exists, err
+}
 
-		body, _ := io.ReadAll(resp.Body)
+func (_self *schedulerService) GetQueues(ctx context.Context) ([]entity.Queue, error) {
+	deferFunc := logging.AppendPrefix("GetQueues")
+	defer deferFunc()
 
-		// Treat 5xx as circuit-breaker errors
-		if resp.StatusCode >= 500 {
-			return 0, fmt.Errorf("server error %d: %s", resp.StatusCode, string(body))
+	var queues []entity.Queue
+	err := _self.call(ctx, func(ctx context.Context) error {
+		resp, err := _self.client.GetQueues(ctx, &schedulerv1.GetQueuesRequest{})
+		if err != nil {
+			return err
 		}
+		queues = make([]entity.Queue, 0, len(resp.Queues))
+		for _, queue := range resp.Queues {
+			queues = append(queues, entity.Queue{
+				Name:        queue.Name,
+				Topic:       queue.Topic,
+				Weight:      int(queue.Weight),
+				Concurrency: int(queue.MaxConcurrency),
+				RateLimit:   int(queue.RateLimit),
+			})
+		}
+		return nil
+	})
+	return queues, err
+}
 
-		fmt.Println("Status:", resp.Status)
-		fmt.Println("Response:", string(body))
-		return 1, nil
-	}
+// call runs rpc with the API key through the circuit breaker, the timeout covers every retry
+func (_self *schedulerService) call(ctx context.Context, rpc func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, _self.timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+_self.apiKey)
 
-	// CALL breaker (v2 API uses generics)
-	_, err = _self.breaker.Execute(operation)
-	if err != nil {
-		if errors.Is(err, gobreaker.ErrOpenState) {
-			fmt.Println("⚠ Circuit breaker OPEN → skip request")
-		}
-		return err
+	_, err := _self.breaker.Execute(func() (struct{}, error) {
+		return struct{}{}, rpc(ctx)
+	})
+	if errors.Is(err, gobreaker.ErrOpenState) {
+		logging.Error(ctx, "circuit breaker is open, skip the call")
 	}
-
-	return nil
+	return err
 }
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/namnv2496/crawler/internal/entity"
+	"github.com/temoto/robotstxt"
+	"golang.org/x/net/html"
+)
+
+// robotsUserAgent is the user agent whose rules of robots.txt are followed
+const robotsUserAgent = "Googlebot"
+
+// robotsTestPath is the page tested against the robots.txt crawled by the ROBOTS events
+const robotsTestPath = "/san-pham/iphone-15.html"
+
+// page is a fetched response, the crawl and the preview extract it the same way
+type page struct {
+	statusCode int
+	body       []byte
+}
+
+// extraction is what the crawl of a page yields: the extracted fields and the message to send
+type extraction struct {
+	record       map[string]string
+	notification string
+}
+
+// fetch requests the URL of the event, or runs its curl command, once
+func (_self *crawlerService) fetch(ctx context.Context, url entity.CrawlerEvent) (*page, error) {
+	switch url.Method {
+	case http.MethodGet, METHOD_ROBOTS:
+		return _self.fetchURL(ctx, http.MethodGet, url.Url)
+	case http.MethodPost:
+		return _self.fetchURL(ctx, http.MethodPost, url.Url)
+	case METHOD_CURL:
+		return _self.runCurl(ctx, url.Url)
+	default:
+		return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("unsupported HTTP method: %s", url.Method))
+	}
+}
+
+// checkStatus fails the pages which are not 200, and the curl commands whose status is not 2xx
+func checkStatus(url entity.CrawlerEvent, page *page) error {
+	if url.Method == METHOD_CURL && page.statusCode >= 200 && page.statusCode < 300 {
+		return nil
+	}
+	if url.Method != METHOD_CURL && page.statusCode == http.StatusOK {
+		return nil
+	}
+	return entity.NewCrawlError(statusClass(page.statusCode), fmt.Errorf("non-200 status code: %d for %s", page.statusCode, url.Url))
+}
+
+// statusClass sorts the status codes which are not 200 for the retry policy
+func statusClass(statusCode int) string {
+	switch {
+	case statusCode == http.StatusNotFound || statusCode == http.StatusGone:
+		return entity.ErrorClassNotFound
+	case statusCode == http.StatusTooManyRequests:
+		return entity.ErrorClassRateLimited
+	case statusCode == http.StatusRequestTimeout || statusCode == http.StatusGatewayTimeout:
+		return entity.ErrorClassTimeout
+	case statusCode >= 500:
+		return entity.ErrorClassServerError
+	default:
+		return entity.ErrorClassClientError
+	}
+}
+
+// transportClass sorts the errors of a request which got no response
+func transportClass(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return entity.ErrorClassTimeout
+	}
+	return entity.ErrorClassConnection
+}
+
+// extract reads the page by the method of the event
+func (_self *crawlerService) extract(url entity.CrawlerEvent, page *page) (*extraction, error) {
+	switch url.Method {
+	case http.MethodGet, http.MethodPost:
+		doc, err := html.Parse(bytes.NewReader(page.body))
+		if err != nil {
+			return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("error parsing HTML: %v", err))
+		}
+		return &extraction{record: map[string]string{"title": extractTitle(doc)}}, nil
+	case METHOD_ROBOTS:
+		robots, err := robotstxt.FromBytes(page.body)
+		if err != nil {
+			return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("error parsing robots.txt: %v", err))
+		}
+		group := robots.FindGroup(robotsUserAgent)
+		return &extraction{record: map[string]string{
+			"user_agent":  robotsUserAgent,
+			"test_url":    robotsTestPath,
+			"allowed":     strconv.FormatBool(group.Test(robotsTestPath)),
+			"crawl_delay": group.CrawlDelay.String(),
+			"sitemaps":    strings.Join(robots.Sitemaps, ","),
+		}}, nil
+	case METHOD_CURL:
+		return &extraction{
+			record: map[string]string{
+				"url":    url.Url,
+				"method": url.Method,
+				"queue":  url.Queue,
+				"domain": url.Domain,
+				"result": string(page.body),
+			},
+			notification: entity.ExtractGoldPrice(page.body),
+		}, nil
+	default:
+		return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("unsupported HTTP method: %s", url.Method))
+	}
+}
+
+func (_self *crawlerService) fetchURL(ctx context.Context, method, url string) (*page, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("error creating request %s: %v", url, err))
+	}
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	resp, err := _self.httpClient.Do(req)
+	if errors.Is(err, errPrivateAddress) {
+		return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("error fetching %s: %v", url, err))
+	}
+	if err != nil {
+		return nil, entity.NewCrawlError(transportClass(err), fmt.Errorf("error fetching %s: %v", url, err))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, entity.NewCrawlError(transportClass(err), fmt.Errorf("error reading %s: %v", url, err))
+	}
+	return &page{statusCode: resp.StatusCode, body: body}, nil
+}
+
+// statusWriteOut appends the status code to the output of curl, it is cut from the body
+const statusWriteOut = "\n%{http_code}"
+
+func (_self *crawlerService) runCurl(ctx context.Context, command string) (*page, error) {
+	parsed, err := parseCurlCommand(command)
+	if err != nil {
+		return nil, entity.NewCrawlError(entity.ErrorClassInvalid, err)
+	}
+	args := append(parsed.args, "--write-out", statusWriteOut)
+	if !_self.allowPrivateIPs {
+		guardArgs, err := curlGuardArgs(ctx, parsed.urls)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, guardArgs...)
+	}
+	output, err := exec.CommandContext(ctx, "curl", args...).Output()
+	if err != nil {
+		return nil, entity.NewCrawlError(curlClass(ctx, err), err)
+	}
+	// the whole output is the body when it does not end with the status code
+	result := &page{body: output}
+	if index := bytes.LastIndexByte(output, '\n'); index >= 0 {
+		if code, err := strconv.Atoi(string(output[index+1:])); err == nil {
+			result.statusCode = code
+			result.body = output[:index]
+		}
+	}
+	return result, nil
+}
+
+// curlClass sorts the failures of curl by its exit code
+func curlClass(ctx context.Context, err error) string {
+	if ctx.Err() != nil {
+		return transportClass(ctx.Err())
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return entity.ErrorClassUnknown
+	}
+	switch exitErr.ExitCode() {
+	case 28: // operation timeout
+		return entity.ErrorClassTimeout
+	case 5, 6, 7, 35, 52, 55, 56: // resolve, connect, TLS, empty reply, send and receive errors
+		return entity.ErrorClassConnection
+	case 3: // malformed URL
+		return entity.ErrorClassInvalid
+	case 1, 47: // a protocol or a redirect refused by the guard of the target
+		return entity.ErrorClassInvalid
+	default:
+		return entity.ErrorClassUnknown
+	}
+}
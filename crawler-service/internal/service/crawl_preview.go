@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/namnv2496/crawler/internal/entity"
+	"github.com/namnv2496/crawler/internal/pkg/logging"
+	"github.com/temoto/robotstxt"
+)
+
+// Preview fetches and extracts like Crawl without the dedup, the visited pages, the retries,
+// the stored result, the notification and the report to the scheduler
+func (_self *crawlerService) Preview(ctx context.Context, url entity.CrawlerEvent) *entity.CrawlPreview {
+	deferFunc := logging.AppendPrefix("Preview")
+	defer deferFunc()
+	startedAt := time.Now()
+	preview := &entity.CrawlPreview{
+		Violations: _self.scopeViolations(ctx, url),
+	}
+	defer func() {
+		preview.Duration = time.Since(startedAt)
+	}()
+	page, err := _self.fetch(ctx, url)
+	preview.FetchDuration = time.Since(startedAt)
+	if err != nil {
+		preview.Error = err.Error()
+		return preview
+	}
+	preview.StatusCode = page.statusCode
+	if err := checkStatus(url, page); err != nil {
+		preview.Error = err.Error()
+		return preview
+	}
+	extractedAt := time.Now()
+	extracted, err := _self.extract(url, page)
+	preview.ExtractDuration = time.Since(extractedAt)
+	if err != nil {
+		preview.Error = err.Error()
+		return preview
+	}
+	preview.Record = extracted.record
+	preview.Notification = extracted.notification
+	return preview
+}
+
+// scopeViolations are the reasons for the crawl of the event to be skipped or to break the rules of the site
+func (_self *crawlerService) scopeViolations(ctx context.Context, url entity.CrawlerEvent) []string {
+	violations := make([]string, 0)
+	target := url.Url
+	if url.Method == METHOD_CURL {
+		target = curlURL(url.Url)
+	} else if !isValidURL(url.Url) {
+		violations = append(violations, "url is not an http or https URL, the crawl skips it")
+	}
+	if url.Domain != "" && len(_self.domains) > 0 && !slices.Contains(_self.domains, url.Domain) {
+		violations = append(violations, fmt.Sprintf("domain %s is not one of the domains of the crawler: %s", url.Domain, strings.Join(_self.domains, ", ")))
+	}
+	_self.mutex.Lock()
+	visited := _self.visited[url.Url]
+	_self.mutex.Unlock()
+	if visited {
+		violations = append(violations, "url is already crawled by this worker, the crawl skips it")
+	}
+	if url.Method != METHOD_ROBOTS && target != "" {
+		if violation := _self.robotsViolation(ctx, target); violation != "" {
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+// robotsViolation tests the target with the robots.txt of its host, a missing robots.txt allows everything
+func (_self *crawlerService) robotsViolation(ctx context.Context, target string) string {
+	parsed, err := neturl.Parse(target)
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	robotsURL := (&neturl.URL{Scheme: parsed.Scheme, Host: parsed.Host, Path: "/robots.txt"}).String()
+	page, err := _self.fetchURL(ctx, http.MethodGet, robotsURL)
+	if err != nil {
+		logging.Error(ctx, "fetch %s failed: %s", robotsURL, err.Error())
+		return ""
+	}
+	robots, err := robotstxt.FromStatusAndBytes(page.statusCode, page.body)
+	if err != nil {
+		return fmt.Sprintf("robots.txt of %s is invalid: %v", parsed.Host, err)
+	}
+	path := parsed.RequestURI()
+	if !robots.TestAgent(path, robotsUserAgent) {
+		return fmt.Sprintf("robots.txt of %s disallows %s for %s", parsed.Host, path, robotsUserAgent)
+	}
+	return ""
+}
@@ -1,23 +1,24 @@
 package service
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"os/exec"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/namnv2496/crawler/internal/configs"
 	"github.com/namnv2496/crawler/internal/domain"
 	"github.com/namnv2496/crawler/internal/entity"
 	"github.com/namnv2496/crawler/internal/pkg/logging"
 	"github.com/namnv2496/crawler/internal/repository"
+	"github.com/namnv2496/crawler/internal/repository/idempotency"
 	"github.com/namnv2496/crawler/internal/repository/schedulerservice"
 	"github.com/namnv2496/crawler/internal/service/mq"
-	"github.com/temoto/robotstxt"
 	"golang.org/x/net/html"
 )
 
@@ -28,37 +29,61 @@ const (
 
 type ICrawlerService interface {
 	Crawl(ctx context.Context, url entity.CrawlerEvent) error
+	// Preview runs the fetch and the extraction of the event once, nothing is stored, notified or retried
+	Preview(ctx context.Context, url entity.CrawlerEvent) *entity.CrawlPreview
 }
 
 type crawlerService struct {
 	maxDepth               int
 	visited                map[string]bool
 	mutex                  sync.Mutex
-	results                map[string]string
+	domains                []string
 	teleService            ITeleService
 	resultRepo             repository.IResultRepository
 	workerPool             IWorkerPool
 	retryProducer          mq.IAsynqProducer
 	schedulerServiceClient schedulerservice.ISchedulerService
+	idempotency            idempotency.IIdempotency
+	deadLetter             mq.IDeadLetterProducer
+	retryPolicy            entity.RetryPolicy
+	respectRobots          bool
+	allowPrivateIPs        bool
+	httpClient             *http.Client
 }
 
 // NewCrawler creates a new crawler instance
 func NewCrawlerService(
+	conf *configs.Config,
 	teleService ITeleService,
 	resultRepo repository.IResultRepository,
 	workerPool IWorkerPool,
 	retryProducer mq.IAsynqProducer,
 	schedulerServiceClient schedulerservice.ISchedulerService,
+	idempotency idempotency.IIdempotency,
+	deadLetter mq.IDeadLetterProducer,
 ) *crawlerService {
 	return &crawlerService{
 		maxDepth:               3,
 		visited:                make(map[string]bool),
-		results:                make(map[string]string),
+		domains:                conf.AppConfig.Domains,
 		teleService:            teleService,
 		resultRepo:             resultRepo,
 		workerPool:             workerPool,
 		retryProducer:          retryProducer,
 		schedulerServiceClient: schedulerServiceClient,
+		idempotency:            idempotency,
+		deadLetter:             deadLetter,
+		retryPolicy: entity.RetryPolicy{
+			MaxAttempts:    int32(conf.Retry.MaxAttempts),
+			InitialDelayMs: conf.Retry.InitialDelay.Milliseconds(),
+			Multiplier:     conf.Retry.Multiplier,
+			MaxDelayMs:     conf.Retry.MaxDelay.Milliseconds(),
+			Jitter:         &conf.Retry.Jitter,
+			RetryOn:        conf.Retry.RetryOn,
+		},
+		respectRobots:   conf.AppConfig.RespectRobots,
+		allowPrivateIPs: conf.AppConfig.AllowPrivateIPs,
+		httpClient:      newCrawlClient(conf.AppConfig.AllowPrivateIPs),
 	}
 }
 
@@ -69,228 +94,196 @@ func (_self *crawlerService) Crawl(ctx context.Context, event entity.CrawlerEven
 	if !event.IsActive {
 		return nil
 	}
-	status := string(entity.StatusSuccessed)
-	err := _self.crawlPage(ctx, event, _self.maxDepth)
+	if dedupKey := event.DedupKey(); dedupKey != "" {
+		acquired, err := _self.idempotency.Acquire(ctx, dedupKey)
+		if err != nil {
+			// fail open: a missed dedup is better than a missed crawl
+			logging.Error(ctx, "check run %s failed: %s", dedupKey, err.Error())
+		} else if !acquired {
+			logging.Info(ctx, "run %s is already processed, skip", dedupKey)
+			return nil
+		}
+	}
+	if event.Retrytime > 0 {
+		// the event may be deleted while the retry was waiting
+		exists, err := _self.schedulerServiceClient.EventExists(ctx, event.Id)
+		if err != nil {
+			logging.Error(ctx, "check event %d failed: %s", event.Id, err.Error())
+		} else if !exists {
+			logging.Info(ctx, "event %d is deleted, drop the retry", event.Id)
+			return nil
+		}
+	}
+	status := entity.StatusSuccessed
+	var errMsg string
+	startedAt := time.Now()
+	resultId, err := _self.crawlPage(ctx, event, _self.maxDepth)
 	if err != nil {
-		// delay 5m if fail
-		if event.Retrytime < 3 {
+		class := entity.ErrorClassOf(err)
+		logging.Error(ctx, "crawl event %d failed (%s): %s", event.Id, class, err.Error())
+		// the policy of the event decides whether the error is retried and when
+		policy := event.RetryPolicy.WithDefaults(_self.retryPolicy)
+		if policy.Retryable(err, event.Retrytime+1) {
 			event.Retrytime += 1
-			_self.retryProducer.EnqueueRetryEvent(ctx, event, time.Now().Add(5*time.Minute))
+			delay := policy.Delay(event.Retrytime)
+			enqueueErr := _self.retryProducer.EnqueueRetryEvent(ctx, event, time.Now().Add(delay))
+			if enqueueErr == nil {
+				logging.Info(ctx, "retry %d of event %d in %s", event.Retrytime, event.Id, delay)
+				// the run is reported once the retries are done
+				return nil
+			}
+			logging.Error(ctx, "enqueue retry of event %d failed: %s", event.Id, enqueueErr.Error())
+			event.Retrytime -= 1
 		}
-		status = string(entity.StatusFailed)
+		status = entity.StatusFailed
+		errMsg = fmt.Sprintf("%s: %s", class, err.Error())
+		_self.sendDeadLetter(ctx, event, errMsg)
 	}
 
-	// update status if it executed successfully
-	_self.schedulerServiceClient.UpdateSchedulerEvent(ctx, &entity.UpdateSchedulerEventRequest{
-		Id: fmt.Sprint(event.Id),
-		Event: &entity.SchedulerEvent{
-			Id:          fmt.Sprint(event.Id),
-			Url:         event.Url,
-			Method:      event.Method,
-			Description: event.Description,
-			Queue:       event.Queue,
-			Domain:      event.Domain,
-			Status:      status,
-		},
-	})
+	if event.RunId == "" {
+		// events published before the run history have nothing to finish
+		return nil
+	}
+	if err := _self.schedulerServiceClient.ReportRunResult(ctx, &entity.RunResult{
+		EventId:  event.Id,
+		RunId:    event.RunId,
+		Status:   status,
+		Duration: time.Since(startedAt),
+		Error:    errMsg,
+		ResultId: resultId,
+	}); err != nil {
+		logging.Error(ctx, "report run %s of event %d failed: %s", event.RunId, event.Id, err.Error())
+	}
 	return nil
 }
 
-func (_self *crawlerService) crawlPage(ctx context.Context, url entity.CrawlerEvent, depth int) error {
+// sendDeadLetter keeps the event given up after its retries, the scheduler can replay it
+func (_self *crawlerService) sendDeadLetter(ctx context.Context, event entity.CrawlerEvent, reason string) {
+	value, err := json.Marshal(event)
+	if err != nil {
+		logging.Error(ctx, "marshal dead letter of event %d failed: %s", event.Id, err.Error())
+		return
+	}
+	if err := _self.deadLetter.Send(ctx, entity.DeadLetter{
+		Queue:    event.Queue,
+		Key:      []byte(strconv.FormatInt(event.Id, 10)),
+		Value:    value,
+		Error:    reason,
+		Attempts: event.Retrytime + 1,
+	}); err != nil {
+		logging.Error(ctx, "send dead letter of event %d failed: %s", event.Id, err.Error())
+	}
+}
+
+// crawlPage returns the id of the stored result, empty when the method stores nothing
+func (_self *crawlerService) crawlPage(ctx context.Context, url entity.CrawlerEvent, depth int) (string, error) {
 	deferFunc := logging.AppendPrefix("crawlPage")
 	defer deferFunc()
 	if depth > _self.maxDepth {
-		return nil
+		return "", nil
 	}
 	_self.mutex.Lock()
 	if _self.visited[url.Url] {
 		_self.mutex.Unlock()
-		return nil
+		return "", nil
 	}
 	_self.visited[url.Url] = true
 	_self.mutex.Unlock()
-	switch url.Method {
-	case http.MethodGet:
-		_self.crawlGET(ctx, url, depth)
-	case http.MethodPost:
-		_self.crawlPOST(ctx, url, depth)
-	case METHOD_CURL:
-		_self.crawlCurl(ctx, url, depth)
-	case METHOD_ROBOTS:
-		_self.crawlRobotFile(ctx, url, depth)
-	default:
-		return fmt.Errorf("unsupported HTTP method: %s", url.Method)
-	}
-
-	// update status
-	go func() {
-		reqBody := fmt.Sprintf(`{"id":%d,"status":"successed"}`, url.Id)
-		resp, err := http.Post("http://localhost:8080/api/v1/event/status", "application/json", strings.NewReader(reqBody))
-		if err != nil {
-			logging.Error(ctx, "failed to update status: %v", err)
-			return
-		}
-		defer resp.Body.Close()
-		if resp.StatusCode != http.StatusOK {
-			logging.Debug(ctx, "status update returned non-200: %d", resp.StatusCode)
-		}
-	}()
-	return nil
-}
-
-func (_self *crawlerService) crawlRobotFile(_ context.Context, url entity.CrawlerEvent, depth int) (string, error) {
-	if !isValidURL(url.Url) {
-		return "", nil
-	}
-	resp, err := http.Get(url.Url)
-	if err != nil {
-		return "", fmt.Errorf("error fetching %s: %v", url.Url, err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("non-200 status code: %d for %s", resp.StatusCode, url.Url)
-	}
-	bodyBytes, err := io.ReadAll(resp.Body)
+	resultId, err := _self.crawlTarget(ctx, url)
 	if err != nil {
-		fmt.Println("error reading response body:", err)
-	}
-	body := string(bodyBytes)
-	fmt.Println(body)
-	// parsing robot.txt
-	if err := _self.handleRobotFile(bodyBytes); err != nil {
-		return "", err
+		// a failed url is crawled again by its retry
+		_self.mutex.Lock()
+		delete(_self.visited, url.Url)
+		_self.mutex.Unlock()
 	}
-	return "", nil
+	return resultId, err
 }
 
-func (_self *crawlerService) crawlGET(ctx context.Context, url entity.CrawlerEvent, depth int) (string, error) {
-	if !isValidURL(url.Url) {
-		return "", nil
-	}
-	resp, err := http.Get(url.Url)
-	if err != nil {
-		return "", fmt.Errorf("error fetching %s: %v", url.Url, err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("non-200 status code: %d for %s", resp.StatusCode, url.Url)
+func (_self *crawlerService) crawlTarget(ctx context.Context, url entity.CrawlerEvent) (string, error) {
+	if _self.respectRobots && url.Method != METHOD_ROBOTS {
+		target := url.Url
+		if url.Method == METHOD_CURL {
+			target = curlURL(url.Url)
+		}
+		if violation := _self.robotsViolation(ctx, target); violation != "" {
+			return "", entity.NewCrawlError(entity.ErrorClassRobotsDisallowed, errors.New(violation))
+		}
 	}
-	doc, err := html.Parse(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("error parsing HTML: %v", err)
+	switch url.Method {
+	case http.MethodGet, http.MethodPost, METHOD_ROBOTS:
+		return "", _self.crawlHTTP(ctx, url)
+	case METHOD_CURL:
+		return _self.crawlCurl(ctx, url)
+	default:
+		return "", entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("unsupported HTTP method: %s", url.Method))
 	}
-	title := extractTitle(doc)
-	_self.mutex.Lock()
-	_self.results[title] = doc.Data
-	_self.mutex.Unlock()
-	return doc.Data, nil
 }
 
-func (_self *crawlerService) crawlPOST(ctx context.Context, url entity.CrawlerEvent, depth int) (string, error) {
+// crawlHTTP fetches the page, or the robots.txt, and extracts it, nothing is stored
+func (_self *crawlerService) crawlHTTP(ctx context.Context, url entity.CrawlerEvent) error {
 	if !isValidURL(url.Url) {
-		return "", nil
+		return nil
 	}
-	resp, err := http.Post(url.Url, "application/x-www-form-urlencoded", nil)
+	page, err := _self.fetch(ctx, url)
 	if err != nil {
-		return "", fmt.Errorf("error posting %s: %v", url.Url, err)
+		return err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("non-200 status code: %d for %s", resp.StatusCode, url.Url)
+	if err := checkStatus(url, page); err != nil {
+		return err
 	}
-	doc, err := html.Parse(resp.Body)
+	extracted, err := _self.extract(url, page)
 	if err != nil {
-		return "", fmt.Errorf("error parsing HTML: %v", err)
+		return err
 	}
-	title := extractTitle(doc)
-	_self.mutex.Lock()
-	_self.results[title] = doc.Data
-	_self.mutex.Unlock()
-	return doc.Data, nil
+	logging.Debug(ctx, "extracted %s %s: %v", url.Method, url.Url, extracted.record)
+	return nil
 }
 
-func (_self *crawlerService) crawlCurl(ctx context.Context, url entity.CrawlerEvent, depth int) (string, error) {
+// crawlCurl runs the curl command and returns the id of the stored output
+func (_self *crawlerService) crawlCurl(ctx context.Context, url entity.CrawlerEvent) (string, error) {
 	deferFunc := logging.AppendPrefix("crawlPage")
 	defer deferFunc()
-	// Parse the curl command string
-	parts := strings.Split(url.Url, "--")
-	var args []string
-
-	// Skip the first part as it's the 'curl' command itself
-	for _, part := range parts[1:] {
-		// Trim spaces
-		part = strings.TrimSpace(part)
-		if part == "" {
-			continue
-		}
-
-		// Split by first space to separate flag from value
-		flagAndValue := strings.SplitN(part, " ", 2)
-		if len(flagAndValue) == 2 {
-			// Add the flag with '--' prefix
-			args = append(args, "--"+flagAndValue[0])
-			// Remove surrounding quotes if present and add the value
-			value := strings.Trim(strings.TrimSpace(flagAndValue[1]), "'`")
-			args = append(args, value)
-		}
-	}
-
-	// Create and execute command
-	cmd := exec.Command("curl", args...)
-	var output []byte
+	var resultId string
 	var err error
 	_self.workerPool.Execute(
 		func() (any, error) {
-			var cmdOutput []byte
-			cmdOutput, err = cmd.Output()
-			output = cmdOutput // Assign to outer variable
-			return cmdOutput, err
+			return _self.fetch(ctx, url)
 		},
-		depth,
 		nil,
 		func(result any, cmdErr error) {
 			if cmdErr != nil {
 				err = cmdErr // Propagate error to outer scope
 				return
 			}
-			if err = _self.teleService.SendMessage(entity.ExtractGoldPrice(output), "text"); err != nil {
+			if err = checkStatus(url, result.(*page)); err != nil {
+				return
+			}
+			var extracted *extraction
+			if extracted, err = _self.extract(url, result.(*page)); err != nil {
+				return
+			}
+			if err = _self.teleService.SendMessage(extracted.notification, "text"); err != nil {
 				logging.Error(ctx, "send price error: %s", err.Error())
 			}
 			// write result to db
-			if err = _self.resultRepo.CreateResult(ctx, &domain.Result{
+			crawlResult := &domain.Result{
 				Url:    url.Url,
 				Method: url.Method,
 				Queue:  url.Queue,
 				Domain: url.Domain,
-				Result: string(output),
-			}); err != nil {
+				Result: extracted.record["result"],
+			}
+			if err = _self.resultRepo.CreateResult(ctx, crawlResult); err != nil {
 				logging.Error(ctx, "create result error: %s", err.Error())
+			} else {
+				resultId = strconv.FormatInt(crawlResult.Id, 10)
 			}
-			logging.Debug(ctx, "send message to Telegram: %v\n", string(output))
-			logging.Debug(ctx, "=======================================")
-			_self.results["test"] = string(output)
+			logging.Debug(ctx, "send message to Telegram: %v\n", extracted.notification)
 		})
 	if err != nil {
-		return "", fmt.Errorf("error executing curl command: %v", err)
+		return "", fmt.Errorf("error executing curl command: %w", err)
 	}
-	resp := string(output)
-	io.NopCloser(bytes.NewReader(output))
-	return resp, nil
-}
-
-func (_self *crawlerService) handleRobotFile(bodyBytes []byte) error {
-	// parsing robot.txt
-	robots, err := robotstxt.FromBytes(bodyBytes)
-	if err != nil {
-		panic(err)
-	}
-	// Replace "Googlebot" with your user-agent string
-	group := robots.FindGroup("Googlebot")
-	testUrl := "/san-pham/iphone-15.html"
-
-	allowed := group.Test(testUrl)
-	fmt.Printf("Googlebot can fetch %s: %v\n", testUrl, allowed)
-	return nil
+	return resultId, nil
 }
 
 func extractTitle(n *html.Node) string {
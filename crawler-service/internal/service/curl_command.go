@@ -0,0 +1,226 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// curlFlags are the flags a curl command may use, true when the flag takes a value. The flags which
+// write or read local files, add other targets or send the request to another host than its URLs are
+// left out, and so are the positional arguments: every target is a --url.
+// The scheduler validates the commands with the same lists, see scheduler-service/internal/validator/curl_command.go.
+var curlFlags = map[string]bool{
+	"url":             true,
+	"request":         true,
+	"header":          true,
+	"user-agent":      true,
+	"referer":         true,
+	"cookie":          true,
+	"user":            true,
+	"oauth2-bearer":   true,
+	"data":            true,
+	"data-ascii":      true,
+	"data-binary":     true,
+	"data-raw":        true,
+	"range":           true,
+	"max-time":        true,
+	"connect-timeout": true,
+	"max-filesize":    true,
+	"compressed":      false,
+	"get":             false,
+	"head":            false,
+	"location":        false,
+	"insecure":        false,
+	"basic":           false,
+	"http1.1":         false,
+	"http2":           false,
+	"silent":          false,
+	"show-error":      false,
+	"globoff":         false,
+	"path-as-is":      false,
+}
+
+// curlShortFlags are the short names of the flags, as copied from the browsers
+var curlShortFlags = map[byte]string{
+	'X': "request",
+	'H': "header",
+	'A': "user-agent",
+	'e': "referer",
+	'b': "cookie",
+	'u': "user",
+	'd': "data",
+	'r': "range",
+	'm': "max-time",
+	'G': "get",
+	'I': "head",
+	'L': "location",
+	'k': "insecure",
+	's': "silent",
+	'S': "show-error",
+	'g': "globoff",
+}
+
+// fileCurlFlags read a local file when their value starts with "@"
+var fileCurlFlags = map[string]bool{
+	"header":      true,
+	"data":        true,
+	"data-ascii":  true,
+	"data-binary": true,
+}
+
+// curlCommand is a parsed curl command: its arguments for curl, with long flags only, and its URLs
+type curlCommand struct {
+	args []string
+	urls []string
+}
+
+// parseCurlCommand reads the command as a shell would split it and checks every flag
+func parseCurlCommand(command string) (*curlCommand, error) {
+	tokens, err := splitCurlCommand(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 || tokens[0] != "curl" {
+		return nil, errors.New("command is not a curl command")
+	}
+	parsed := &curlCommand{}
+	for i := 1; i < len(tokens); i++ {
+		names, value, err := curlFlagNames(tokens[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			takesValue, ok := curlFlags[name]
+			if !ok {
+				return nil, fmt.Errorf("flag %s of curl is not allowed", tokens[i])
+			}
+			if !takesValue {
+				parsed.args = append(parsed.args, "--"+name)
+				continue
+			}
+			// the flag with a value is the last of a group of short flags
+			if value == "" {
+				if i+1 >= len(tokens) {
+					return nil, fmt.Errorf("flag %s of curl needs a value", tokens[i])
+				}
+				i++
+				value = tokens[i]
+			}
+			if err := checkCurlValue(name, value); err != nil {
+				return nil, err
+			}
+			parsed.args = append(parsed.args, "--"+name, value)
+			if name == "url" {
+				parsed.urls = append(parsed.urls, value)
+			}
+		}
+	}
+	if len(parsed.urls) == 0 {
+		return nil, errors.New("curl command has no --url")
+	}
+	return parsed, nil
+}
+
+// curlURL is the first --url of the command, empty when the command is refused
+func curlURL(command string) string {
+	parsed, err := parseCurlCommand(command)
+	if err != nil {
+		return ""
+	}
+	return parsed.urls[0]
+}
+
+// curlFlagNames are the long names of the flags of a token and the value glued to a short flag,
+// e.g. "-sSL" is silent, show-error and location and "-XPOST" is request POST
+func curlFlagNames(token string) ([]string, string, error) {
+	if name, ok := strings.CutPrefix(token, "--"); ok && name != "" {
+		return []string{name}, "", nil
+	}
+	if len(token) < 2 || token[0] != '-' || token == "--" {
+		return nil, "", fmt.Errorf("argument %q of curl is not allowed, the targets are given with --url", token)
+	}
+	names := make([]string, 0, len(token)-1)
+	for i := 1; i < len(token); i++ {
+		name, ok := curlShortFlags[token[i]]
+		if !ok {
+			return nil, "", fmt.Errorf("flag -%c of curl is not allowed", token[i])
+		}
+		names = append(names, name)
+		if curlFlags[name] {
+			return names, token[i+1:], nil
+		}
+	}
+	return names, "", nil
+}
+
+// checkCurlValue refuses the values which read a local file
+func checkCurlValue(name, value string) error {
+	if fileCurlFlags[name] && strings.HasPrefix(value, "@") {
+		return fmt.Errorf("value of --%s of curl reads a file", name)
+	}
+	// a cookie without "=" is the name of a cookie file
+	if name == "cookie" && !strings.Contains(value, "=") {
+		return errors.New("value of --cookie of curl reads a file")
+	}
+	return nil
+}
+
+// splitCurlCommand splits the command into words as a shell does: spaces separate the words, single
+// quotes and backticks keep their text, double quotes and backslashes escape, a backslash before a
+// newline continues the line. The backticks were the quotes of the older commands.
+func splitCurlCommand(command string) ([]string, error) {
+	var tokens []string
+	var word strings.Builder
+	inWord := false
+	for i := 0; i < len(command); i++ {
+		c := command[i]
+		switch {
+		case c == '\\':
+			if i+1 >= len(command) {
+				return nil, errors.New("curl command ends with a backslash")
+			}
+			i++
+			if command[i] != '\n' {
+				word.WriteByte(command[i])
+				inWord = true
+			}
+		case c == '\'' || c == '`':
+			end := strings.IndexByte(command[i+1:], c)
+			if end < 0 {
+				return nil, errors.New("curl command has an unclosed quote")
+			}
+			word.WriteString(command[i+1 : i+1+end])
+			i += end + 1
+			inWord = true
+		case c == '"':
+			i++
+			for ; i < len(command) && command[i] != '"'; i++ {
+				if command[i] == '\\' && i+1 < len(command) && strings.IndexByte("\"\\$`\n", command[i+1]) >= 0 {
+					i++
+					if command[i] == '\n' {
+						continue
+					}
+				}
+				word.WriteByte(command[i])
+			}
+			if i >= len(command) {
+				return nil, errors.New("curl command has an unclosed quote")
+			}
+			inWord = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inWord {
+				tokens = append(tokens, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		tokens = append(tokens, word.String())
+	}
+	return tokens, nil
+}
@@ -43,7 +43,8 @@ func (_self *asynqProducer) EnqueueRetryEvent(ctx context.Context, event any, pr
 		return err
 	}
 	task := asynq.NewTask(RetryEvent, payload)
-	taskInfor, err := _self.client.EnqueueContext(ctx, task, asynq.ProcessAt(processAt), asynq.MaxRetry(1))
+	// the retry policy of the event decides the retries, asynq runs the task once
+	taskInfor, err := _self.client.EnqueueContext(ctx, task, asynq.ProcessAt(processAt), asynq.MaxRetry(0))
 	if err != nil {
 		return err
 	}
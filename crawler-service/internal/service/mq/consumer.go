@@ -2,34 +2,45 @@ package mq
 
 import (
 	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/namnv2496/crawler/internal/entity"
 	"github.com/segmentio/kafka-go"
 )
 
 type IConsumer interface {
-	GetConsumer() []*kafka.Reader
+	// Queues of the config, they are consumed until the queues of the scheduler are loaded
+	Queues() []entity.Queue
+	// NewReader joins the consumer group on the topic, the caller closes the reader
+	NewReader(topic string) IReader
 }
 type Consumer struct {
-	client []*kafka.Reader
+	conf   *configs.Config
+	queues []entity.Queue
 }
 
 func NewKafkaConsumer(
 	conf *configs.Config,
-) *Consumer {
-	consumers := make([]*kafka.Reader, 0)
-	for _, topic := range conf.KafkaConsumerConfig.Topic {
-		consumers = append(consumers, kafka.NewReader(kafka.ReaderConfig{
-			Brokers:   conf.KafkaConsumerConfig.Brokers,
-			Topic:     topic,
-			GroupID:   conf.KafkaConsumerConfig.GroupID,
-			Partition: 0,
-			MaxBytes:  10e6, // 10MB
-		}))
+) (*Consumer, error) {
+	queues, err := ParseQueues(conf)
+	if err != nil {
+		return nil, err
 	}
 	return &Consumer{
-		client: consumers,
-	}
+		conf:   conf,
+		queues: queues,
+	}, nil
+}
+
+func (c *Consumer) Queues() []entity.Queue {
+	return c.queues
 }
 
-func (c *Consumer) GetConsumer() []*kafka.Reader {
-	return c.client
+// NewReader reads every partition of the topic assigned to the group, the group picks the partitions
+func (c *Consumer) NewReader(topic string) IReader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:        c.conf.KafkaConsumerConfig.Brokers,
+		Topic:          topic,
+		GroupID:        c.conf.KafkaConsumerConfig.GroupID,
+		CommitInterval: c.conf.KafkaConsumerConfig.CommitInterval,
+		MaxBytes:       10e6, // 10MB
+	})
 }
@@ -0,0 +1,66 @@
+package mq
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/namnv2496/crawler/internal/entity"
+	"github.com/segmentio/kafka-go"
+)
+
+// headers of a dead letter, the key and the value are the ones of the given up message
+const (
+	HeaderDeadLetterError     = "dead-letter-error"
+	HeaderDeadLetterQueue     = "dead-letter-queue"
+	HeaderDeadLetterTopic     = "dead-letter-topic"
+	HeaderDeadLetterPartition = "dead-letter-partition"
+	HeaderDeadLetterOffset    = "dead-letter-offset"
+	HeaderDeadLetterAttempts  = "dead-letter-attempts"
+	HeaderDeadLetterTime      = "dead-letter-time"
+)
+
+type IDeadLetterProducer interface {
+	// Send writes the message to the dead-letter topic with the reason of its failure,
+	// it does nothing when no dead-letter topic is configured
+	Send(ctx context.Context, deadLetter entity.DeadLetter) error
+}
+
+type DeadLetterProducer struct {
+	writer *kafka.Writer
+}
+
+func NewDeadLetterProducer(
+	conf *configs.Config,
+) IDeadLetterProducer {
+	producer := &DeadLetterProducer{}
+	if topic := conf.KafkaConsumerConfig.DeadLetterTopic; topic != "" {
+		producer.writer = &kafka.Writer{
+			Addr:                   kafka.TCP(conf.KafkaConsumerConfig.Brokers...),
+			Balancer:               &kafka.LeastBytes{},
+			Topic:                  topic,
+			AllowAutoTopicCreation: true,
+		}
+	}
+	return producer
+}
+
+func (p *DeadLetterProducer) Send(ctx context.Context, deadLetter entity.DeadLetter) error {
+	if p.writer == nil {
+		return nil
+	}
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   deadLetter.Key,
+		Value: deadLetter.Value,
+		Headers: []kafka.Header{
+			{Key: HeaderDeadLetterError, Value: []byte(deadLetter.Error)},
+			{Key: HeaderDeadLetterQueue, Value: []byte(deadLetter.Queue)},
+			{Key: HeaderDeadLetterTopic, Value: []byte(deadLetter.Topic)},
+			{Key: HeaderDeadLetterPartition, Value: []byte(strconv.Itoa(deadLetter.Partition))},
+			{Key: HeaderDeadLetterOffset, Value: []byte(strconv.FormatInt(deadLetter.Offset, 10))},
+			{Key: HeaderDeadLetterAttempts, Value: []byte(strconv.FormatInt(deadLetter.Attempts, 10))},
+			{Key: HeaderDeadLetterTime, Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package mq
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/namnv2496/crawler/internal/entity"
+)
+
+// DefaultRateLimit is the number of messages read per second from the topics of the config
+const DefaultRateLimit = 10
+
+// ParseQueues builds the queues of the consumed topics from the "<topic>=<weight>/<concurrency>"
+// entries, a topic without an entry has weight 1 and concurrency 1
+func ParseQueues(conf *configs.Config) ([]entity.Queue, error) {
+	specs := make(map[string]entity.Queue, len(conf.KafkaConsumerConfig.Queues))
+	for _, entry := range conf.KafkaConsumerConfig.Queues {
+		topic, spec, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid queue %q, expected <topic>=<weight>/<concurrency>", entry)
+		}
+		weightValue, concurrencyValue, ok := strings.Cut(spec, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid queue %q, expected <topic>=<weight>/<concurrency>", entry)
+		}
+		weight, err := strconv.Atoi(weightValue)
+		if err != nil || weight < 1 {
+			return nil, fmt.Errorf("invalid weight of queue %q", entry)
+		}
+		concurrency, err := strconv.Atoi(concurrencyValue)
+		if err != nil || concurrency < 1 {
+			return nil, fmt.Errorf("invalid concurrency of queue %q", entry)
+		}
+		specs[topic] = entity.Queue{Name: topic, Topic: topic, Weight: weight, Concurrency: concurrency, RateLimit: DefaultRateLimit}
+	}
+	queues := make([]entity.Queue, 0, len(conf.KafkaConsumerConfig.Topic))
+	for _, topic := range conf.KafkaConsumerConfig.Topic {
+		queue, ok := specs[topic]
+		if !ok {
+			queue = entity.Queue{Name: topic, Topic: topic, Weight: 1, Concurrency: 1, RateLimit: DefaultRateLimit}
+		}
+		queues = append(queues, queue)
+	}
+	return queues, nil
+}
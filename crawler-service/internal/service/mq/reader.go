@@ -0,0 +1,134 @@
+package mq
+
+import (
+	"context"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// IReader is the part of kafka.Reader used by the consumers
+type IReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Stats() kafka.ReaderStats
+	Close() error
+}
+
+var _ IReader = &kafka.Reader{}
+
+// Committer commits the messages of a reader in the order of their partition. Up to concurrency
+// messages of a partition run at once and finish in any order, the offset of the partition moves
+// past a message once it and every message fetched before it are done: a crash or a rebalance
+// reads the unfinished messages again instead of skipping them. The messages fetched while their
+// partition is full are held instead of blocking the fetch of the other partitions.
+type Committer struct {
+	reader      IReader
+	concurrency int
+	mutex       sync.Mutex
+	partitions  map[int]*partitionOffsets
+	held        int
+}
+
+// partitionOffsets are the messages of a partition which are not committed, in the order of the fetch
+type partitionOffsets struct {
+	running int
+	// next is the offset after the last fetched message, a smaller offset is a read again after a rebalance
+	next    int64
+	pending []*Ticket
+	held    []*Ticket
+}
+
+// Ticket is a fetched message tracked by the committer until it is committed
+type Ticket struct {
+	Message   kafka.Message
+	partition *partitionOffsets
+	done      bool
+}
+
+func NewCommitter(reader IReader, concurrency int) *Committer {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Committer{
+		reader:      reader,
+		concurrency: concurrency,
+		partitions:  make(map[int]*partitionOffsets),
+	}
+}
+
+// Begin records the message as pending. ready is true when its partition has a free slot, otherwise
+// the message is held and Done returns it once a message of the partition frees a slot.
+func (c *Committer) Begin(message kafka.Message) (ticket *Ticket, ready bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	partition := c.partitions[message.Partition]
+	if partition != nil && message.Offset < partition.next {
+		// the group rebalanced and reads the partition again from its committed offset: the messages
+		// fetched before are not committed anymore and the held ones are fetched again
+		c.held -= len(partition.held)
+		partition = nil
+	}
+	if partition == nil {
+		partition = &partitionOffsets{}
+		c.partitions[message.Partition] = partition
+	}
+	partition.next = message.Offset + 1
+	ticket = &Ticket{Message: message, partition: partition}
+	partition.pending = append(partition.pending, ticket)
+	if partition.running < c.concurrency {
+		partition.running++
+		return ticket, true
+	}
+	partition.held = append(partition.held, ticket)
+	c.held++
+	return ticket, false
+}
+
+// Done frees the slot of the ticket and commits the messages of its partition which are done up to
+// the first one running. The commit is sent under the lock so the offsets of a partition only grow.
+// next is the held message of the partition which takes the slot, nil when none is held.
+func (c *Committer) Done(ctx context.Context, ticket *Ticket) (next *Ticket, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	partition := ticket.partition
+	if c.partitions[ticket.Message.Partition] != partition || ticket.done {
+		// the partition was reset by a rebalance, its messages are read again
+		return nil, nil
+	}
+	ticket.done = true
+	partition.running--
+	if len(partition.held) > 0 {
+		next = partition.held[0]
+		partition.held = partition.held[1:]
+		partition.running++
+		c.held--
+	}
+	var last *kafka.Message
+	for len(partition.pending) > 0 && partition.pending[0].done {
+		last = &partition.pending[0].Message
+		partition.pending = partition.pending[1:]
+	}
+	if last == nil {
+		return next, nil
+	}
+	return next, c.reader.CommitMessages(ctx, *last)
+}
+
+// Pending is the number of fetched messages which are not committed yet
+func (c *Committer) Pending() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	count := 0
+	for _, partition := range c.partitions {
+		count += len(partition.pending)
+	}
+	return count
+}
+
+// Held is the number of fetched messages waiting for a slot of their partition
+func (c *Committer) Held() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.held
+}
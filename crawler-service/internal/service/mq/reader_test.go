@@ -0,0 +1,176 @@
+package mq
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// fakeReader records the commits of the committer
+type fakeReader struct {
+	mutex   sync.Mutex
+	commits []string
+}
+
+func (r *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	<-ctx.Done()
+	return kafka.Message{}, ctx.Err()
+}
+
+func (r *fakeReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, msg := range msgs {
+		r.commits = append(r.commits, position(msg))
+	}
+	return nil
+}
+
+func (r *fakeReader) Stats() kafka.ReaderStats { return kafka.ReaderStats{} }
+
+func (r *fakeReader) Close() error { return nil }
+
+func position(msg kafka.Message) string {
+	return fmt.Sprintf("%d:%d", msg.Partition, msg.Offset)
+}
+
+// step begins or finishes the message of ticket, a ticket is named to tell the fetches of an offset apart
+type step struct {
+	ticket    string
+	begin     bool
+	partition int
+	offset    int64
+	// ready is whether a begun message runs at once
+	ready bool
+	// commit is the position committed by a done message, release the ticket of the held message it frees
+	commit  string
+	release string
+}
+
+func TestCommitter(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency int
+		steps       []step
+		held        int
+		pending     int
+	}{
+		{
+			name:        "messages done in order are committed one by one",
+			concurrency: 3,
+			steps: []step{
+				{ticket: "a", begin: true, partition: 0, offset: 0, ready: true},
+				{ticket: "b", begin: true, partition: 0, offset: 1, ready: true},
+				{ticket: "a", commit: "0:0"},
+				{ticket: "b", commit: "0:1"},
+			},
+		},
+		{
+			name:        "a message done early waits for the older messages of its partition",
+			concurrency: 3,
+			steps: []step{
+				{ticket: "a", begin: true, partition: 0, offset: 0, ready: true},
+				{ticket: "b", begin: true, partition: 0, offset: 1, ready: true},
+				{ticket: "c", begin: true, partition: 0, offset: 2, ready: true},
+				{ticket: "c"},
+				{ticket: "b"},
+				{ticket: "a", commit: "0:2"},
+			},
+		},
+		{
+			name:        "partitions finishing out of order are committed on their own",
+			concurrency: 2,
+			steps: []step{
+				{ticket: "a0", begin: true, partition: 0, offset: 10, ready: true},
+				{ticket: "b0", begin: true, partition: 1, offset: 20, ready: true},
+				{ticket: "a1", begin: true, partition: 0, offset: 11, ready: true},
+				{ticket: "b1", begin: true, partition: 1, offset: 21, ready: true},
+				{ticket: "b1"},
+				{ticket: "a1"},
+				{ticket: "b0", commit: "1:21"},
+				{ticket: "a0", commit: "0:11"},
+			},
+		},
+		{
+			name:        "a full partition holds its message without stopping the others",
+			concurrency: 1,
+			steps: []step{
+				{ticket: "a0", begin: true, partition: 0, offset: 0, ready: true},
+				{ticket: "a1", begin: true, partition: 0, offset: 1, ready: false},
+				{ticket: "b0", begin: true, partition: 1, offset: 0, ready: true},
+				{ticket: "b0", commit: "1:0"},
+				{ticket: "a0", commit: "0:0", release: "a1"},
+				{ticket: "a1", commit: "0:1"},
+			},
+		},
+		{
+			name:        "a partition read again after a rebalance forgets the older messages",
+			concurrency: 1,
+			steps: []step{
+				{ticket: "a0", begin: true, partition: 0, offset: 0, ready: true},
+				{ticket: "a1", begin: true, partition: 0, offset: 1, ready: false},
+				{ticket: "b0", begin: true, partition: 0, offset: 0, ready: true},
+				{ticket: "a0"},
+				{ticket: "b1", begin: true, partition: 0, offset: 1, ready: false},
+				{ticket: "b0", commit: "0:0", release: "b1"},
+				{ticket: "b1", commit: "0:1"},
+			},
+		},
+		{
+			name:        "the messages not done stay pending",
+			concurrency: 1,
+			steps: []step{
+				{ticket: "a0", begin: true, partition: 0, offset: 0, ready: true},
+				{ticket: "a1", begin: true, partition: 0, offset: 1, ready: false},
+				{ticket: "b0", begin: true, partition: 1, offset: 0, ready: true},
+			},
+			held:    1,
+			pending: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reader := &fakeReader{}
+			committer := NewCommitter(reader, test.concurrency)
+			tickets := make(map[string]*Ticket)
+			for i, step := range test.steps {
+				if step.begin {
+					ticket, ready := committer.Begin(kafka.Message{Partition: step.partition, Offset: step.offset})
+					if ready != step.ready {
+						t.Fatalf("step %d: ready = %v, want %v", i, ready, step.ready)
+					}
+					tickets[step.ticket] = ticket
+					continue
+				}
+				before := len(reader.commits)
+				next, err := committer.Done(context.Background(), tickets[step.ticket])
+				if err != nil {
+					t.Fatalf("step %d: %v", i, err)
+				}
+				commit := ""
+				if len(reader.commits) > before {
+					commit = reader.commits[len(reader.commits)-1]
+				}
+				if commit != step.commit {
+					t.Fatalf("step %d: commit = %q, want %q", i, commit, step.commit)
+				}
+				if step.release == "" {
+					if next != nil {
+						t.Fatalf("step %d: released %s, want none", i, position(next.Message))
+					}
+				} else if next != tickets[step.release] {
+					t.Fatalf("step %d: released ticket is not %s", i, step.release)
+				}
+			}
+			if held := committer.Held(); held != test.held {
+				t.Fatalf("held = %d, want %d", held, test.held)
+			}
+			if pending := committer.Pending(); pending != test.pending {
+				t.Fatalf("pending = %d, want %d", pending, test.pending)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/namnv2496/crawler/internal/entity"
+	"github.com/namnv2496/crawler/internal/pkg/logging"
+	"github.com/namnv2496/crawler/internal/repository/schedulerservice"
+	"github.com/namnv2496/crawler/internal/service/mq"
+	"github.com/segmentio/kafka-go"
+)
+
+// fetchRetryDelay waits after a failed fetch before the next one
+const fetchRetryDelay = time.Second
+
+type IQueueDispatcher interface {
+	// Run consumes the queues until ctx is done, then waits for the crawls in flight
+	Run(ctx context.Context)
+	// Stats of every queue, in the order of the scheduler
+	Stats() []entity.QueueStats
+}
+
+// queueState is a consumed queue, current is its smooth weighted round-robin counter.
+// The spec of the queue changes on reload, its topic does not: a new topic is a new queue.
+type queueState struct {
+	spec      atomic.Pointer[entity.Queue]
+	reader    mq.IReader
+	committer *mq.Committer
+	// waiting is the message fetched ahead, released are the held messages whose partition freed a slot
+	waiting   chan *mq.Ticket
+	released  chan *mq.Ticket
+	heldLimit int
+	inFlight  atomic.Int32
+	processed atomic.Int64
+	current   int
+	// stop ends the fetch of a removed queue, running holds its reader open until its crawls are done
+	stop    context.CancelFunc
+	running sync.WaitGroup
+}
+
+// queueDispatcher shares the workers between the queues. Every queue fetches one message ahead at
+// its rate limit, a free worker goes to the queue with a waiting message by smooth weighted
+// round-robin: when the queues are backlogged the priority queue gets its weight share of the
+// workers, and a message arriving on it is picked before the waiting messages of the lighter queues.
+// The queues are loaded from the scheduler and reloaded every refresh interval.
+// A message is committed once it is crawled, in the order of its partition, see mq.Committer. A message
+// of a partition running as many messages as its concurrency is held, it does not stop the fetch of
+// the other partitions, and runs before the fetched messages once a message of its partition is done.
+type queueDispatcher struct {
+	conf             *configs.Config
+	consumer         mq.IConsumer
+	crawlerService   ICrawlerService
+	schedulerService schedulerservice.ISchedulerService
+	deadLetter       mq.IDeadLetterProducer
+	// mutex guards queues and the round-robin counters
+	mutex   sync.Mutex
+	queues  []*queueState
+	workers chan struct{}
+	// wakeup is signaled when a message is fetched, a crawl is done or the queues change
+	wakeup    chan struct{}
+	waitGroup sync.WaitGroup
+	// fetchers are the fetch goroutines, they close the readers with their last commits
+	fetchers sync.WaitGroup
+}
+
+func NewQueueDispatcher(
+	conf *configs.Config,
+	consumer mq.IConsumer,
+	crawlerService ICrawlerService,
+	schedulerService schedulerservice.ISchedulerService,
+	deadLetter mq.IDeadLetterProducer,
+) *queueDispatcher {
+	workers := conf.KafkaConsumerConfig.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	return &queueDispatcher{
+		conf:             conf,
+		consumer:         consumer,
+		crawlerService:   crawlerService,
+		schedulerService: schedulerService,
+		deadLetter:       deadLetter,
+		workers:          make(chan struct{}, workers),
+		wakeup:           make(chan struct{}, 1),
+	}
+}
+
+var _ IQueueDispatcher = &queueDispatcher{}
+
+func (_self *queueDispatcher) Run(ctx context.Context) {
+	ctx = logging.InjectTraceId(ctx)
+	logging.ResetPrefix(ctx, "QueueDispatcher")
+	queues, err := _self.schedulerService.GetQueues(ctx)
+	if err != nil {
+		logging.Error(ctx, "load queues from the scheduler failed, consume the queues of the config: %s", err.Error())
+		queues = _self.consumer.Queues()
+	}
+	_self.setQueues(ctx, queues)
+	go _self.refresh(ctx)
+	for {
+		select {
+		case _self.workers <- struct{}{}:
+		case <-ctx.Done():
+			_self.drain(ctx)
+			return
+		}
+		state, ticket, ok := _self.next(ctx)
+		if !ok {
+			<-_self.workers
+			_self.drain(ctx)
+			return
+		}
+		_self.waitGroup.Add(1)
+		go func() {
+			defer func() {
+				state.inFlight.Add(-1)
+				state.processed.Add(1)
+				state.running.Done()
+				<-_self.workers
+				_self.wake()
+				_self.waitGroup.Done()
+			}()
+			// a started crawl is finished when ctx is done
+			_self.process(context.WithoutCancel(ctx), state, ticket)
+		}()
+	}
+}
+
+// drain waits for the crawls in flight and their commits, then for the readers to be closed
+func (_self *queueDispatcher) drain(ctx context.Context) {
+	logging.Info(ctx, "stop fetching, wait for the crawls in flight")
+	_self.waitGroup.Wait()
+	_self.fetchers.Wait()
+	logging.Info(ctx, "queues are drained")
+}
+
+// refresh reloads the queues from the scheduler, the queues are kept when the scheduler is unreachable.
+// A zero interval disables the reload.
+func (_self *queueDispatcher) refresh(ctx context.Context) {
+	if _self.conf.KafkaConsumerConfig.QueueRefreshInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(_self.conf.KafkaConsumerConfig.QueueRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		queues, err := _self.schedulerService.GetQueues(ctx)
+		if err != nil {
+			logging.Error(ctx, "reload queues failed: %s", err.Error())
+			continue
+		}
+		_self.setQueues(ctx, queues)
+	}
+}
+
+// setQueues starts the new queues, updates the spec of the kept queues and stops the removed ones.
+// The fetched messages of a removed queue are not committed, the group reads them again.
+func (_self *queueDispatcher) setQueues(ctx context.Context, queues []entity.Queue) {
+	_self.mutex.Lock()
+	defer _self.mutex.Unlock()
+	current := make(map[string]*queueState, len(_self.queues))
+	for _, state := range _self.queues {
+		current[state.spec.Load().Name] = state
+	}
+	states := make([]*queueState, 0, len(queues))
+	for _, queue := range queues {
+		state, ok := current[queue.Name]
+		if ok && state.spec.Load().Topic == queue.Topic {
+			delete(current, queue.Name)
+			if *state.spec.Load() != queue {
+				logging.Info(ctx, "queue %s is updated: weight %d, concurrency %d, rate limit %d", queue.Name, queue.Weight, queue.Concurrency, queue.RateLimit)
+			}
+			state.spec.Store(&queue)
+			states = append(states, state)
+			continue
+		}
+		states = append(states, _self.startQueue(ctx, queue))
+		logging.Info(ctx, "queue %s is consumed from topic %s", queue.Name, queue.Topic)
+	}
+	for _, state := range current {
+		state.stop()
+		logging.Info(ctx, "queue %s is not consumed anymore", state.spec.Load().Name)
+	}
+	_self.queues = states
+	_self.wake()
+}
+
+func (_self *queueDispatcher) startQueue(ctx context.Context, queue entity.Queue) *queueState {
+	reader := _self.consumer.NewReader(queue.Topic)
+	heldLimit := _self.conf.KafkaConsumerConfig.HeldMessages
+	if heldLimit < 1 {
+		heldLimit = 1
+	}
+	state := &queueState{
+		reader:    reader,
+		committer: mq.NewCommitter(reader, _self.conf.KafkaConsumerConfig.PartitionConcurrency),
+		waiting:   make(chan *mq.Ticket, 1),
+		// the held and released messages together stay under the limit, a release never blocks
+		released:  make(chan *mq.Ticket, heldLimit),
+		heldLimit: heldLimit,
+	}
+	state.spec.Store(&queue)
+	fetchCtx, stop := context.WithCancel(ctx)
+	state.stop = stop
+	_self.fetchers.Add(1)
+	go _self.fetch(fetchCtx, state)
+	return state
+}
+
+// fetch reads the queue ahead of the workers at its rate limit, it blocks while a message is waiting.
+// The messages of a full partition are held by the committer, the fetch skips its ticks while the
+// queue holds heldLimit messages. The reader is closed once the queue is stopped and its crawls are done.
+func (_self *queueDispatcher) fetch(ctx context.Context, state *queueState) {
+	defer _self.fetchers.Done()
+	defer func() {
+		state.running.Wait()
+		if err := state.reader.Close(); err != nil {
+			logging.Error(ctx, "close reader of queue %s failed: %s", state.spec.Load().Name, err.Error())
+		}
+	}()
+	interval := fetchInterval(state.spec.Load())
+	rateLimiter := time.NewTicker(interval)
+	defer rateLimiter.Stop()
+	for {
+		select {
+		case <-rateLimiter.C:
+		case <-ctx.Done():
+			return
+		}
+		if next := fetchInterval(state.spec.Load()); next != interval {
+			interval = next
+			rateLimiter.Reset(interval)
+		}
+		if state.committer.Held()+len(state.released) >= state.heldLimit {
+			continue
+		}
+		message, err := state.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logging.Error(ctx, "fetch queue %s failed: %s", state.spec.Load().Name, err.Error())
+			time.Sleep(fetchRetryDelay)
+			continue
+		}
+		ticket, ready := state.committer.Begin(message)
+		if !ready {
+			continue
+		}
+		select {
+		case state.waiting <- ticket:
+			_self.wake()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func fetchInterval(queue *entity.Queue) time.Duration {
+	rateLimit := queue.RateLimit
+	if rateLimit < 1 {
+		rateLimit = mq.DefaultRateLimit
+	}
+	return time.Second / time.Duration(rateLimit)
+}
+
+// next waits for a message of a queue under its concurrency
+func (_self *queueDispatcher) next(ctx context.Context) (*queueState, *mq.Ticket, bool) {
+	for {
+		if state, ticket, ok := _self.take(); ok {
+			return state, ticket, true
+		}
+		select {
+		case <-_self.wakeup:
+		case <-ctx.Done():
+			return nil, nil, false
+		}
+	}
+}
+
+// take picks a queue and takes its oldest message, a released one before the waiting one. The crawl
+// is counted as running before the queue can be removed, so the reader of a removed queue stays open
+// until the commit.
+func (_self *queueDispatcher) take() (*queueState, *mq.Ticket, bool) {
+	_self.mutex.Lock()
+	defer _self.mutex.Unlock()
+	state := _self.pick()
+	if state == nil {
+		return nil, nil, false
+	}
+	// only the dispatcher takes the messages, a picked queue has one released or waiting
+	var ticket *mq.Ticket
+	select {
+	case ticket = <-state.released:
+	default:
+		ticket = <-state.waiting
+	}
+	state.inFlight.Add(1)
+	state.running.Add(1)
+	return state, ticket, true
+}
+
+// pick is the smooth weighted round-robin of nginx over the queues which can run a message
+func (_self *queueDispatcher) pick() *queueState {
+	var best *queueState
+	total := 0
+	for _, state := range _self.queues {
+		spec := state.spec.Load()
+		if len(state.waiting)+len(state.released) == 0 || int(state.inFlight.Load()) >= spec.Concurrency {
+			continue
+		}
+		state.current += spec.Weight
+		total += spec.Weight
+		if best == nil || state.current > best.current {
+			best = state
+		}
+	}
+	if best != nil {
+		best.current -= total
+	}
+	return best
+}
+
+func (_self *queueDispatcher) wake() {
+	select {
+	case _self.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// process crawls the message and commits it. A poison message is dead-lettered, or skipped without a
+// dead-letter topic, and committed: it would fail the same way again and hold its partition back.
+// The held message of the partition which takes the freed slot is released to the dispatcher.
+func (_self *queueDispatcher) process(ctx context.Context, state *queueState, ticket *mq.Ticket) {
+	message := ticket.Message
+	logging.Debug(ctx, "message at topic:%v partition:%v offset:%v\t%s = %s\n", message.Topic, message.Partition, message.Offset, string(message.Key), string(message.Value))
+	if err := _self.crawl(ctx, message); err != nil {
+		queue := state.spec.Load().Name
+		logging.Error(ctx, "poison message at topic:%v partition:%v offset:%v: %s", message.Topic, message.Partition, message.Offset, err.Error())
+		if err := _self.deadLetter.Send(ctx, entity.DeadLetter{
+			Queue:     queue,
+			Topic:     message.Topic,
+			Partition: message.Partition,
+			Offset:    message.Offset,
+			Key:       message.Key,
+			Value:     message.Value,
+			Error:     err.Error(),
+			Attempts:  1,
+		}); err != nil {
+			logging.Error(ctx, "dead-letter topic:%v partition:%v offset:%v failed, skip it: %s", message.Topic, message.Partition, message.Offset, err.Error())
+		}
+	}
+	next, err := state.committer.Done(ctx, ticket)
+	if err != nil {
+		logging.Error(ctx, "commit topic:%v partition:%v offset:%v failed: %s", message.Topic, message.Partition, message.Offset, err.Error())
+	}
+	if next != nil {
+		state.released <- next
+	}
+}
+
+// crawl returns an error for the poison messages only, the crawler retries the failed crawls itself
+func (_self *queueDispatcher) crawl(ctx context.Context, message kafka.Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("crawl panicked: %v", r)
+		}
+	}()
+	var url entity.CrawlerEvent
+	if err := json.Unmarshal(message.Value, &url); err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	if err := _self.crawlerService.Crawl(ctx, url); err != nil {
+		logging.Error(ctx, err.Error())
+	}
+	return nil
+}
+
+func (_self *queueDispatcher) Stats() []entity.QueueStats {
+	_self.mutex.Lock()
+	queues := _self.queues
+	_self.mutex.Unlock()
+	stats := make([]entity.QueueStats, 0, len(queues))
+	for _, state := range queues {
+		readerStats := state.reader.Stats()
+		waiting := len(state.waiting) + len(state.released)
+		held := state.committer.Held()
+		inFlight := int(state.inFlight.Load())
+		stats = append(stats, entity.QueueStats{
+			Queue:     *state.spec.Load(),
+			Lag:       readerStats.Lag + readerStats.QueueLength + int64(waiting) + int64(held) + int64(inFlight),
+			Pending:   state.committer.Pending(),
+			Waiting:   waiting,
+			Held:      held,
+			InFlight:  inFlight,
+			Processed: state.processed.Load(),
+		})
+	}
+	return stats
+}
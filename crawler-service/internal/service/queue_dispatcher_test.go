@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/namnv2496/crawler/internal/configs"
+	"github.com/namnv2496/crawler/internal/entity"
+	"github.com/namnv2496/crawler/internal/service/mq"
+	"github.com/segmentio/kafka-go"
+)
+
+// eventLog is the order in which the fakes saw the crawls, the dead letters, the commits and the close
+type eventLog struct {
+	mutex   sync.Mutex
+	entries []string
+}
+
+func (l *eventLog) add(entry string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+func (l *eventLog) snapshot() []string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return append([]string(nil), l.entries...)
+}
+
+// fakeBroker is an in-memory topic read by one member of the group
+type fakeBroker struct {
+	log      *eventLog
+	mutex    sync.Mutex
+	messages []kafka.Message
+}
+
+func (b *fakeBroker) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	b.mutex.Lock()
+	if len(b.messages) > 0 {
+		message := b.messages[0]
+		b.messages = b.messages[1:]
+		b.mutex.Unlock()
+		return message, nil
+	}
+	b.mutex.Unlock()
+	<-ctx.Done()
+	return kafka.Message{}, ctx.Err()
+}
+
+func (b *fakeBroker) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	for _, msg := range msgs {
+		b.log.add("commit " + position(msg))
+	}
+	return nil
+}
+
+func (b *fakeBroker) Stats() kafka.ReaderStats { return kafka.ReaderStats{} }
+
+func (b *fakeBroker) Close() error {
+	b.log.add("close")
+	return nil
+}
+
+type fakeConsumer struct {
+	reader mq.IReader
+}
+
+func (c *fakeConsumer) Queues() []entity.Queue {
+	return []entity.Queue{{Name: "normal", Topic: "normal", Weight: 1, Concurrency: 10, RateLimit: 1000}}
+}
+
+func (c *fakeConsumer) NewReader(topic string) mq.IReader { return c.reader }
+
+// fakeScheduler is unreachable, the dispatcher consumes the queues of the consumer
+type fakeScheduler struct{}
+
+func (fakeScheduler) ReportRunResult(ctx context.Context, result *entity.RunResult) error { return nil }
+
+func (fakeScheduler) EventExists(ctx context.Context, id int64) (bool, error) { return true, nil }
+
+func (fakeScheduler) GetQueues(ctx context.Context) ([]entity.Queue, error) {
+	return nil, errors.New("scheduler is unreachable")
+}
+
+// fakeCrawler crawls by the url of the event: "panic" panics, "slow" takes a while and "gate" waits for
+// the gate. The description of the event is the position of its message.
+type fakeCrawler struct {
+	log     *eventLog
+	gate    chan struct{}
+	started chan string
+}
+
+func (c *fakeCrawler) Crawl(ctx context.Context, event entity.CrawlerEvent) error {
+	c.started <- event.Description
+	switch event.Url {
+	case "panic":
+		panic("crawl of " + event.Description)
+	case "slow":
+		time.Sleep(50 * time.Millisecond)
+	case "gate":
+		<-c.gate
+	}
+	c.log.add("done " + event.Description)
+	return nil
+}
+
+func (c *fakeCrawler) Preview(ctx context.Context, event entity.CrawlerEvent) *entity.CrawlPreview {
+	return nil
+}
+
+type fakeDeadLetter struct {
+	log *eventLog
+}
+
+func (d *fakeDeadLetter) Send(ctx context.Context, deadLetter entity.DeadLetter) error {
+	d.log.add(fmt.Sprintf("dead %d:%d", deadLetter.Partition, deadLetter.Offset))
+	return nil
+}
+
+func position(msg kafka.Message) string {
+	return fmt.Sprintf("%d:%d", msg.Partition, msg.Offset)
+}
+
+// message of an event crawled by url, "poison" is a message which is not JSON
+func message(partition int, offset int64, url string) kafka.Message {
+	msg := kafka.Message{Topic: "normal", Partition: partition, Offset: offset}
+	if url == "poison" {
+		msg.Value = []byte("{")
+		return msg
+	}
+	msg.Value, _ = json.Marshal(entity.CrawlerEvent{Url: url, Description: position(msg), IsActive: true})
+	return msg
+}
+
+func newTestDispatcher(messages []kafka.Message) (*queueDispatcher, *fakeCrawler, *eventLog) {
+	log := &eventLog{}
+	conf := &configs.Config{
+		KafkaConsumerConfig: configs.KafkaConsumerConfig{
+			Workers:              4,
+			PartitionConcurrency: 2,
+			HeldMessages:         10,
+		},
+	}
+	crawler := &fakeCrawler{log: log, gate: make(chan struct{}), started: make(chan string, len(messages))}
+	broker := &fakeBroker{log: log, messages: messages}
+	dispatcher := NewQueueDispatcher(conf, &fakeConsumer{reader: broker}, crawler, fakeScheduler{}, &fakeDeadLetter{log: log})
+	return dispatcher, crawler, log
+}
+
+// waitFor polls the log until done holds
+func waitFor(t *testing.T, log *eventLog, done func(entries []string) bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !done(log.snapshot()) {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out, log: %v", log.snapshot())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func contains(entries []string, entry string) bool {
+	return index(entries, entry) >= 0
+}
+
+func index(entries []string, entry string) int {
+	for i, e := range entries {
+		if e == entry {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkCommits fails a commit of a partition before every message up to its offset is crawled or
+// dead-lettered, and a commit which does not move the offset of its partition forward
+func checkCommits(t *testing.T, entries []string, messages []kafka.Message) {
+	t.Helper()
+	finished := make(map[string]bool)
+	committed := make(map[int]int64)
+	for _, entry := range entries {
+		kind, pos, _ := strings.Cut(entry, " ")
+		switch kind {
+		case "done", "dead":
+			finished[pos] = true
+		case "commit":
+			var partition int
+			var offset int64
+			fmt.Sscanf(pos, "%d:%d", &partition, &offset)
+			if last, ok := committed[partition]; ok && offset <= last {
+				t.Errorf("commit %s does not move partition %d past %d", pos, partition, last)
+			}
+			committed[partition] = offset
+			for _, msg := range messages {
+				if msg.Partition == partition && msg.Offset <= offset && !finished[position(msg)] {
+					t.Errorf("commit %s before %s is processed", pos, position(msg))
+				}
+			}
+		}
+	}
+}
+
+func TestQueueDispatcherCommits(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []kafka.Message
+		// lastCommits are the last positions committed, deadLetters the positions dead-lettered
+		lastCommits []string
+		deadLetters []string
+		// before are the entries logged before others
+		before [][2]string
+	}{
+		{
+			name: "partitions finishing out of order are committed in the order of each partition",
+			messages: []kafka.Message{
+				message(0, 0, "slow"),
+				message(1, 0, "fast"),
+				message(0, 1, "fast"),
+				message(1, 1, "slow"),
+				message(0, 2, "fast"),
+				message(1, 2, "fast"),
+			},
+			lastCommits: []string{"0:2", "1:2"},
+		},
+		{
+			name: "a slow partition holds its messages while the other partition goes on",
+			messages: []kafka.Message{
+				message(0, 0, "slow"),
+				message(0, 1, "slow"),
+				message(0, 2, "fast"),
+				message(0, 3, "fast"),
+				message(1, 0, "fast"),
+				message(1, 1, "fast"),
+			},
+			lastCommits: []string{"0:3", "1:1"},
+			// the held messages of partition 0 do not stop the fetch of partition 1
+			before: [][2]string{{"commit 1:1", "done 0:0"}},
+		},
+		{
+			name: "poison messages are dead-lettered and committed",
+			messages: []kafka.Message{
+				message(0, 0, "poison"),
+				message(0, 1, "panic"),
+				message(0, 2, "fast"),
+				message(1, 0, "panic"),
+			},
+			lastCommits: []string{"0:2", "1:0"},
+			deadLetters: []string{"0:0", "0:1", "1:0"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dispatcher, _, log := newTestDispatcher(test.messages)
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan struct{})
+			go func() {
+				defer close(stopped)
+				dispatcher.Run(ctx)
+			}()
+			waitFor(t, log, func(entries []string) bool {
+				for _, commit := range test.lastCommits {
+					if !contains(entries, "commit "+commit) {
+						return false
+					}
+				}
+				return true
+			})
+			cancel()
+			<-stopped
+			entries := log.snapshot()
+			checkCommits(t, entries, test.messages)
+			for _, deadLetter := range test.deadLetters {
+				if !contains(entries, "dead "+deadLetter) {
+					t.Errorf("%s is not dead-lettered, log: %v", deadLetter, entries)
+				}
+			}
+			for _, pair := range test.before {
+				if index(entries, pair[0]) > index(entries, pair[1]) {
+					t.Errorf("%s is logged after %s, log: %v", pair[0], pair[1], entries)
+				}
+			}
+			if entries[len(entries)-1] != "close" {
+				t.Errorf("reader is not closed last, log: %v", entries)
+			}
+		})
+	}
+}
+
+func TestQueueDispatcherDrain(t *testing.T) {
+	messages := []kafka.Message{
+		message(0, 0, "gate"),
+		message(0, 1, "fast"),
+	}
+	dispatcher, crawler, log := newTestDispatcher(messages)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		dispatcher.Run(ctx)
+	}()
+	waitFor(t, log, func(entries []string) bool {
+		return contains(entries, "done 0:1")
+	})
+	// both messages of the partition run at once
+	<-crawler.started
+	<-crawler.started
+	// the stop of the worker: the fetch ends and the crawl in flight is waited for
+	cancel()
+	select {
+	case <-stopped:
+		t.Fatal("dispatcher stopped before the crawl in flight is done")
+	case <-time.After(50 * time.Millisecond):
+	}
+	for _, entry := range log.snapshot() {
+		if strings.HasPrefix(entry, "commit") {
+			t.Fatalf("%s while 0:0 is in flight", entry)
+		}
+	}
+	close(crawler.gate)
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("dispatcher is not drained")
+	}
+	entries := log.snapshot()
+	checkCommits(t, entries, messages)
+	want := []string{"done 0:0", "commit 0:1", "close"}
+	if got := entries[len(entries)-len(want):]; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("log ends with %v, want %v", got, want)
+	}
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/hibiken/asynq"
 	"github.com/namnv2496/crawler/internal/entity"
+	"github.com/namnv2496/crawler/internal/pkg/logging"
 	"github.com/namnv2496/crawler/internal/service/mq"
 )
 
@@ -16,15 +18,18 @@ type IRetryWorker interface {
 type retryWorker struct {
 	asynqConsumer mq.IAsynqConsumer
 	crawlService  ICrawlerService
+	deadLetter    mq.IDeadLetterProducer
 }
 
 func NewRetryWorker(
 	asynqConsumer mq.IAsynqConsumer,
 	crawlService ICrawlerService,
+	deadLetter mq.IDeadLetterProducer,
 ) IRetryWorker {
 	return &retryWorker{
 		asynqConsumer: asynqConsumer,
 		crawlService:  crawlService,
+		deadLetter:    deadLetter,
 	}
 }
 
@@ -38,7 +43,16 @@ func (_self *retryWorker) Start(ctx context.Context) {
 func (_self *retryWorker) RetryEventHandler(ctx context.Context, task *asynq.Task) error {
 	var event entity.CrawlerEvent
 	if err := json.Unmarshal(task.Payload(), &event); err != nil {
-		return err
+		// the payload never reads, it is kept instead of retried
+		if err := _self.deadLetter.Send(ctx, entity.DeadLetter{
+			Value:    task.Payload(),
+			Error:    fmt.Sprintf("unmarshal retry event: %s", err.Error()),
+			Attempts: 1,
+		}); err != nil {
+			logging.Error(ctx, "send dead letter of task %s failed: %s", task.Type(), err.Error())
+			return err
+		}
+		return nil
 	}
 	return _self.crawlService.Crawl(ctx, event)
 }
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/namnv2496/crawler/internal/entity"
+)
+
+// errPrivateAddress is the connection to an address of the private networks, the crawls never reach them
+var errPrivateAddress = errors.New("private address is refused")
+
+// newCrawlClient is the client of the crawls, it refuses the private addresses when it connects. The
+// scheduler checks the host when the event is saved, a host whose records changed since is refused here.
+func newCrawlClient(allowPrivateIPs bool) *http.Client {
+	if allowPrivateIPs {
+		return http.DefaultClient
+	}
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   refusePrivate,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// the dial checks the address of the target, a proxy would hide it
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Transport: transport}
+}
+
+// refusePrivate checks the resolved address of every connection, redirects included
+func refusePrivate(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if isPrivate(addr) {
+		return fmt.Errorf("%w: %s", errPrivateAddress, host)
+	}
+	return nil
+}
+
+// curlGuardArgs check the host of every --url of the command and pin curl to the checked addresses,
+// curl would resolve the hosts again otherwise. The redirects, the globs of the URLs and the proxies of
+// the environment are turned off, they would connect to an address which is not checked.
+func curlGuardArgs(ctx context.Context, urls []string) ([]string, error) {
+	args := []string{"--proto", "=http,https", "--max-redirs", "0", "--noproxy", "*", "--globoff"}
+	pinned := make(map[string]bool)
+	for _, rawURL := range urls {
+		parsed, err := neturl.Parse(rawURL)
+		if err != nil || parsed.Hostname() == "" {
+			return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("invalid --url %q of the curl command", rawURL))
+		}
+		host := strings.TrimSuffix(parsed.Hostname(), ".")
+		if addr, ok := literalAddr(host); ok {
+			if isPrivate(addr) {
+				return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("%w: %s", errPrivateAddress, host))
+			}
+			continue
+		}
+		addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil || len(addrs) == 0 {
+			return nil, entity.NewCrawlError(entity.ErrorClassConnection, fmt.Errorf("failed to resolve %s: %v", host, err))
+		}
+		for _, addr := range addrs {
+			if isPrivate(addr) {
+				return nil, entity.NewCrawlError(entity.ErrorClassInvalid, fmt.Errorf("%w: %s resolves to %s", errPrivateAddress, host, addr))
+			}
+		}
+		port := parsed.Port()
+		if port == "" {
+			port = "80"
+			if strings.EqualFold(parsed.Scheme, "https") {
+				port = "443"
+			}
+		}
+		target := parsed.Hostname() + ":" + port
+		if pinned[target] {
+			continue
+		}
+		pinned[target] = true
+		address := addrs[0].Unmap().String()
+		if addrs[0].Unmap().Is6() {
+			address = "[" + address + "]"
+		}
+		args = append(args, "--resolve", target+":"+address)
+	}
+	return args, nil
+}
+
+// isPrivate is true for the addresses of the machine, of the local networks and of the cloud metadata,
+// the scheduler rejects the same addresses when the event is saved.
+// isPrivate, literalAddr and parseNumericIPv4 are twins of scheduler-service/internal/validator/target.go,
+// the two services do not share a module: change both together.
+func isPrivate(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() ||
+		// carrier-grade NAT is private to the provider
+		netip.MustParsePrefix("100.64.0.0/10").Contains(addr)
+}
+
+// literalAddr reads a host which is an address: an IPv6 or dotted IPv4 address, or a numeric IPv4 form
+func literalAddr(host string) (netip.Addr, bool) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, true
+	}
+	return parseNumericIPv4(host)
+}
+
+// parseNumericIPv4 reads the other forms of IPv4 which inet_aton, curl and the browsers accept: 1 to 4
+// parts in decimal, octal (0 prefix) or hex (0x prefix), the last part fills the remaining bytes,
+// e.g. 2130706433, 0x7f000001, 0177.0.0.1 and 127.1 are 127.0.0.1
+func parseNumericIPv4(host string) (netip.Addr, bool) {
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+	values := make([]uint64, len(parts))
+	for i, part := range parts {
+		base := 10
+		if rest, ok := strings.CutPrefix(strings.ToLower(part), "0x"); ok {
+			part, base = rest, 16
+		} else if len(part) > 1 && part[0] == '0' {
+			part, base = part[1:], 8
+		}
+		value, err := strconv.ParseUint(part, base, 32)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		values[i] = value
+	}
+	last := len(values) - 1
+	if values[last] >= 1<<(8*(4-last)) {
+		return netip.Addr{}, false
+	}
+	number := uint32(values[last])
+	for i, value := range values[:last] {
+		if value > 255 {
+			return netip.Addr{}, false
+		}
+		number |= uint32(value) << (24 - 8*i)
+	}
+	return netip.AddrFrom4([4]byte{byte(number >> 24), byte(number >> 16), byte(number >> 8), byte(number)}), true
+}
@@ -1,7 +1,6 @@
 package service
 
 import (
-	"fmt"
 	"sync"
 	"sync/atomic"
 
@@ -9,7 +8,9 @@ import (
 )
 
 type IWorkerPool interface {
-	Execute(crawlFunc func() (any, error), depth int, statscallback StatsCallback, outputCallback OuputCallback)
+	// Execute runs crawlFunc on a worker and returns once outputCallback is done, the retries are left
+	// to the retry policy of the event
+	Execute(crawlFunc func() (any, error), statscallback StatsCallback, outputCallback OuputCallback)
 }
 
 type workerPool struct {
@@ -17,74 +18,68 @@ type workerPool struct {
 	pagesCrawled  atomic.Int32
 	activeWorkers atomic.Int32
 	queueSize     atomic.Int32
-	waitGroup     sync.WaitGroup
-	queue         chan func() (any, error)
+	startOnce     sync.Once
+	queue         chan poolTask
 }
 
 type StatsCallback func(crawled, active, queued int32)
 type OuputCallback func(output any, err error)
 
+type poolTask struct {
+	crawlFunc      func() (any, error)
+	statscallback  StatsCallback
+	outputCallback OuputCallback
+	done           chan struct{}
+}
+
 func NewWorkerPool(
 	conf *configs.Config,
 ) IWorkerPool {
 	return &workerPool{
 		workers: conf.AppConfig.Workers,
-		queue:   make(chan func() (any, error), 1000),
+		queue:   make(chan poolTask, 1000),
 	}
 }
 
-func (_self *workerPool) Execute(crawlFunc func() (any, error), depth int, statscallback StatsCallback, outputCallback OuputCallback) {
-	// Initialize the pool
-	_self.queue <- crawlFunc
-	_self.queueSize.Add(1)
-
-	// Start workers
-	for i := 0; i < _self.workers; i++ {
-		_self.waitGroup.Add(1)
-		go _self.worker(depth, statscallback, outputCallback)
+func (_self *workerPool) Execute(crawlFunc func() (any, error), statscallback StatsCallback, outputCallback OuputCallback) {
+	// the workers are started by the first call and live as long as the pool
+	_self.startOnce.Do(func() {
+		workers := max(_self.workers, 1)
+		for i := 0; i < workers; i++ {
+			go _self.worker()
+		}
+	})
+	task := poolTask{
+		crawlFunc:      crawlFunc,
+		statscallback:  statscallback,
+		outputCallback: outputCallback,
+		done:           make(chan struct{}),
 	}
-
-	// Start a goroutine to wait for completion
-	go func() {
-		_self.waitGroup.Wait()
-		close(_self.queue)
-	}()
+	_self.queueSize.Add(1)
+	_self.queue <- task
+	<-task.done
 }
 
-func (_self *workerPool) worker(depth int, statscallback StatsCallback, outputCallback OuputCallback) {
-	defer _self.waitGroup.Done()
-
-	for urlExecute := range _self.queue {
+func (_self *workerPool) worker() {
+	for task := range _self.queue {
 		_self.activeWorkers.Add(1)
-		statscallback(_self.pagesCrawled.Load(), _self.activeWorkers.Load(), _self.queueSize.Load())
-		var output any
-		var err error
-		for retryCount := 0; retryCount <= depth; retryCount++ {
-			output, err = urlExecute()
-			if err == nil || retryCount == depth {
-				break
-			}
-			fmt.Printf("Retry attempt %d/%d for execution: %v\n", retryCount+1, depth, err)
-		}
-
+		_self.reportStats(task.statscallback)
+		output, err := task.crawlFunc()
 		if err != nil {
-			outputCallback(nil, fmt.Errorf("error executing curl command: %v", err))
+			task.outputCallback(nil, err)
 		} else {
-			outputCallback(output, nil)
+			task.outputCallback(output, nil)
 		}
-		// Process URL
-		// links := _self.crawlURL(url, depth)
-		// // Add new URLs to queue
-		// for _, link := range links {
-		// 	if _, visited := _self.visited.LoadOrStore(link, true); !visited {
-		// 		_self.queue <- link
-		// 		_self.queueSize.Add(1)
-		// 	}
-		// }
-
 		_self.pagesCrawled.Add(1)
 		_self.queueSize.Add(-1)
 		_self.activeWorkers.Add(-1)
+		_self.reportStats(task.statscallback)
+		close(task.done)
+	}
+}
+
+func (_self *workerPool) reportStats(statscallback StatsCallback) {
+	if statscallback != nil {
 		statscallback(_self.pagesCrawled.Load(), _self.activeWorkers.Load(), _self.queueSize.Load())
 	}
 }
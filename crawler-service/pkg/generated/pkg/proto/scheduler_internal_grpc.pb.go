@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: pkg/proto/scheduler_internal.proto
+
+package schedulerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SchedulerInternalService_ReportRunResult_FullMethodName = "/scheduler.v1.SchedulerInternalService/ReportRunResult"
+	SchedulerInternalService_EventExists_FullMethodName     = "/scheduler.v1.SchedulerInternalService/EventExists"
+	SchedulerInternalService_GetQueues_FullMethodName       = "/scheduler.v1.SchedulerInternalService/GetQueues"
+)
+
+// SchedulerInternalServiceClient is the client API for SchedulerInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SchedulerInternalServiceClient interface {
+	// ReportRunResult finishes a run, reporting a finished run again is a no-op so it is safe to retry
+	ReportRunResult(ctx context.Context, in *ReportRunResultRequest, opts ...grpc.CallOption) (*ReportRunResultResponse, error)
+	// EventExists lets the workers drop the retries of deleted events, it sees the events of every team
+	EventExists(ctx context.Context, in *EventExistsRequest, opts ...grpc.CallOption) (*EventExistsResponse, error)
+	// GetQueues returns every queue, the workers poll it to follow the changes of the queues
+	GetQueues(ctx context.Context, in *GetQueuesRequest, opts ...grpc.CallOption) (*GetQueuesResponse, error)
+}
+
+type schedulerInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulerInternalServiceClient(cc grpc.ClientConnInterface) SchedulerInternalServiceClient {
+	return &schedulerInternalServiceClient{cc}
+}
+
+func (c *schedulerInternalServiceClient) ReportRunResult(ctx context.Context, in *ReportRunResultRequest, opts ...grpc.CallOption) (*ReportRunResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportRunResultResponse)
+	err := c.cc.Invoke(ctx, SchedulerInternalService_ReportRunResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerInternalServiceClient) EventExists(ctx context.Context, in *EventExistsRequest, opts ...grpc.CallOption) (*EventExistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventExistsResponse)
+	err := c.cc.Invoke(ctx, SchedulerInternalService_EventExists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerInternalServiceClient) GetQueues(ctx context.Context, in *GetQueuesRequest, opts ...grpc.CallOption) (*GetQueuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueuesResponse)
+	err := c.cc.Invoke(ctx, SchedulerInternalService_GetQueues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulerInternalServiceServer is the server API for SchedulerInternalService service.
+// All implementations must embed UnimplementedSchedulerInternalServiceServer
+// for forward compatibility.
+type SchedulerInternalServiceServer interface {
+	// ReportRunResult finishes a run, reporting a finished run again is a no-op so it is safe to retry
+	ReportRunResult(context.Context, *ReportRunResultRequest) (*ReportRunResultResponse, error)
+	// EventExists lets the workers drop the retries of deleted events, it sees the events of every team
+	EventExists(context.Context, *EventExistsRequest) (*EventExistsResponse, error)
+	// GetQueues returns every queue, the workers poll it to follow the changes of the queues
+	GetQueues(context.Context, *GetQueuesRequest) (*GetQueuesResponse, error)
+	mustEmbedUnimplementedSchedulerInternalServiceServer()
+}
+
+// UnimplementedSchedulerInternalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchedulerInternalServiceServer struct{}
+
+func (UnimplementedSchedulerInternalServiceServer) ReportRunResult(context.Context, *ReportRunResultRequest) (*ReportRunResultResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportRunResult not implemented")
+}
+func (UnimplementedSchedulerInternalServiceServer) EventExists(context.Context, *EventExistsRequest) (*EventExistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EventExists not implemented")
+}
+func (UnimplementedSchedulerInternalServiceServer) GetQueues(context.Context, *GetQueuesRequest) (*GetQueuesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQueues not implemented")
+}
+func (UnimplementedSchedulerInternalServiceServer) mustEmbedUnimplementedSchedulerInternalServiceServer() {
+}
+func (UnimplementedSchedulerInternalServiceServer) testEmbeddedByValue() {}
+
+// UnsafeSchedulerInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulerInternalServiceServer will
+// result in compilation errors.
+type UnsafeSchedulerInternalServiceServer interface {
+	mustEmbedUnimplementedSchedulerInternalServiceServer()
+}
+
+func RegisterSchedulerInternalServiceServer(s grpc.ServiceRegistrar, srv SchedulerInternalServiceServer) {
+	// If the following call panics, it indicates UnimplementedSchedulerInternalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SchedulerInternalService_ServiceDesc, srv)
+}
+
+func _SchedulerInternalService_ReportRunResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRunResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerInternalServiceServer).ReportRunResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerInternalService_ReportRunResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerInternalServiceServer).ReportRunResult(ctx, req.(*ReportRunResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerInternalService_EventExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerInternalServiceServer).EventExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerInternalService_EventExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerInternalServiceServer).EventExists(ctx, req.(*EventExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerInternalService_GetQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerInternalServiceServer).GetQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerInternalService_GetQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerInternalServiceServer).GetQueues(ctx, req.(*GetQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchedulerInternalService_ServiceDesc is the grpc.ServiceDesc for SchedulerInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchedulerInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.v1.SchedulerInternalService",
+	HandlerType: (*SchedulerInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportRunResult",
+			Handler:    _SchedulerInternalService_ReportRunResult_Handler,
+		},
+		{
+			MethodName: "EventExists",
+			Handler:    _SchedulerInternalService_EventExists_Handler,
+		},
+		{
+			MethodName: "GetQueues",
+			Handler:    _SchedulerInternalService_GetQueues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/scheduler_internal.proto",
+}
@@ -8,5 +8,6 @@ func Execute() error {
 	}
 	rootCmd.AddCommand(serverCmd)
 	rootCmd.AddCommand(schedulerWorkerCmd)
+	rootCmd.AddCommand(syncCmd)
 	return rootCmd.Execute()
 }
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
-	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/validator"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/namnv2496/scheduler/internal/auth"
 	"github.com/namnv2496/scheduler/internal/configs"
 	"github.com/namnv2496/scheduler/internal/controller"
+	"github.com/namnv2496/scheduler/internal/ratelimit"
 	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/internal/repository/crawlerservice"
+	"github.com/namnv2496/scheduler/internal/repository/rulestore"
 	"github.com/namnv2496/scheduler/internal/service"
 	internalvalidator "github.com/namnv2496/scheduler/internal/validator"
 	crawlerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
@@ -44,8 +48,33 @@ func InvokeServer(invokers ...any) *fx.App {
 			fx.Annotate(repository.NewSchedulerEventRepository, fx.As(new(repository.ISchedulerEventRepository))),
 			fx.Annotate(service.NewSchedulerEventService, fx.As(new(service.ISchedulerEventService))),
 			fx.Annotate(controller.NewSchedulerEventController, fx.As(new(crawlerv1.SchedulerEventServiceServer))),
+			// workflow
+			fx.Annotate(repository.NewOutboxRepository, fx.As(new(repository.IOutboxRepository))),
+			fx.Annotate(repository.NewEventRunRepository, fx.As(new(repository.IEventRunRepository))),
+			fx.Annotate(repository.NewWorkflowRepository, fx.As(new(repository.IWorkflowRepository))),
+			fx.Annotate(repository.NewTenantRepository, fx.As(new(repository.ITenantRepository))),
+			fx.Annotate(repository.NewQueueRepository, fx.As(new(repository.IQueueRepository))),
+			fx.Annotate(repository.NewWorkflowRunRepository, fx.As(new(repository.IWorkflowRunRepository))),
+			fx.Annotate(service.NewWorkflowService, fx.As(new(service.IWorkflowService))),
+			fx.Annotate(service.NewEventRunService, fx.As(new(service.IEventRunService))),
+			fx.Annotate(crawlerservice.NewCrawlerService, fx.As(new(crawlerservice.ICrawlerService))),
+			fx.Annotate(service.NewCrawlPreviewService, fx.As(new(service.ICrawlPreviewService))),
+			fx.Annotate(controller.NewWorkflowController, fx.As(new(crawlerv1.WorkflowServiceServer))),
+			fx.Annotate(controller.NewInternalController, fx.As(new(crawlerv1.SchedulerInternalServiceServer))),
+			fx.Annotate(controller.NewRateLimitController, fx.As(new(crawlerv1.RateLimitServiceServer))),
+			fx.Annotate(controller.NewValidationController, fx.As(new(crawlerv1.ValidationServiceServer))),
+			// queue
+			fx.Annotate(service.NewQueueService, fx.As(new(service.IQueueService))),
+			fx.Annotate(controller.NewQueueController, fx.As(new(crawlerv1.QueueServiceServer))),
+			// dead letter
+			fx.Annotate(repository.NewDeadLetterRepository, fx.As(new(repository.IDeadLetterRepository))),
+			fx.Annotate(service.NewDeadLetterService, fx.As(new(service.IDeadLetterService))),
+			fx.Annotate(controller.NewDeadLetterController, fx.As(new(crawlerv1.DeadLetterServiceServer))),
 
+			fx.Annotate(auth.NewAuthenticator, fx.As(new(auth.IAuthenticator))),
 			fx.Annotate(startRateLimit, fx.As(new(utils.IRateLimit))),
+			ratelimit.NewInterceptor,
+			rulestore.NewRuleStore,
 			fx.Annotate(internalvalidator.NewValidate, fx.As(new(internalvalidator.IValidate))),
 		),
 		fx.Supply(
@@ -60,6 +89,15 @@ func startServer(
 	lc fx.Lifecycle,
 	config *configs.Config,
 	urlController crawlerv1.SchedulerEventServiceServer,
+	workflowController crawlerv1.WorkflowServiceServer,
+	internalController crawlerv1.SchedulerInternalServiceServer,
+	rateLimitController crawlerv1.RateLimitServiceServer,
+	validationController crawlerv1.ValidationServiceServer,
+	queueController crawlerv1.QueueServiceServer,
+	deadLetterController crawlerv1.DeadLetterServiceServer,
+	validate internalvalidator.IValidate,
+	authenticator auth.IAuthenticator,
+	rateLimitInterceptor *ratelimit.Interceptor,
 ) error {
 	// start grpc
 	listener, err := net.Listen("tcp", config.AppConfig.GRPCPort)
@@ -67,17 +105,31 @@ func startServer(
 		return err
 	}
 	defer listener.Close()
+	if err := validate.Watch(context.Background()); err != nil {
+		return fmt.Errorf("failed to watch validation rules: %v", err)
+	}
 	var opts = []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
-			validator.UnaryServerInterceptor(),
+			rateLimitInterceptor.Unary(),
+			auth.UnaryServerInterceptor(authenticator),
+			internalvalidator.UnaryServerInterceptor(validate),
 		),
 		grpc.ChainStreamInterceptor(
-			validator.StreamServerInterceptor(),
+			rateLimitInterceptor.Stream(),
+			auth.StreamServerInterceptor(authenticator),
+			internalvalidator.StreamServerInterceptor(validate),
 		),
 	}
 	server := grpc.NewServer(opts...)
 	reflection.Register(server)
 	crawlerv1.RegisterSchedulerEventServiceServer(server, urlController)
+	crawlerv1.RegisterWorkflowServiceServer(server, workflowController)
+	crawlerv1.RegisterRateLimitServiceServer(server, rateLimitController)
+	crawlerv1.RegisterValidationServiceServer(server, validationController)
+	crawlerv1.RegisterQueueServiceServer(server, queueController)
+	crawlerv1.RegisterDeadLetterServiceServer(server, deadLetterController)
+	// internal RPCs are served on gRPC only, no gateway handler is registered for them
+	crawlerv1.RegisterSchedulerInternalServiceServer(server, internalController)
 	fmt.Printf("gRPC server is running on %s\n", config.AppConfig.GRPCPort)
 	// start http
 	conn, err := grpc.NewClient(config.AppConfig.GRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -85,10 +137,28 @@ func startServer(
 		return fmt.Errorf("failed to dial gRPC server: %v", err)
 	}
 	defer conn.Close()
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayIncomingHeader),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeader),
+	)
 	if err := crawlerv1.RegisterSchedulerEventServiceHandler(context.Background(), mux, conn); err != nil {
 		return fmt.Errorf("failed to register handler: %v", err)
 	}
+	if err := crawlerv1.RegisterWorkflowServiceHandler(context.Background(), mux, conn); err != nil {
+		return fmt.Errorf("failed to register workflow handler: %v", err)
+	}
+	if err := crawlerv1.RegisterRateLimitServiceHandler(context.Background(), mux, conn); err != nil {
+		return fmt.Errorf("failed to register rate limit handler: %v", err)
+	}
+	if err := crawlerv1.RegisterValidationServiceHandler(context.Background(), mux, conn); err != nil {
+		return fmt.Errorf("failed to register validation handler: %v", err)
+	}
+	if err := crawlerv1.RegisterQueueServiceHandler(context.Background(), mux, conn); err != nil {
+		return fmt.Errorf("failed to register queue handler: %v", err)
+	}
+	if err := crawlerv1.RegisterDeadLetterServiceHandler(context.Background(), mux, conn); err != nil {
+		return fmt.Errorf("failed to register dead letter handler: %v", err)
+	}
 	go func() {
 		fmt.Printf("HTTP server is running on %s\n", config.AppConfig.HTTPPort)
 		if err := http.ListenAndServe(config.AppConfig.HTTPPort, mux); err != nil {
@@ -111,3 +181,31 @@ func startRateLimit(
 	fmt.Printf("Rate limit is started")
 	return rateLimit
 }
+
+// gatewayIncomingHeader forwards If-Match and the API key to the server next to the default headers
+func gatewayIncomingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, controller.IfMatchHeader) {
+		return controller.IfMatchHeader, true
+	}
+	if strings.EqualFold(key, auth.APIKeyHeader) {
+		return auth.APIKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayOutgoingHeaders are the metadata returned as plain HTTP headers
+var gatewayOutgoingHeaders = map[string]string{
+	controller.ETagHeader:      "ETag",
+	ratelimit.LimitHeader:      "X-RateLimit-Limit",
+	ratelimit.RemainingHeader:  "X-RateLimit-Remaining",
+	ratelimit.ResetHeader:      "X-RateLimit-Reset",
+	ratelimit.RetryAfterHeader: "Retry-After",
+}
+
+// gatewayOutgoingHeader returns the event version as the ETag header and the rate limit headers as they are
+func gatewayOutgoingHeader(key string) (string, bool) {
+	if header, ok := gatewayOutgoingHeaders[key]; ok {
+		return header, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
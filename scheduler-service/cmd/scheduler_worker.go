@@ -1,13 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"time"
 
 	"github.com/namnv2496/scheduler/internal/configs"
 	"github.com/namnv2496/scheduler/internal/repository"
 	"github.com/namnv2496/scheduler/internal/repository/distributedlock"
+	"github.com/namnv2496/scheduler/internal/repository/shardlease"
 	"github.com/namnv2496/scheduler/internal/service"
 	"github.com/namnv2496/scheduler/internal/service/mq"
+	"github.com/namnv2496/scheduler/pkg/utils"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 )
@@ -16,9 +19,10 @@ var schedulerWorkerCmd = &cobra.Command{
 	Use:   "scheduler_worker",
 	Short: "Start the scheduler worker",
 	Run: func(cmd *cobra.Command, args []string) {
+		// Run serves until SIGINT or SIGTERM, then stops the lifecycle hooks
 		InvokeSchedulerWorker(
 			startCronjob,
-		)
+		).Run()
 	},
 }
 
@@ -32,9 +36,20 @@ func InvokeSchedulerWorker(invokers ...any) *fx.App {
 			fx.Annotate(repository.NewSchedulerEventRepository, fx.As(new(repository.ISchedulerEventRepository))),
 			// MQ
 			fx.Annotate(mq.NewKafkaProducer, fx.As(new(mq.IProducer))),
+			fx.Annotate(repository.NewOutboxRepository, fx.As(new(repository.IOutboxRepository))),
+			fx.Annotate(service.NewOutboxRelay, fx.As(new(service.IOutboxRelay))),
 			fx.Annotate(service.NewUrlCronJob, fx.As(new(service.ICrawlerCronJob))),
+			fx.Annotate(repository.NewTenantRepository, fx.As(new(repository.ITenantRepository))),
+			fx.Annotate(repository.NewQueueRepository, fx.As(new(repository.IQueueRepository))),
+			// dead letter
+			fx.Annotate(mq.NewDeadLetterReader, fx.As(new(mq.IReader))),
+			fx.Annotate(repository.NewDeadLetterRepository, fx.As(new(repository.IDeadLetterRepository))),
+			fx.Annotate(service.NewDeadLetterMirror, fx.As(new(service.IDeadLetterMirror))),
 			// rate limit
+			fx.Annotate(startRateLimit, fx.As(new(utils.IRateLimit))),
 			fx.Annotate(distributedlock.NewDistributedLock, fx.As(new(distributedlock.IDistributedLock))),
+			// sharding
+			fx.Annotate(shardlease.NewShardLease, fx.As(new(shardlease.IShardLease))),
 		),
 		fx.Supply(
 			config,
@@ -45,11 +60,23 @@ func InvokeSchedulerWorker(invokers ...any) *fx.App {
 }
 
 func startCronjob(
+	lc fx.Lifecycle,
 	urlCronJob service.ICrawlerCronJob,
+	deadLetterMirror service.IDeadLetterMirror,
 ) error {
-	// start cron job
-	if err := urlCronJob.Start(); err != nil {
-		panic("failed to start publisher")
-	}
-	select {}
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			deadLetterMirror.Start(ctx)
+			return nil
+		},
+		OnStop: deadLetterMirror.Stop,
+	})
+	// the shards are released on stop, the other replicas take them over at their next rebalance
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return urlCronJob.Start(context.Background())
+		},
+		OnStop: urlCronJob.Stop,
+	})
+	return nil
 }
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/internal/repository/rulestore"
+	"github.com/namnv2496/scheduler/internal/service"
+	internalvalidator "github.com/namnv2496/scheduler/internal/validator"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var (
+	syncDir    string
+	syncDryRun bool
+	syncPrune  bool
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Reconcile scheduler events with a directory of YAML manifests",
+	Run: func(cmd *cobra.Command, args []string) {
+		app := InvokeSync(
+			runSync,
+		)
+		if err := app.Err(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	syncCmd.Flags().StringVar(&syncDir, "dir", "", "directory of event manifests (*.yaml, *.yml)")
+	syncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "print the changes without applying them")
+	syncCmd.Flags().BoolVar(&syncPrune, "prune", false, "soft delete named events which have no manifest")
+	syncCmd.MarkFlagRequired("dir")
+}
+
+func InvokeSync(invokers ...any) *fx.App {
+	config := configs.LoadConfig()
+	app := fx.New(
+		fx.NopLogger,
+		fx.Provide(
+			fx.Annotate(repository.NewDatabase, fx.As(new(repository.IDatabase))),
+			fx.Annotate(repository.NewSchedulerEventRepository, fx.As(new(repository.ISchedulerEventRepository))),
+			fx.Annotate(repository.NewOutboxRepository, fx.As(new(repository.IOutboxRepository))),
+			fx.Annotate(repository.NewEventRunRepository, fx.As(new(repository.IEventRunRepository))),
+			fx.Annotate(repository.NewWorkflowRepository, fx.As(new(repository.IWorkflowRepository))),
+			fx.Annotate(repository.NewTenantRepository, fx.As(new(repository.ITenantRepository))),
+			fx.Annotate(repository.NewQueueRepository, fx.As(new(repository.IQueueRepository))),
+			fx.Annotate(repository.NewWorkflowRunRepository, fx.As(new(repository.IWorkflowRunRepository))),
+			fx.Annotate(service.NewWorkflowService, fx.As(new(service.IWorkflowService))),
+			fx.Annotate(service.NewSchedulerEventService, fx.As(new(service.ISchedulerEventService))),
+			fx.Annotate(service.NewEventSyncService, fx.As(new(service.IEventSyncService))),
+			rulestore.NewRuleStore,
+			fx.Annotate(internalvalidator.NewValidate, fx.As(new(internalvalidator.IValidate))),
+		),
+		fx.Supply(
+			config,
+		),
+		fx.Invoke(invokers...),
+	)
+	return app
+}
+
+func runSync(
+	syncService service.IEventSyncService,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+	ctx = logging.InjectTraceId(ctx)
+
+	manifests, err := service.LoadEventManifests(syncDir)
+	if err != nil {
+		return err
+	}
+	changes, err := syncService.Sync(ctx, manifests, syncDryRun, syncPrune)
+	for _, change := range changes {
+		line := fmt.Sprintf("%-6s %s", change.Action, change.Name)
+		if change.Action == entity.SyncActionUpdate {
+			line += " (" + strings.Join(change.Fields, ", ") + ")"
+		}
+		if change.Error != "" {
+			line += ": " + change.Error
+		}
+		fmt.Println(line)
+	}
+	mode := "applied"
+	if syncDryRun {
+		mode = "planned"
+	}
+	fmt.Printf("%d manifests, %d changes %s\n", len(manifests), len(changes), mode)
+	return err
+}
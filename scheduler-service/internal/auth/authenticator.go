@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/namnv2496/scheduler/internal/configs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHeader carries a static API key, "authorization: Bearer <key>" is accepted as well
+const APIKeyHeader = "x-api-key"
+
+// AnonymousSubject is the caller without credentials while authentication is disabled
+const AnonymousSubject = "anonymous"
+
+type IAuthenticator interface {
+	// Authenticate returns the caller from the metadata of the call. Without credentials
+	// the caller is an anonymous editor unless authentication is enabled.
+	Authenticate(ctx context.Context) (*Principal, error)
+}
+
+type apiKey struct {
+	key       string
+	principal Principal
+}
+
+type Authenticator struct {
+	enabled    bool
+	apiKeys    []apiKey
+	hmacSecret []byte
+	rsaKey     *rsa.PublicKey
+	parser     *jwt.Parser
+}
+
+func NewAuthenticator(conf *configs.Config) (*Authenticator, error) {
+	authenticator := &Authenticator{
+		enabled:    conf.Auth.Enabled,
+		hmacSecret: []byte(conf.Auth.JWTSecret),
+	}
+	for _, entry := range conf.Auth.APIKeys {
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("api key must be key:team:role")
+		}
+		role, ok := GetRole(parts[2])
+		if !ok {
+			return nil, fmt.Errorf("api key of team %s has unknown role %s", parts[1], parts[2])
+		}
+		authenticator.apiKeys = append(authenticator.apiKeys, apiKey{
+			key:       parts[0],
+			principal: Principal{Subject: "apikey:" + parts[1], Team: parts[1], Role: role},
+		})
+	}
+	// the admin token of the purge RPC keeps working as an admin key of every team
+	if conf.Admin.Token != "" {
+		authenticator.apiKeys = append(authenticator.apiKeys, apiKey{
+			key:       conf.Admin.Token,
+			principal: Principal{Subject: "admin", Role: RoleAdmin},
+		})
+	}
+	if conf.Auth.JWTPublicKeyFile != "" {
+		data, err := os.ReadFile(conf.Auth.JWTPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwt public key: %w", err)
+		}
+		if authenticator.rsaKey, err = jwt.ParseRSAPublicKeyFromPEM(data); err != nil {
+			return nil, fmt.Errorf("failed to parse jwt public key: %w", err)
+		}
+	}
+	methods := make([]string, 0)
+	if len(authenticator.hmacSecret) > 0 {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if authenticator.rsaKey != nil {
+		methods = append(methods, "RS256", "RS384", "RS512")
+	}
+	options := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if conf.Auth.JWTIssuer != "" {
+		options = append(options, jwt.WithIssuer(conf.Auth.JWTIssuer))
+	}
+	if conf.Auth.JWTAudience != "" {
+		options = append(options, jwt.WithAudience(conf.Auth.JWTAudience))
+	}
+	authenticator.parser = jwt.NewParser(options...)
+	return authenticator, nil
+}
+
+// claims of the tokens issued to the teams
+type claims struct {
+	jwt.RegisteredClaims
+	Team string `json:"team"`
+	Role string `json:"role"`
+}
+
+func (_self *Authenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	credential := ""
+	if values := md.Get(APIKeyHeader); len(values) > 0 {
+		credential = values[0]
+	} else if values := md.Get("authorization"); len(values) > 0 {
+		bearer, ok := strings.CutPrefix(values[0], "Bearer ")
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "authorization must be a bearer token")
+		}
+		credential = bearer
+	}
+	if credential == "" {
+		if _self.enabled {
+			return nil, status.Errorf(codes.Unauthenticated, "credentials are required")
+		}
+		return &Principal{Subject: AnonymousSubject, Role: RoleEditor}, nil
+	}
+	for _, key := range _self.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(credential), []byte(key.key)) == 1 {
+			principal := key.principal
+			return &principal, nil
+		}
+	}
+	if strings.Count(credential, ".") == 2 {
+		return _self.verifyToken(credential)
+	}
+	return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
+}
+
+func (_self *Authenticator) verifyToken(token string) (*Principal, error) {
+	var tokenClaims claims
+	_, err := _self.parser.ParseWithClaims(token, &tokenClaims, func(token *jwt.Token) (any, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			return _self.hmacSecret, nil
+		case *jwt.SigningMethodRSA:
+			return _self.rsaKey, nil
+		}
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %s", err)
+	}
+	role, ok := GetRole(tokenClaims.Role)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "token has unknown role %q", tokenClaims.Role)
+	}
+	if tokenClaims.Team == "" && role != RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "token has no team")
+	}
+	return &Principal{Subject: tokenClaims.Subject, Team: tokenClaims.Team, Role: role}, nil
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// methodRoles are the RPCs which need more than the default role of their name
+var methodRoles = map[string]Role{
+	schedulerv1.SchedulerEventService_PurgeSchedulerEvent_FullMethodName: RoleAdmin,
+	schedulerv1.RateLimitService_ListRateLimitBlocks_FullMethodName:      RoleAdmin,
+	schedulerv1.RateLimitService_GetRateLimitBlock_FullMethodName:        RoleAdmin,
+	schedulerv1.RateLimitService_UnblockRateLimit_FullMethodName:         RoleAdmin,
+	schedulerv1.ValidationService_PutValidationRules_FullMethodName:      RoleAdmin,
+	schedulerv1.ValidationService_ReloadValidationRules_FullMethodName:   RoleAdmin,
+	schedulerv1.QueueService_CreateQueue_FullMethodName:                  RoleAdmin,
+	schedulerv1.QueueService_UpdateQueue_FullMethodName:                  RoleAdmin,
+	schedulerv1.QueueService_DeleteQueue_FullMethodName:                  RoleAdmin,
+	schedulerv1.DeadLetterService_ListDeadLetters_FullMethodName:         RoleAdmin,
+	schedulerv1.DeadLetterService_GetDeadLetter_FullMethodName:           RoleAdmin,
+	schedulerv1.DeadLetterService_ReplayDeadLetter_FullMethodName:        RoleAdmin,
+	schedulerv1.DeadLetterService_BulkReplayDeadLetters_FullMethodName:   RoleAdmin,
+	schedulerv1.DeadLetterService_DiscardDeadLetter_FullMethodName:       RoleAdmin,
+}
+
+// publicServices check their callers themselves, the crawler workers use the internal API key
+var publicServices = []string{
+	"/" + schedulerv1.SchedulerInternalService_ServiceDesc.ServiceName + "/",
+}
+
+// RequiredRole is admin for the listed RPCs, viewer for the reads and editor for the writes
+func RequiredRole(fullMethod string) Role {
+	if role, ok := methodRoles[fullMethod]; ok {
+		return role
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range []string{"Get", "List", "Export", "ServerReflectionInfo"} {
+		if strings.HasPrefix(method, prefix) {
+			return RoleViewer
+		}
+	}
+	return RoleEditor
+}
+
+func isPublic(fullMethod string) bool {
+	for _, service := range publicServices {
+		if strings.HasPrefix(fullMethod, service) {
+			return true
+		}
+	}
+	return false
+}
+
+func authorize(ctx context.Context, authenticator IAuthenticator, fullMethod string) (context.Context, error) {
+	if isPublic(fullMethod) {
+		return ctx, nil
+	}
+	principal, err := authenticator.Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if required := RequiredRole(fullMethod); !principal.Role.Allows(required) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s role is required", required)
+	}
+	return NewContext(ctx, principal), nil
+}
+
+func UnaryServerInterceptor(authenticator IAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, authenticator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(authenticator IAuthenticator) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(stream.Context(), authenticator, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// serverStream passes the context with the caller to the stream handlers
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (_self *serverStream) Context() context.Context {
+	return _self.ctx
+}
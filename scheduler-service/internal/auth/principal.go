@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+var roleLevels = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+func GetRole(role string) (Role, bool) {
+	_, ok := roleLevels[Role(role)]
+	return Role(role), ok
+}
+
+// Allows is true when the role includes required, admin > editor > viewer
+func (_self Role) Allows(required Role) bool {
+	return roleLevels[_self] >= roleLevels[required]
+}
+
+// Principal is the authenticated caller of an RPC
+type Principal struct {
+	Subject string
+	Team    string
+	Role    Role
+}
+
+// IsScoped is true when the caller only sees the events of its team
+func (_self *Principal) IsScoped() bool {
+	return _self.Role != RoleAdmin
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the caller of the RPC, it is missing for the jobs and commands of the scheduler itself
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// RequireRole guards an RPC again in the controller, the interceptor already checked the role of the method
+func RequireRole(ctx context.Context, required Role) error {
+	principal, ok := FromContext(ctx)
+	if !ok || !principal.Role.Allows(required) {
+		return status.Errorf(codes.PermissionDenied, "%s role is required", required)
+	}
+	return nil
+}
@@ -12,9 +12,10 @@ type AppConfig struct {
 	Domains  []string `env:"domains" envDefault:"phone_cellphones,phone_thegioididong"` // gold,diamond
 	Workers  int      `env:"workers" envDefault:"100"`
 }
+
+// KafkaProducerConfig has no topics, the topic of an event is the topic of its queue
 type KafkaProducerConfig struct {
-	Brokers string   `env:"producer_broker" envDefault:"localhost:29092"`
-	Topic   []string `env:"producer_topic" envDefault:"normal,priority"`
+	Brokers string `env:"producer_broker" envDefault:"localhost:29092"`
 }
 
 type KafkaConsumerConfig struct {
@@ -44,6 +45,78 @@ type Cron struct {
 	CronExpression string `env:"CRON_EXPRESSION" envDefault:"*/1 * * * *"`
 }
 
+type Shard struct {
+	Total          int           `env:"shard_total" envDefault:"16"`
+	LeaseTTL       time.Duration `env:"shard_lease_ttl" envDefault:"30s"`
+	RebalanceEvery time.Duration `env:"shard_rebalance_every" envDefault:"10s"`
+}
+
+type Outbox struct {
+	RelayInterval time.Duration `env:"outbox_relay_interval" envDefault:"1s"`
+	BatchSize     int           `env:"outbox_batch_size" envDefault:"100"`
+	MaxAttempts   int           `env:"outbox_max_attempts" envDefault:"10"`
+	RetryDelay    time.Duration `env:"outbox_retry_delay" envDefault:"5s"`
+	// ClaimTimeout hides the claimed messages from the other relays while they are published, a relay
+	// which stops meanwhile leaves them to be published again after it
+	ClaimTimeout time.Duration `env:"outbox_claim_timeout" envDefault:"1m"`
+}
+
+type DeadLetter struct {
+	// Topic receives the messages given up by the crawler workers, the scheduler worker stores them
+	Topic   string `env:"dead_letter_topic" envDefault:"dead-letters"`
+	GroupID string `env:"dead_letter_group_id" envDefault:"scheduler-dead-letters"`
+	// BulkReplayLimit caps the dead letters replayed by one call
+	BulkReplayLimit int `env:"dead_letter_bulk_replay_limit" envDefault:"100"`
+}
+
+type Backfill struct {
+	MaxSlots int `env:"backfill_max_slots" envDefault:"100"`
+}
+
+type Admin struct {
+	// Token guards admin RPCs, they are disabled when it is empty
+	Token string `env:"admin_token" envDefault:""`
+}
+
+type Auth struct {
+	// Enabled rejects the calls without credentials, otherwise they run as an anonymous editor without team
+	Enabled bool `env:"auth_enabled" envDefault:"false"`
+	// APIKeys are "key:team:role" entries, role is viewer, editor or admin
+	APIKeys []string `env:"auth_api_keys" envDefault:""`
+	// JWTSecret verifies HS256/HS384/HS512 tokens
+	JWTSecret string `env:"auth_jwt_secret" envDefault:""`
+	// JWTPublicKeyFile is a PEM RSA public key verifying RS256/RS384/RS512 tokens
+	JWTPublicKeyFile string `env:"auth_jwt_public_key_file" envDefault:""`
+	JWTIssuer        string `env:"auth_jwt_issuer" envDefault:""`
+	JWTAudience      string `env:"auth_jwt_audience" envDefault:""`
+}
+
+type Internal struct {
+	// APIKey authenticates the crawler workers on the internal RPCs, they are disabled when it is empty
+	APIKey string `env:"internal_api_key" envDefault:""`
+}
+
+type CrawlerService struct {
+	// GRPCHost serves the internal RPCs of the crawler workers, they use internal_api_key as well
+	GRPCHost string `env:"crawler_service_grpc_host" envDefault:"localhost:9091"`
+	// Timeout of a preview, it covers the fetch and the robots.txt of the target
+	Timeout time.Duration `env:"crawler_service_timeout" envDefault:"30s"`
+}
+
+type RateLimit struct {
+	// Rules are "<method>=<rate>/<period>[/<burst>]" entries, method is the RPC name, its full
+	// gRPC name or * for the other RPCs, e.g. "CreateSchedulerEvent=50/1s,*=600/1m/100"
+	Rules []string `env:"rate_limit_rules" envDefault:"CreateSchedulerEvent=50/1s,GetSchedulerEvents=10/1m,ListSchedulerEvents=10/1m,PreviewCrawl=10/1m"`
+}
+
+type Validator struct {
+	// RulesFile is the YAML (or JSON) rules of the event fields, it seeds the rules shared in Redis
+	// when its version is newer than theirs and is reloaded on change
+	RulesFile string `env:"validation_rules_file" envDefault:"./internal/validator/rules.yaml"`
+	// RefreshEvery reloads the shared rules when a change notification was missed
+	RefreshEvery time.Duration `env:"validation_rules_refresh_every" envDefault:"30s"`
+}
+
 type Telegram struct {
 	Enable      bool   `env:"telegram_enable" envDefault:"false"`
 	APIKey      string `env:"telegram_api_key" envDefault:""`
@@ -57,6 +130,16 @@ type Config struct {
 	KafkaConsumerConfig KafkaConsumerConfig
 	DatabaseConfig      DatabaseConfig
 	Cron                Cron
+	Shard               Shard
+	Outbox              Outbox
+	DeadLetter          DeadLetter
+	Backfill            Backfill
+	Admin               Admin
+	Internal            Internal
+	CrawlerService      CrawlerService
+	Auth                Auth
+	RateLimit           RateLimit
+	Validator           Validator
 	Telegram            Telegram
 	Redis               Redis
 }
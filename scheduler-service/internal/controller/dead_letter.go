@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/service"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"github.com/namnv2496/scheduler/pkg/logging"
+)
+
+// DeadLetterController inspects, replays and discards the dead letters of the crawler workers
+type DeadLetterController struct {
+	schedulerv1.UnimplementedDeadLetterServiceServer
+	deadLetterService service.IDeadLetterService
+}
+
+func NewDeadLetterController(
+	deadLetterService service.IDeadLetterService,
+) schedulerv1.DeadLetterServiceServer {
+	return &DeadLetterController{
+		deadLetterService: deadLetterService,
+	}
+}
+
+func (_self *DeadLetterController) ListDeadLetters(
+	ctx context.Context,
+	req *schedulerv1.ListDeadLettersRequest,
+) (*schedulerv1.ListDeadLettersResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ListDeadLetters")
+	deadLetters, nextPageToken, err := _self.deadLetterService.ListDeadLetters(ctx, entity.DeadLetterFilter{
+		Queue:     req.Queue,
+		Status:    domain.DeadLetterStatusEnum(req.Status),
+		EventId:   req.EventId,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to list dead letters")
+	}
+	resp := &schedulerv1.ListDeadLettersResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, deadLetter := range deadLetters {
+		resp.DeadLetters = append(resp.DeadLetters, toDeadLetterProto(deadLetter))
+	}
+	return resp, nil
+}
+
+func (_self *DeadLetterController) GetDeadLetter(
+	ctx context.Context,
+	req *schedulerv1.GetDeadLetterRequest,
+) (*schedulerv1.GetDeadLetterResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetDeadLetter")
+	deadLetter, err := _self.deadLetterService.GetDeadLetter(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get dead letter")
+	}
+	return &schedulerv1.GetDeadLetterResponse{
+		DeadLetter: toDeadLetterProto(deadLetter),
+	}, nil
+}
+
+func (_self *DeadLetterController) ReplayDeadLetter(
+	ctx context.Context,
+	req *schedulerv1.ReplayDeadLetterRequest,
+) (*schedulerv1.ReplayDeadLetterResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ReplayDeadLetter")
+	deadLetter, runId, err := _self.deadLetterService.ReplayDeadLetter(ctx, req.Id, req.Force)
+	if err != nil {
+		return nil, toStatusError(err, "failed to replay dead letter")
+	}
+	return &schedulerv1.ReplayDeadLetterResponse{
+		DeadLetter: toDeadLetterProto(deadLetter),
+		RunId:      runId,
+	}, nil
+}
+
+func (_self *DeadLetterController) BulkReplayDeadLetters(
+	ctx context.Context,
+	req *schedulerv1.BulkReplayDeadLettersRequest,
+) (*schedulerv1.BulkReplayDeadLettersResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "BulkReplayDeadLetters")
+	replays, err := _self.deadLetterService.BulkReplayDeadLetters(ctx, req.Ids, req.Queue, int(req.Limit), req.Force)
+	if err != nil {
+		return nil, toStatusError(err, "failed to replay dead letters")
+	}
+	resp := &schedulerv1.BulkReplayDeadLettersResponse{}
+	for _, replay := range replays {
+		if replay.Error == "" {
+			resp.Replayed++
+		}
+		resp.Replays = append(resp.Replays, &schedulerv1.DeadLetterReplay{
+			Id:    replay.Id,
+			RunId: replay.RunId,
+			Error: replay.Error,
+		})
+	}
+	return resp, nil
+}
+
+func (_self *DeadLetterController) DiscardDeadLetter(
+	ctx context.Context,
+	req *schedulerv1.DiscardDeadLetterRequest,
+) (*schedulerv1.DiscardDeadLetterResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "DiscardDeadLetter")
+	deadLetter, err := _self.deadLetterService.DiscardDeadLetter(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to discard dead letter")
+	}
+	return &schedulerv1.DiscardDeadLetterResponse{
+		DeadLetter: toDeadLetterProto(deadLetter),
+	}, nil
+}
+
+func toDeadLetterProto(deadLetter *entity.DeadLetter) *schedulerv1.DeadLetter {
+	resp := &schedulerv1.DeadLetter{
+		Id:        deadLetter.Id,
+		Queue:     deadLetter.Queue,
+		EventId:   deadLetter.EventId,
+		RunId:     deadLetter.RunId,
+		Topic:     deadLetter.Topic,
+		Partition: int32(deadLetter.Partition),
+		Offset:    deadLetter.Offset,
+		Key:       deadLetter.Key,
+		Payload:   deadLetter.Payload,
+		Error:     deadLetter.Error,
+		Attempts:  deadLetter.Attempts,
+		Status:    string(deadLetter.Status),
+		Replays:   int32(deadLetter.Replays),
+		FailedAt:  deadLetter.FailedAt.String(),
+		CreatedAt: deadLetter.CreatedAt.String(),
+		UpdatedAt: deadLetter.UpdatedAt.String(),
+
+		PayloadBase64: deadLetter.PayloadBase64,
+	}
+	if deadLetter.ReplayedAt != nil {
+		resp.ReplayedAt = deadLetter.ReplayedAt.String()
+	}
+	if deadLetter.DiscardedAt != nil {
+		resp.DiscardedAt = deadLetter.DiscardedAt.String()
+	}
+	return resp
+}
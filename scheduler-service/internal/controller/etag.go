@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ETagHeader and IfMatchHeader are the metadata keys of the event version,
+// the gateway maps them to the HTTP ETag and If-Match headers
+const (
+	ETagHeader    = "etag"
+	IfMatchHeader = "if-match"
+)
+
+// expectedVersion returns the version a write must match. The version of the request wins over
+// the If-Match header, 0 means the write is not conditional.
+func expectedVersion(ctx context.Context, version int64) (int64, error) {
+	if version > 0 {
+		return version, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(IfMatchHeader)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	value := strings.TrimSpace(values[0])
+	if value == "*" {
+		return 0, nil
+	}
+	value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid If-Match header %q", values[0])
+	}
+	return version, nil
+}
+
+// setETag sends the version of the event back, a failure only loses the header
+func setETag(ctx context.Context, version int64) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ETagHeader, strconv.Quote(strconv.FormatInt(version, 10))))
+}
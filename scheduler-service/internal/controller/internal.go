@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/service"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// InternalController serves the RPCs of the crawler workers, it is not exposed on the gateway
+type InternalController struct {
+	schedulerv1.UnimplementedSchedulerInternalServiceServer
+	conf            *configs.Config
+	eventService    service.ISchedulerEventService
+	eventRunService service.IEventRunService
+	queueService    service.IQueueService
+}
+
+func NewInternalController(
+	conf *configs.Config,
+	eventService service.ISchedulerEventService,
+	eventRunService service.IEventRunService,
+	queueService service.IQueueService,
+) schedulerv1.SchedulerInternalServiceServer {
+	return &InternalController{
+		conf:            conf,
+		eventService:    eventService,
+		eventRunService: eventRunService,
+		queueService:    queueService,
+	}
+}
+
+func (_self *InternalController) ReportRunResult(
+	ctx context.Context,
+	req *schedulerv1.ReportRunResultRequest,
+) (*schedulerv1.ReportRunResultResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ReportRunResult")
+	if err := checkInternal(ctx, _self.conf.Internal.APIKey); err != nil {
+		return nil, err
+	}
+	if req.RunId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "run_id is required")
+	}
+	if req.DurationMs < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "duration_ms must not be negative")
+	}
+	result := entity.RunResult{
+		EventId:  req.EventId,
+		RunId:    req.RunId,
+		Status:   domain.StatusEnum(req.Status),
+		Duration: time.Duration(req.DurationMs) * time.Millisecond,
+		Error:    req.Error,
+		ResultId: req.ResultId,
+	}
+	if err := _self.eventRunService.ReportEventRun(ctx, result); err != nil {
+		return nil, toStatusError(err, "failed to report run")
+	}
+	logging.Infof(ctx, "run %s of event %d is %s in %dms", req.RunId, req.EventId, req.Status, req.DurationMs)
+	return &schedulerv1.ReportRunResultResponse{
+		Status: req.Status,
+	}, nil
+}
+
+func (_self *InternalController) EventExists(
+	ctx context.Context,
+	req *schedulerv1.EventExistsRequest,
+) (*schedulerv1.EventExistsResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "EventExists")
+	if err := checkInternal(ctx, _self.conf.Internal.APIKey); err != nil {
+		return nil, err
+	}
+	_, err := _self.eventService.GetSchedulerEvent(ctx, req.EventId)
+	if status.Code(err) == codes.NotFound {
+		return &schedulerv1.EventExistsResponse{Exists: false}, nil
+	}
+	if err != nil {
+		return nil, toStatusError(err, "failed to get event")
+	}
+	return &schedulerv1.EventExistsResponse{Exists: true}, nil
+}
+
+func (_self *InternalController) GetQueues(
+	ctx context.Context,
+	req *schedulerv1.GetQueuesRequest,
+) (*schedulerv1.GetQueuesResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetQueues")
+	if err := checkInternal(ctx, _self.conf.Internal.APIKey); err != nil {
+		return nil, err
+	}
+	queues, err := _self.queueService.ListQueues(ctx)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list queues")
+	}
+	resp := &schedulerv1.GetQueuesResponse{}
+	for _, queue := range queues {
+		resp.Queues = append(resp.Queues, &schedulerv1.CrawlerQueue{
+			Name:           queue.Name,
+			Topic:          queue.Topic,
+			Weight:         int32(queue.Weight),
+			RateLimit:      int32(queue.RateLimit),
+			MaxConcurrency: int32(queue.MaxConcurrency),
+		})
+	}
+	return resp, nil
+}
+
+// checkInternal accepts the API key of the crawler workers from the "authorization: Bearer <key>" header.
+// An empty key disables the internal RPCs.
+func checkInternal(ctx context.Context, apiKey string) error {
+	if apiKey == "" {
+		return status.Errorf(codes.PermissionDenied, "internal RPCs are disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		bearer, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(bearer), []byte(apiKey)) == 1 {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "internal token is required")
+}
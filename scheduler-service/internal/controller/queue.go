@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/service"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QueueController manages the queues of the crawler workers
+type QueueController struct {
+	schedulerv1.UnimplementedQueueServiceServer
+	queueService service.IQueueService
+}
+
+func NewQueueController(
+	queueService service.IQueueService,
+) schedulerv1.QueueServiceServer {
+	return &QueueController{
+		queueService: queueService,
+	}
+}
+
+func (_self *QueueController) CreateQueue(
+	ctx context.Context,
+	req *schedulerv1.CreateQueueRequest,
+) (*schedulerv1.CreateQueueResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "CreateQueue")
+	if req.Queue.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name of the queue is required")
+	}
+	queue, err := _self.queueService.CreateQueue(ctx, fromQueueProto(req.Queue))
+	if err != nil {
+		return nil, toStatusError(err, "failed to create queue")
+	}
+	return &schedulerv1.CreateQueueResponse{
+		Queue: toQueueProto(queue),
+	}, nil
+}
+
+func (_self *QueueController) GetQueue(
+	ctx context.Context,
+	req *schedulerv1.GetQueueRequest,
+) (*schedulerv1.GetQueueResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetQueue")
+	queue, err := _self.queueService.GetQueue(ctx, req.Name)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get queue")
+	}
+	return &schedulerv1.GetQueueResponse{
+		Queue: toQueueProto(queue),
+	}, nil
+}
+
+func (_self *QueueController) ListQueues(
+	ctx context.Context,
+	req *schedulerv1.ListQueuesRequest,
+) (*schedulerv1.ListQueuesResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ListQueues")
+	queues, err := _self.queueService.ListQueues(ctx)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list queues")
+	}
+	resp := &schedulerv1.ListQueuesResponse{}
+	for _, queue := range queues {
+		resp.Queues = append(resp.Queues, toQueueProto(queue))
+	}
+	return resp, nil
+}
+
+func (_self *QueueController) UpdateQueue(
+	ctx context.Context,
+	req *schedulerv1.UpdateQueueRequest,
+) (*schedulerv1.UpdateQueueResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "UpdateQueue")
+	if name := req.Queue.GetName(); name != "" && name != req.Name {
+		return nil, status.Errorf(codes.InvalidArgument, "queue %s cannot be renamed to %s", req.Name, name)
+	}
+	queue, err := _self.queueService.UpdateQueue(ctx, req.Name, fromQueueProto(req.Queue))
+	if err != nil {
+		return nil, toStatusError(err, "failed to update queue")
+	}
+	return &schedulerv1.UpdateQueueResponse{
+		Queue: toQueueProto(queue),
+	}, nil
+}
+
+func (_self *QueueController) DeleteQueue(
+	ctx context.Context,
+	req *schedulerv1.DeleteQueueRequest,
+) (*schedulerv1.DeleteQueueResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "DeleteQueue")
+	if err := _self.queueService.DeleteQueue(ctx, req.Name); err != nil {
+		return nil, toStatusError(err, "failed to delete queue")
+	}
+	return &schedulerv1.DeleteQueueResponse{
+		Status: "deleted",
+	}, nil
+}
+
+func fromQueueProto(queue *schedulerv1.Queue) *entity.Queue {
+	return &entity.Queue{
+		Name:           queue.GetName(),
+		Topic:          queue.GetTopic(),
+		Weight:         int(queue.GetWeight()),
+		RateLimit:      int(queue.GetRateLimit()),
+		MaxConcurrency: int(queue.GetMaxConcurrency()),
+	}
+}
+
+func toQueueProto(queue *entity.Queue) *schedulerv1.Queue {
+	return &schedulerv1.Queue{
+		Name:           queue.Name,
+		Topic:          queue.Topic,
+		Weight:         int32(queue.Weight),
+		RateLimit:      int32(queue.RateLimit),
+		MaxConcurrency: int32(queue.MaxConcurrency),
+		CreatedAt:      queue.CreatedAt.String(),
+		UpdatedAt:      queue.UpdatedAt.String(),
+	}
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/namnv2496/scheduler/internal/auth"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/namnv2496/scheduler/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimitController lets the admins see and clear the blocks of the rate limiter
+type RateLimitController struct {
+	schedulerv1.UnimplementedRateLimitServiceServer
+	rateLimit utils.IRateLimit
+}
+
+func NewRateLimitController(
+	rateLimit utils.IRateLimit,
+) schedulerv1.RateLimitServiceServer {
+	return &RateLimitController{
+		rateLimit: rateLimit,
+	}
+}
+
+func (_self *RateLimitController) ListRateLimitBlocks(
+	ctx context.Context,
+	req *schedulerv1.ListRateLimitBlocksRequest,
+) (*schedulerv1.ListRateLimitBlocksResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ListRateLimitBlocks")
+	if err := auth.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	blocks, err := _self.rateLimit.ListBlocks(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list blocks: %v", err)
+	}
+	resp := &schedulerv1.ListRateLimitBlocksResponse{}
+	for _, block := range blocks {
+		if !req.IncludeExpired && !block.IsActive() {
+			continue
+		}
+		if !strings.Contains(block.Path, req.Path) || !strings.Contains(block.Key, req.Key) {
+			continue
+		}
+		resp.Blocks = append(resp.Blocks, toRateLimitBlock(block))
+	}
+	// the blocks ending last are the ones to look at first
+	sort.Slice(resp.Blocks, func(i, j int) bool {
+		return resp.Blocks[i].EndTime > resp.Blocks[j].EndTime
+	})
+	return resp, nil
+}
+
+func (_self *RateLimitController) GetRateLimitBlock(
+	ctx context.Context,
+	req *schedulerv1.GetRateLimitBlockRequest,
+) (*schedulerv1.GetRateLimitBlockResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetRateLimitBlock")
+	if err := auth.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req.Path == "" || req.Key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "path and key are required")
+	}
+	block, err := _self.rateLimit.GetBlock(ctx, req.Path, req.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block: %v", err)
+	}
+	if block == nil {
+		return nil, status.Errorf(codes.NotFound, "%s is not blocked on %s", req.Key, req.Path)
+	}
+	return &schedulerv1.GetRateLimitBlockResponse{
+		Block: toRateLimitBlock(block),
+	}, nil
+}
+
+func (_self *RateLimitController) UnblockRateLimit(
+	ctx context.Context,
+	req *schedulerv1.UnblockRateLimitRequest,
+) (*schedulerv1.UnblockRateLimitResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "UnblockRateLimit")
+	if err := auth.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req.Path == "" || req.Key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "path and key are required")
+	}
+	unblocked, err := _self.rateLimit.Unblock(ctx, req.Path, req.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unblock: %v", err)
+	}
+	principal, _ := auth.FromContext(ctx)
+	logging.Infof(ctx, "%s unblocked %s on %s: %t", principal.Subject, req.Key, req.Path, unblocked)
+	return &schedulerv1.UnblockRateLimitResponse{
+		Unblocked: unblocked,
+	}, nil
+}
+
+func toRateLimitBlock(block *utils.Block) *schedulerv1.RateLimitBlock {
+	return &schedulerv1.RateLimitBlock{
+		Path:    block.Path,
+		Key:     block.Key,
+		Count:   int64(block.Count),
+		EndTime: block.EndTime.UnixMilli(),
+		Active:  block.IsActive(),
+	}
+}
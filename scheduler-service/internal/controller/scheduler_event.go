@@ -5,9 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/namnv2496/scheduler/internal/auth"
+	"github.com/namnv2496/scheduler/internal/configs"
 	"github.com/namnv2496/scheduler/internal/domain"
 	"github.com/namnv2496/scheduler/internal/entity"
 	"github.com/namnv2496/scheduler/internal/service"
@@ -17,26 +21,30 @@ import (
 
 	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
 	"github.com/namnv2496/scheduler/pkg/logging"
-	"github.com/namnv2496/scheduler/pkg/utils"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type SchedulerEventController struct {
 	schedulerv1.UnimplementedSchedulerEventServiceServer
+	conf                  *configs.Config
 	SchedulerEventService service.ISchedulerEventService
-	ratelimter            utils.IRateLimit
+	crawlPreviewService   service.ICrawlPreviewService
 	internalvalidator     internalvalidator.IValidate
 }
 
 func NewSchedulerEventController(
+	conf *configs.Config,
 	SchedulerEventService service.ISchedulerEventService,
-	ratelimter utils.IRateLimit,
+	crawlPreviewService service.ICrawlPreviewService,
 	internalvalidator internalvalidator.IValidate,
 ) schedulerv1.SchedulerEventServiceServer {
 	return &SchedulerEventController{
+		conf:                  conf,
 		SchedulerEventService: SchedulerEventService,
-		ratelimter:            ratelimter,
+		crawlPreviewService:   crawlPreviewService,
 		internalvalidator:     internalvalidator,
 	}
 }
@@ -49,17 +57,12 @@ func (_self *SchedulerEventController) CreateSchedulerEvent(
 	logging.SetName("scheduler")
 	ctx = logging.ResetPrefix(ctx, "CreateSchedulerEvent")
 
-	logging.Infof(ctx, "CreateSchedulerEvent is called before")
-	// // rate limit
-	if err := _self.checkInserRateLimit(ctx, req.Event.Id); err != nil {
-		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded: %v", err)
-	}
-
-	logging.Infof(ctx, "CreateSchedulerEvent is called after")
 	if req == nil || req.Event == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "request or url is nil")
 	}
 	newEvent := &entity.SchedulerEvent{
+		Name:        req.Event.Name,
+		Team:        req.Event.Team,
 		Url:         req.Event.Url,
 		Method:      req.Event.Method,
 		Description: req.Event.Description,
@@ -71,24 +74,14 @@ func (_self *SchedulerEventController) CreateSchedulerEvent(
 		SchedulerAt: req.Event.SchedulerAt,
 		Status:      domain.StatusPending,
 		CronExp:     req.Event.CronExp,
+		RetryPolicy: fromRetryPolicyProto(req.Event.RetryPolicy),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
-	eventFields := newEvent.ToMap()
-	if err := _self.internalvalidator.ValidateRequire(ctx, "insert", eventFields); err != nil {
-		return nil, err
-	}
-
-	if err := _self.internalvalidator.ValidateValue(ctx, eventFields); err != nil {
-		return nil, err
-	}
-	if err := _self.internalvalidator.ValidateCustomeRules(eventFields); err != nil {
-		return nil, err
-	}
 
 	id, err := _self.SchedulerEventService.CreateSchedulerEvent(ctx, newEvent)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create url: %v", err)
+		return nil, toStatusError(err, "failed to create url")
 	}
 
 	return &schedulerv1.CreateSchedulerEventResponse{
@@ -109,10 +102,6 @@ func (_self *SchedulerEventController) GetSchedulerEvents(
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "request is nil")
 	}
-	// rate limit
-	if err := _self.checkRateLimit(ctx, "test"); err != nil {
-		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded: %v", err)
-	}
 	if req.Limit == 0 {
 		req.Limit = 20
 	}
@@ -126,6 +115,8 @@ func (_self *SchedulerEventController) GetSchedulerEvents(
 	for i, event := range events {
 		SchedulerEvents[i] = &schedulerv1.SchedulerEvent{
 			Id:          fmt.Sprintf("%d", event.Id),
+			Name:        event.Name,
+			Team:        event.Team,
 			Url:         event.Url,
 			Method:      event.Method,
 			Description: event.Description,
@@ -136,6 +127,7 @@ func (_self *SchedulerEventController) GetSchedulerEvents(
 			RepeatTimes: event.RepeatTimes,
 			SchedulerAt: event.SchedulerAt,
 			CronExp:     event.CronExp,
+			RetryPolicy: toRetryPolicyProto(event.RetryPolicy),
 			CreatedAt:   event.CreatedAt.String(),
 			UpdatedAt:   event.UpdatedAt.String(),
 		}
@@ -145,6 +137,74 @@ func (_self *SchedulerEventController) GetSchedulerEvents(
 	}, nil
 }
 
+func (_self *SchedulerEventController) GetSchedulerEvent(
+	ctx context.Context,
+	req *schedulerv1.GetSchedulerEventRequest,
+) (*schedulerv1.GetSchedulerEventResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetSchedulerEvent")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	event, err := _self.SchedulerEventService.GetSchedulerEvent(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get event")
+	}
+	setETag(ctx, event.Version)
+	return &schedulerv1.GetSchedulerEventResponse{
+		Event: toSchedulerEventProto(event),
+	}, nil
+}
+
+func (_self *SchedulerEventController) ListSchedulerEvents(
+	ctx context.Context,
+	req *schedulerv1.ListSchedulerEventsRequest,
+) (*schedulerv1.ListSchedulerEventsResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ListSchedulerEvents")
+	filter := entity.SchedulerEventFilter{
+		Domain:    req.Domain,
+		Queue:     req.Queue,
+		Method:    req.Method,
+		IsActive:  req.IsActive,
+		Search:    req.Query,
+		OrderBy:   req.OrderBy,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}
+	if req.Status != "" {
+		filter.Status = domain.GetStatusEnum(req.Status)
+		if filter.Status == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", req.Status)
+		}
+	}
+	if req.CreatedFrom > 0 {
+		filter.CreatedFrom = time.UnixMilli(req.CreatedFrom)
+	}
+	if req.CreatedTo > 0 {
+		filter.CreatedTo = time.UnixMilli(req.CreatedTo)
+	}
+	if req.UpdatedFrom > 0 {
+		filter.UpdatedFrom = time.UnixMilli(req.UpdatedFrom)
+	}
+	if req.UpdatedTo > 0 {
+		filter.UpdatedTo = time.UnixMilli(req.UpdatedTo)
+	}
+	events, nextPageToken, err := _self.SchedulerEventService.ListSchedulerEvents(ctx, filter)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list events")
+	}
+	resp := &schedulerv1.ListSchedulerEventsResponse{
+		Events:        make([]*schedulerv1.SchedulerEvent, len(events)),
+		NextPageToken: nextPageToken,
+	}
+	for i, event := range events {
+		resp.Events[i] = toSchedulerEventProto(event)
+	}
+	return resp, nil
+}
+
 func (_self *SchedulerEventController) UpdateSchedulerEvent(
 	ctx context.Context,
 	req *schedulerv1.UpdateSchedulerEventRequest,
@@ -158,8 +218,13 @@ func (_self *SchedulerEventController) UpdateSchedulerEvent(
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
 	}
+	version, err := expectedVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
 	domainUrl := &entity.SchedulerEvent{
 		Id:          id,
+		Name:        req.Event.Name,
 		Url:         req.Event.Url,
 		Method:      req.Event.Method,
 		Description: req.Event.Description,
@@ -171,47 +236,239 @@ func (_self *SchedulerEventController) UpdateSchedulerEvent(
 		SchedulerAt: req.Event.SchedulerAt,
 		Status:      domain.GetStatusEnum(req.Event.Status),
 		CronExp:     req.Event.CronExp,
+		RetryPolicy: fromRetryPolicyProto(req.Event.RetryPolicy),
 	}
 
-	err = _self.SchedulerEventService.UpdateSchedulerEvent(ctx, id, domainUrl)
+	event, err := _self.SchedulerEventService.UpdateSchedulerEvent(ctx, id, domainUrl, version)
 	if err != nil {
-		if errors.Is(err, errors.New("url not found")) { // Check for specific error from repository/service
-			return nil, status.Errorf(codes.NotFound, "url with ID %s not found", req.Id)
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update url: %v", err)
+		return nil, toStatusError(err, "failed to update event")
 	}
+	setETag(ctx, event.Version)
 
 	return &schedulerv1.UpdateSchedulerEventResponse{
-		Id:     req.Id,
-		Status: "updated",
+		Id:      req.Id,
+		Status:  "updated",
+		Version: event.Version,
 	}, nil
 }
 
-func (_self *SchedulerEventController) checkRateLimit(ctx context.Context, key string) error {
-	// rate limit 10 request/ minute
-	pass, err := _self.ratelimter.Allow(ctx, "query_url", key, utils.LimitPerMinute(10, 1))
+func (_self *SchedulerEventController) PatchSchedulerEvent(
+	ctx context.Context,
+	req *schedulerv1.PatchSchedulerEventRequest,
+) (*schedulerv1.PatchSchedulerEventResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "PatchSchedulerEvent")
+	if req == nil || req.Event == nil || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "request, event, or id is nil/empty")
+	}
+	id, err := strconv.ParseInt(req.Id, 10, 64)
 	if err != nil {
-		return err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
 	}
-	if !pass {
-		return fmt.Errorf("rate limit exceeded")
+	version, err := expectedVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	paths := req.GetUpdateMask().GetPaths()
+	existing, err := _self.SchedulerEventService.GetSchedulerEvent(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get event")
+	}
+	if version > 0 && existing.Version != version {
+		return nil, status.Errorf(codes.Aborted, "event %d is at version %d, not %d", id, existing.Version, version)
+	}
+	if err := applyEventMask(existing, req.Event, paths); err != nil {
+		return nil, err
+	}
+	// the interceptor validated the fields of the patch, the merged event is validated for the rules
+	// between fields, the write is bound to the version it was merged from
+	if err := _self.internalvalidator.Validate(ctx, internalvalidator.ActionEdit, existing.ToMap()); err != nil {
+		return nil, err
+	}
+	event, err := _self.SchedulerEventService.PatchSchedulerEvent(ctx, id, existing, paths, existing.Version)
+	if err != nil {
+		return nil, toStatusError(err, "failed to patch event")
+	}
+	setETag(ctx, event.Version)
+	return &schedulerv1.PatchSchedulerEventResponse{
+		Event: toSchedulerEventProto(event),
+	}, nil
+}
+
+// applyEventMask copies the fields named by paths from patch to event
+func applyEventMask(event *entity.SchedulerEvent, patch *schedulerv1.SchedulerEvent, paths []string) error {
+	if len(paths) == 0 {
+		return status.Errorf(codes.InvalidArgument, "update mask is empty")
+	}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			event.Name = patch.Name
+		case "url":
+			event.Url = patch.Url
+		case "method":
+			event.Method = patch.Method
+		case "description":
+			event.Description = patch.Description
+		case "queue":
+			event.Queue = patch.Queue
+		case "domain":
+			event.Domain = patch.Domain
+		case "is_active":
+			event.IsActive = patch.IsActive
+		case "next_run_time":
+			event.NextRunTime = patch.NextRunTime
+		case "repeat_times":
+			event.RepeatTimes = patch.RepeatTimes
+		case "scheduler_at":
+			event.SchedulerAt = patch.SchedulerAt
+		case "cron_exp":
+			event.CronExp = patch.CronExp
+		case "retry_policy":
+			event.RetryPolicy = fromRetryPolicyProto(patch.RetryPolicy)
+		default:
+			return status.Errorf(codes.InvalidArgument, "field %s cannot be patched", path)
+		}
 	}
 	return nil
 }
 
-func (_self *SchedulerEventController) checkInserRateLimit(ctx context.Context, key string) error {
-	ctx = logging.AppendPrefix(ctx, "checkInserRateLimit")
+// maxBulkEvents bounds one bulk request, bigger catalogues are sent in several requests
+const maxBulkEvents = 1000
 
-	logging.Infof(ctx, "rate limit is called")
+func (_self *SchedulerEventController) BulkCreateSchedulerEvents(
+	ctx context.Context,
+	req *schedulerv1.BulkSchedulerEventsRequest,
+) (*schedulerv1.BulkSchedulerEventsResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "BulkCreateSchedulerEvents")
+	return _self.bulkSaveSchedulerEvents(ctx, req, false)
+}
 
-	// rate limit 50 request/ second you can use LimitSecond(50 /*rate*/, 1)
-	pass, err := _self.ratelimter.Allow(ctx, "create_event", key, utils.LimitCustom(50 /*rate*/, 1 /*burst*/, time.Second))
-	if err != nil {
-		return err
+func (_self *SchedulerEventController) BulkUpsertSchedulerEvents(
+	ctx context.Context,
+	req *schedulerv1.BulkSchedulerEventsRequest,
+) (*schedulerv1.BulkSchedulerEventsResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "BulkUpsertSchedulerEvents")
+	return _self.bulkSaveSchedulerEvents(ctx, req, true)
+}
+
+func (_self *SchedulerEventController) bulkSaveSchedulerEvents(
+	ctx context.Context,
+	req *schedulerv1.BulkSchedulerEventsRequest,
+	upsert bool,
+) (*schedulerv1.BulkSchedulerEventsResponse, error) {
+	if len(req.Events) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "events are empty")
+	}
+	if len(req.Events) > maxBulkEvents {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d events are accepted in one request", maxBulkEvents)
+	}
+	results := make([]entity.BulkEventResult, 0, len(req.Events))
+	rows := make([]entity.BulkEventRow, 0, len(req.Events))
+	for i, reqEvent := range req.Events {
+		event, err := _self.toBulkEvent(ctx, reqEvent, upsert)
+		if err != nil {
+			results = append(results, entity.BulkEventResult{
+				Index:  i,
+				Status: entity.BulkStatusError,
+				Error:  err.Error(),
+			})
+			continue
+		}
+		rows = append(rows, entity.BulkEventRow{
+			Index: i,
+			Event: event,
+		})
+	}
+	if len(rows) > 0 {
+		results = append(results, _self.SchedulerEventService.BulkSaveSchedulerEvents(ctx, rows, upsert, req.DryRun)...)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Index < results[j].Index
+	})
+
+	resp := &schedulerv1.BulkSchedulerEventsResponse{
+		Results: make([]*schedulerv1.BulkEventResult, len(results)),
 	}
-	logging.Infof(ctx, "rate limit is called after")
-	if !pass {
-		return fmt.Errorf("rate limit exceeded")
+	for i, result := range results {
+		resp.Results[i] = &schedulerv1.BulkEventResult{
+			Index:  int32(result.Index),
+			Status: result.Status,
+			Error:  result.Error,
+		}
+		if result.Id > 0 {
+			resp.Results[i].Id = strconv.FormatInt(result.Id, 10)
+		}
+		if result.Status == entity.BulkStatusError {
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+	}
+	logging.Infof(ctx, "bulk events: succeeded %d, failed %d, dry run %v", resp.Succeeded, resp.Failed, req.DryRun)
+	return resp, nil
+}
+
+// toBulkEvent maps and validates one row of a bulk request the same way as a single create/update.
+// A row keeps its is_active like the rows of the export, a row without it is saved paused.
+func (_self *SchedulerEventController) toBulkEvent(ctx context.Context, reqEvent *schedulerv1.SchedulerEvent, upsert bool) (*entity.SchedulerEvent, error) {
+	action := internalvalidator.ActionInsert
+	if upsert && reqEvent.Id != "" {
+		action = internalvalidator.ActionEdit
+	}
+	if err := _self.internalvalidator.ValidateEvent(ctx, action, reqEvent); err != nil {
+		if st, ok := status.FromError(err); ok {
+			return nil, errors.New(st.Message())
+		}
+		return nil, err
+	}
+	event := &entity.SchedulerEvent{
+		Name:        reqEvent.Name,
+		Team:        reqEvent.Team,
+		Url:         reqEvent.Url,
+		Method:      reqEvent.Method,
+		Description: reqEvent.Description,
+		Queue:       reqEvent.Queue,
+		Domain:      reqEvent.Domain,
+		IsActive:    reqEvent.IsActive,
+		NextRunTime: reqEvent.NextRunTime,
+		RepeatTimes: reqEvent.RepeatTimes,
+		SchedulerAt: reqEvent.SchedulerAt,
+		Status:      domain.StatusPending,
+		CronExp:     reqEvent.CronExp,
+		RetryPolicy: fromRetryPolicyProto(reqEvent.RetryPolicy),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if reqEvent.Id != "" {
+		id, err := strconv.ParseInt(reqEvent.Id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID format")
+		}
+		event.Id = id
+		if upsert {
+			event.Version = reqEvent.Version
+		}
+	}
+	return event, nil
+}
+
+func (_self *SchedulerEventController) ExportSchedulerEvents(
+	req *schedulerv1.ExportSchedulerEventsRequest,
+	stream grpc.ServerStreamingServer[httpbody.HttpBody],
+) error {
+	ctx := logging.InjectTraceId(stream.Context())
+	ctx = logging.ResetPrefix(ctx, "ExportSchedulerEvents")
+	err := _self.SchedulerEventService.ExportSchedulerEvents(ctx, strings.ToLower(req.Format), func(contentType string, chunk []byte) error {
+		return stream.Send(&httpbody.HttpBody{
+			ContentType: contentType,
+			Data:        chunk,
+		})
+	})
+	if err != nil {
+		return toStatusError(err, "failed to export events")
 	}
 	return nil
 }
@@ -220,9 +477,229 @@ func (_self *SchedulerEventController) UpdateEventStatus(ctx context.Context, re
 	ctx = logging.InjectTraceId(ctx)
 	logging.ResetPrefix(ctx, "UpdateEventStatus")
 	logging.Infof(ctx, "update status of event: %s", req)
+	// the results of the runs are reported by the crawler with ReportRunResult of the internal API
+	if req.RunId != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "run_id is not accepted, run results are reported by the crawler")
+	}
 	err := _self.SchedulerEventService.UpdateEventStatus(ctx, req.Id, domain.StatusEnum(req.Status))
 	if err != nil {
 		return nil, err
 	}
 	return &schedulerv1.UpdateEventStatusResponse{}, nil
 }
+
+func (_self *SchedulerEventController) DeleteSchedulerEvent(ctx context.Context, req *schedulerv1.DeleteSchedulerEventRequest) (*schedulerv1.DeleteSchedulerEventResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "DeleteSchedulerEvent")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	if err := _self.SchedulerEventService.DeleteSchedulerEvent(ctx, id); err != nil {
+		return nil, toStatusError(err, "failed to delete event")
+	}
+	return &schedulerv1.DeleteSchedulerEventResponse{
+		Id:     req.Id,
+		Status: "deleted",
+	}, nil
+}
+
+func (_self *SchedulerEventController) RestoreSchedulerEvent(ctx context.Context, req *schedulerv1.RestoreSchedulerEventRequest) (*schedulerv1.RestoreSchedulerEventResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "RestoreSchedulerEvent")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	if err := _self.SchedulerEventService.RestoreSchedulerEvent(ctx, id); err != nil {
+		return nil, toStatusError(err, "failed to restore event")
+	}
+	return &schedulerv1.RestoreSchedulerEventResponse{
+		Id:     req.Id,
+		Status: "restored",
+	}, nil
+}
+
+func (_self *SchedulerEventController) PurgeSchedulerEvent(ctx context.Context, req *schedulerv1.PurgeSchedulerEventRequest) (*schedulerv1.PurgeSchedulerEventResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "PurgeSchedulerEvent")
+	if err := auth.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	if err := _self.SchedulerEventService.PurgeSchedulerEvent(ctx, id); err != nil {
+		return nil, toStatusError(err, "failed to purge event")
+	}
+	return &schedulerv1.PurgeSchedulerEventResponse{
+		Id:     req.Id,
+		Status: "purged",
+	}, nil
+}
+
+func (_self *SchedulerEventController) RunNow(ctx context.Context, req *schedulerv1.RunNowRequest) (*schedulerv1.RunNowResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "RunNow")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	runId, err := _self.SchedulerEventService.RunNow(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to run event")
+	}
+	return &schedulerv1.RunNowResponse{
+		Id:    req.Id,
+		RunId: runId,
+	}, nil
+}
+
+func (_self *SchedulerEventController) PauseSchedulerEvent(ctx context.Context, req *schedulerv1.PauseSchedulerEventRequest) (*schedulerv1.PauseSchedulerEventResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "PauseSchedulerEvent")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	if err := _self.SchedulerEventService.Pause(ctx, id); err != nil {
+		return nil, toStatusError(err, "failed to pause event")
+	}
+	return &schedulerv1.PauseSchedulerEventResponse{
+		Id:     req.Id,
+		Status: "paused",
+	}, nil
+}
+
+func (_self *SchedulerEventController) ResumeSchedulerEvent(ctx context.Context, req *schedulerv1.ResumeSchedulerEventRequest) (*schedulerv1.ResumeSchedulerEventResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ResumeSchedulerEvent")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	if err := _self.SchedulerEventService.Resume(ctx, id); err != nil {
+		return nil, toStatusError(err, "failed to resume event")
+	}
+	return &schedulerv1.ResumeSchedulerEventResponse{
+		Id:     req.Id,
+		Status: "resumed",
+	}, nil
+}
+
+func (_self *SchedulerEventController) SkipNext(ctx context.Context, req *schedulerv1.SkipNextRequest) (*schedulerv1.SkipNextResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "SkipNext")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	skippedAt, schedulerAt, err := _self.SchedulerEventService.SkipNext(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to skip next run")
+	}
+	return &schedulerv1.SkipNextResponse{
+		Id:          req.Id,
+		SkippedAt:   skippedAt,
+		SchedulerAt: schedulerAt,
+	}, nil
+}
+
+func (_self *SchedulerEventController) Backfill(ctx context.Context, req *schedulerv1.BackfillRequest) (*schedulerv1.BackfillResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "Backfill")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	runIds, skipped, err := _self.SchedulerEventService.Backfill(ctx, id, req.From, req.To)
+	if err != nil {
+		return nil, toStatusError(err, "failed to backfill event")
+	}
+	return &schedulerv1.BackfillResponse{
+		Id:      req.Id,
+		RunIds:  runIds,
+		Skipped: int32(skipped),
+	}, nil
+}
+
+func toSchedulerEventProto(event *entity.SchedulerEvent) *schedulerv1.SchedulerEvent {
+	return &schedulerv1.SchedulerEvent{
+		Id:          fmt.Sprintf("%d", event.Id),
+		Name:        event.Name,
+		Team:        event.Team,
+		Url:         event.Url,
+		Method:      event.Method,
+		Description: event.Description,
+		Queue:       event.Queue,
+		Domain:      event.Domain,
+		IsActive:    event.IsActive,
+		NextRunTime: event.NextRunTime,
+		RepeatTimes: event.RepeatTimes,
+		SchedulerAt: event.SchedulerAt,
+		Status:      string(event.Status),
+		CronExp:     event.CronExp,
+		RetryPolicy: toRetryPolicyProto(event.RetryPolicy),
+		Version:     event.Version,
+		CreatedAt:   event.CreatedAt.String(),
+		UpdatedAt:   event.UpdatedAt.String(),
+	}
+}
+
+func (_self *SchedulerEventController) PreviewCrawl(ctx context.Context, req *schedulerv1.PreviewCrawlRequest) (*schedulerv1.PreviewCrawlResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "PreviewCrawl")
+	if req == nil || req.Event == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request or event is nil")
+	}
+	preview, err := _self.crawlPreviewService.PreviewCrawl(ctx, &entity.SchedulerEvent{
+		Url:    req.Event.Url,
+		Method: req.Event.Method,
+		Queue:  req.Event.Queue,
+		Domain: req.Event.Domain,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to preview crawl")
+	}
+	return &schedulerv1.PreviewCrawlResponse{
+		Preview: &schedulerv1.CrawlPreview{
+			StatusCode:   int32(preview.StatusCode),
+			FetchMs:      preview.FetchDuration.Milliseconds(),
+			ExtractMs:    preview.ExtractDuration.Milliseconds(),
+			DurationMs:   preview.Duration.Milliseconds(),
+			Record:       preview.Record,
+			Notification: preview.Notification,
+			Violations:   preview.Violations,
+			Error:        preview.Error,
+		},
+	}, nil
+}
+
+func fromRetryPolicyProto(policy *schedulerv1.RetryPolicy) *domain.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &domain.RetryPolicy{
+		MaxAttempts:    policy.MaxAttempts,
+		InitialDelayMs: policy.InitialDelayMs,
+		Multiplier:     policy.Multiplier,
+		MaxDelayMs:     policy.MaxDelayMs,
+		Jitter:         policy.Jitter,
+		RetryOn:        policy.RetryOn,
+	}
+}
+
+func toRetryPolicyProto(policy *domain.RetryPolicy) *schedulerv1.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &schedulerv1.RetryPolicy{
+		MaxAttempts:    policy.MaxAttempts,
+		InitialDelayMs: policy.InitialDelayMs,
+		Multiplier:     policy.Multiplier,
+		MaxDelayMs:     policy.MaxDelayMs,
+		Jitter:         policy.Jitter,
+		RetryOn:        policy.RetryOn,
+	}
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/entity"
+	internalvalidator "github.com/namnv2496/scheduler/internal/validator"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+type ValidationController struct {
+	schedulerv1.UnimplementedValidationServiceServer
+	internalvalidator internalvalidator.IValidate
+}
+
+func NewValidationController(
+	internalvalidator internalvalidator.IValidate,
+) schedulerv1.ValidationServiceServer {
+	return &ValidationController{
+		internalvalidator: internalvalidator,
+	}
+}
+
+func (_self *ValidationController) GetValidationRules(
+	ctx context.Context,
+	req *schedulerv1.GetValidationRulesRequest,
+) (*schedulerv1.GetValidationRulesResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetValidationRules")
+	rules, err := toValidationRules(ctx, _self.internalvalidator.Rules())
+	if err != nil {
+		return nil, err
+	}
+	return &schedulerv1.GetValidationRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
+func (_self *ValidationController) PutValidationRules(
+	ctx context.Context,
+	req *schedulerv1.PutValidationRulesRequest,
+) (*schedulerv1.PutValidationRulesResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "PutValidationRules")
+	version, err := expectedVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	var rules entity.ValidationRules
+	if err := yaml.Unmarshal([]byte(req.Content), &rules); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "content is not valid YAML or JSON: %v", err)
+	}
+	saved, err := _self.internalvalidator.SaveRules(ctx, &rules, version)
+	if err != nil {
+		return nil, toStatusError(err, "failed to save validation rules")
+	}
+	logging.Infof(ctx, "validation rules version %d are saved", saved.Version)
+	resp, err := toValidationRules(ctx, saved)
+	if err != nil {
+		return nil, err
+	}
+	return &schedulerv1.PutValidationRulesResponse{
+		Rules: resp,
+	}, nil
+}
+
+func (_self *ValidationController) ReloadValidationRules(
+	ctx context.Context,
+	req *schedulerv1.ReloadValidationRulesRequest,
+) (*schedulerv1.ReloadValidationRulesResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "ReloadValidationRules")
+	rules, err := _self.internalvalidator.Reload(ctx)
+	if err != nil {
+		// the file is broken, the rules in use are kept
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to reload validation rules: %v", err)
+	}
+	resp, err := toValidationRules(ctx, rules)
+	if err != nil {
+		return nil, err
+	}
+	return &schedulerv1.ReloadValidationRulesResponse{
+		Rules: resp,
+	}, nil
+}
+
+// toValidationRules encodes the rules as YAML and sends their version as ETag
+func toValidationRules(ctx context.Context, rules *entity.ValidationRules) (*schedulerv1.ValidationRules, error) {
+	content, err := yaml.Marshal(rules)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode validation rules: %v", err)
+	}
+	setETag(ctx, rules.Version)
+	return &schedulerv1.ValidationRules{
+		Version: rules.Version,
+		Content: string(content),
+	}, nil
+}
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/service"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WorkflowController struct {
+	schedulerv1.UnimplementedWorkflowServiceServer
+	workflowService service.IWorkflowService
+}
+
+func NewWorkflowController(
+	workflowService service.IWorkflowService,
+) schedulerv1.WorkflowServiceServer {
+	return &WorkflowController{
+		workflowService: workflowService,
+	}
+}
+
+func (_self *WorkflowController) CreateWorkflow(
+	ctx context.Context,
+	req *schedulerv1.CreateWorkflowRequest,
+) (*schedulerv1.CreateWorkflowResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "CreateWorkflow")
+	if req == nil || req.Workflow == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request or workflow is nil")
+	}
+	workflow := &entity.Workflow{
+		Name:        req.Workflow.Name,
+		Description: req.Workflow.Description,
+	}
+	for _, step := range req.Workflow.Steps {
+		workflow.Steps = append(workflow.Steps, entity.WorkflowStep{
+			EventId:   step.EventId,
+			DependsOn: step.DependsOn,
+		})
+	}
+	id, err := _self.workflowService.CreateWorkflow(ctx, workflow)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create workflow")
+	}
+	return &schedulerv1.CreateWorkflowResponse{
+		Id:     strconv.FormatInt(id, 10),
+		Status: strconv.Itoa(http.StatusCreated),
+	}, nil
+}
+
+func (_self *WorkflowController) GetWorkflow(
+	ctx context.Context,
+	req *schedulerv1.GetWorkflowRequest,
+) (*schedulerv1.GetWorkflowResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetWorkflow")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	workflow, err := _self.workflowService.GetWorkflow(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get workflow")
+	}
+	resp := &schedulerv1.Workflow{
+		Id:          strconv.FormatInt(workflow.Id, 10),
+		Name:        workflow.Name,
+		Description: workflow.Description,
+		CreatedAt:   workflow.CreatedAt.String(),
+		UpdatedAt:   workflow.UpdatedAt.String(),
+	}
+	for _, step := range workflow.Steps {
+		resp.Steps = append(resp.Steps, &schedulerv1.WorkflowStep{
+			EventId:   step.EventId,
+			DependsOn: step.DependsOn,
+		})
+	}
+	return &schedulerv1.GetWorkflowResponse{
+		Workflow: resp,
+	}, nil
+}
+
+func (_self *WorkflowController) StartWorkflowRun(
+	ctx context.Context,
+	req *schedulerv1.StartWorkflowRunRequest,
+) (*schedulerv1.StartWorkflowRunResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "StartWorkflowRun")
+	workflowId, err := strconv.ParseInt(req.WorkflowId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workflow ID format")
+	}
+	run, err := _self.workflowService.StartWorkflowRun(ctx, workflowId)
+	if err != nil {
+		return nil, toStatusError(err, "failed to start workflow run")
+	}
+	return &schedulerv1.StartWorkflowRunResponse{
+		Run: toWorkflowRunProto(run),
+	}, nil
+}
+
+func (_self *WorkflowController) GetWorkflowRun(
+	ctx context.Context,
+	req *schedulerv1.GetWorkflowRunRequest,
+) (*schedulerv1.GetWorkflowRunResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "GetWorkflowRun")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	run, err := _self.workflowService.GetWorkflowRun(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get workflow run")
+	}
+	return &schedulerv1.GetWorkflowRunResponse{
+		Run: toWorkflowRunProto(run),
+	}, nil
+}
+
+func (_self *WorkflowController) CancelWorkflowRun(
+	ctx context.Context,
+	req *schedulerv1.CancelWorkflowRunRequest,
+) (*schedulerv1.CancelWorkflowRunResponse, error) {
+	ctx = logging.InjectTraceId(ctx)
+	ctx = logging.ResetPrefix(ctx, "CancelWorkflowRun")
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ID format")
+	}
+	run, err := _self.workflowService.CancelWorkflowRun(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to cancel workflow run")
+	}
+	return &schedulerv1.CancelWorkflowRunResponse{
+		Run: toWorkflowRunProto(run),
+	}, nil
+}
+
+func toWorkflowRunProto(run *entity.WorkflowRun) *schedulerv1.WorkflowRun {
+	resp := &schedulerv1.WorkflowRun{
+		Id:         strconv.FormatInt(run.Id, 10),
+		WorkflowId: strconv.FormatInt(run.WorkflowId, 10),
+		Status:     string(run.Status),
+		CreatedAt:  run.CreatedAt.String(),
+		UpdatedAt:  run.UpdatedAt.String(),
+	}
+	if run.FinishedAt != nil {
+		resp.FinishedAt = run.FinishedAt.String()
+	}
+	for _, step := range run.Steps {
+		elem := &schedulerv1.WorkflowRunStep{
+			EventId:   step.EventId,
+			RunId:     step.RunId,
+			DependsOn: step.DependsOn,
+			Status:    string(step.Status),
+			Error:     step.Error,
+		}
+		if step.StartedAt != nil {
+			elem.StartedAt = step.StartedAt.String()
+		}
+		if step.FinishedAt != nil {
+			elem.FinishedAt = step.FinishedAt.String()
+		}
+		resp.Steps = append(resp.Steps, elem)
+	}
+	return resp
+}
+
+// toStatusError keeps the status returned by the service, any other error is internal
+func toStatusError(err error, message string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+type DeadLetterStatusEnum string
+
+const (
+	DeadLetterStatusPending   DeadLetterStatusEnum = "pending"
+	DeadLetterStatusReplayed  DeadLetterStatusEnum = "replayed"
+	DeadLetterStatusDiscarded DeadLetterStatusEnum = "discarded"
+)
+
+// DeadLetter is a message given up by the crawler workers, mirrored from the dead-letter topic.
+// Topic, Partition and Offset locate the message in the topic of its queue, they are empty for the
+// events which failed their retries. DeadLetterPartition and DeadLetterOffset locate it in the
+// dead-letter topic, they make the mirror idempotent.
+type DeadLetter struct {
+	Id                  int64                `gorm:"column:id;primaryKey" json:"id"`
+	Queue               string               `gorm:"column:queue" json:"queue"`
+	EventId             int64                `gorm:"column:event_id" json:"event_id"`
+	RunId               string               `gorm:"column:run_id" json:"run_id"`
+	Topic               string               `gorm:"column:topic" json:"topic"`
+	Partition           int                  `gorm:"column:partition" json:"partition"`
+	Offset              int64                `gorm:"column:offset" json:"offset"`
+	Key                 string               `gorm:"column:key" json:"key"`
+	Payload             []byte               `gorm:"column:payload;type:bytea" json:"payload"`
+	Error               string               `gorm:"column:error;type:text" json:"error"`
+	Attempts            int64                `gorm:"column:attempts" json:"attempts"`
+	Status              DeadLetterStatusEnum `gorm:"column:status" json:"status"`
+	Replays             int                  `gorm:"column:replays" json:"replays"`
+	DeadLetterPartition int                  `gorm:"column:dead_letter_partition;uniqueIndex:dead_letters_position_idx" json:"dead_letter_partition"`
+	DeadLetterOffset    int64                `gorm:"column:dead_letter_offset;uniqueIndex:dead_letters_position_idx" json:"dead_letter_offset"`
+	FailedAt            time.Time            `gorm:"column:failed_at" json:"failed_at"`
+	ReplayedAt          *time.Time           `gorm:"column:replayed_at" json:"replayed_at"`
+	DiscardedAt         *time.Time           `gorm:"column:discarded_at" json:"discarded_at"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (DeadLetter) TableName() string {
+	return "dead_letters"
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type TriggerEnum string
+
+const (
+	TriggerCron     TriggerEnum = "cron"
+	TriggerWorkflow TriggerEnum = "workflow"
+	TriggerManual   TriggerEnum = "manual"
+	TriggerBackfill TriggerEnum = "backfill"
+	TriggerReplay   TriggerEnum = "replay"
+	// pause, resume and skip do not run the event, they are kept in the history as finished runs
+	TriggerPause  TriggerEnum = "pause"
+	TriggerResume TriggerEnum = "resume"
+	TriggerSkip   TriggerEnum = "skip"
+)
+
+// EventRun is one execution of an event, it is the run history of the scheduler
+type EventRun struct {
+	Id            int64       `gorm:"column:id;primaryKey" json:"id"`
+	RunId         string      `gorm:"column:run_id" json:"run_id"`
+	EventId       int64       `gorm:"column:event_id" json:"event_id"`
+	WorkflowRunId int64       `gorm:"column:workflow_run_id" json:"workflow_run_id"`
+	Trigger       TriggerEnum `gorm:"column:trigger" json:"trigger"`
+	Status        StatusEnum  `gorm:"column:status" json:"status"`
+	ScheduledAt   int64       `gorm:"column:scheduled_at" json:"scheduled_at"`
+	DependsOn     string      `gorm:"column:depends_on" json:"depends_on"`
+	Error         string      `gorm:"column:error;type:text" json:"error"`
+	DurationMs    int64       `gorm:"column:duration_ms" json:"duration_ms"`
+	ResultId      string      `gorm:"column:result_id" json:"result_id"`
+	StartedAt     *time.Time  `gorm:"column:started_at" json:"started_at"`
+	FinishedAt    *time.Time  `gorm:"column:finished_at" json:"finished_at"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (EventRun) TableName() string {
+	return "event_runs"
+}
+
+func (_self EventRun) IsFinished() bool {
+	return _self.Status == StatusSuccessed || _self.Status == StatusFailed || _self.Status == StatusCancelled || _self.Status == StatusSkipped
+}
+
+// FormatIds stores a list of event ids as "11,13,14"
+func FormatIds(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
+
+func ParseIds(value string) []int64 {
+	ids := make([]int64, 0)
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+)
+
+type OutboxStatusEnum string
+
+const (
+	OutboxStatusPending   OutboxStatusEnum = "pending"
+	OutboxStatusSent      OutboxStatusEnum = "sent"
+	OutboxStatusFailed    OutboxStatusEnum = "failed"
+	OutboxStatusCancelled OutboxStatusEnum = "cancelled"
+)
+
+// Outbox is a message waiting to be relayed to Kafka. It is written in the same
+// transaction as the state change that produced it. Topic is the queue of the event,
+// the relay publishes to the topic of the queue.
+type Outbox struct {
+	Id            int64            `gorm:"column:id;primaryKey" json:"id"`
+	EventId       int64            `gorm:"column:event_id" json:"event_id"`
+	RunId         string           `gorm:"column:run_id" json:"run_id"`
+	Topic         string           `gorm:"column:topic" json:"topic"`
+	Key           string           `gorm:"column:key" json:"key"`
+	Payload       string           `gorm:"column:payload;type:text" json:"payload"`
+	Status        OutboxStatusEnum `gorm:"column:status" json:"status"`
+	Attempts      int              `gorm:"column:attempts" json:"attempts"`
+	LastError     string           `gorm:"column:last_error;type:text" json:"last_error"`
+	NextAttemptAt time.Time        `gorm:"column:next_attempt_at" json:"next_attempt_at"`
+	SentAt        *time.Time       `gorm:"column:sent_at" json:"sent_at"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (Outbox) TableName() string {
+	return "scheduler_outbox"
+}
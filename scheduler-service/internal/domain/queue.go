@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// Queue is a named queue of the crawler workers, the events of the queue are published to its Topic.
+// The workers share themselves between the queues by Weight, read RateLimit messages per second from
+// the topic and run up to MaxConcurrency crawls of the queue at once.
+type Queue struct {
+	Id             int64  `gorm:"column:id;primaryKey" json:"id"`
+	Name           string `gorm:"column:name" json:"name"`
+	Topic          string `gorm:"column:topic" json:"topic"`
+	Weight         int    `gorm:"column:weight;default:1" json:"weight"`
+	RateLimit      int    `gorm:"column:rate_limit;default:10" json:"rate_limit"`
+	MaxConcurrency int    `gorm:"column:max_concurrency;default:1" json:"max_concurrency"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (Queue) TableName() string {
+	return "queues"
+}
+
+// Priority of the queue for the tenant quotas, the queues weighted above the default are priority queues
+func (_self Queue) Priority() int {
+	if _self.Weight > 1 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// error classes of a failed crawl, the crawler sorts its errors into them
+const (
+	ErrorClassTimeout          = "timeout"
+	ErrorClassConnection       = "connection"
+	ErrorClassServerError      = "server_error"
+	ErrorClassRateLimited      = "rate_limited"
+	ErrorClassNotFound         = "not_found"
+	ErrorClassClientError      = "client_error"
+	ErrorClassRobotsDisallowed = "robots_disallowed"
+	ErrorClassInvalid          = "invalid"
+	ErrorClassUnknown          = "unknown"
+)
+
+// limits of a retry policy, they are the proto rules of the API as well
+const (
+	MaxRetryAttempts = 20
+	MaxRetryDelayMs  = 24 * 60 * 60 * 1000
+)
+
+var ErrorClasses = []string{
+	ErrorClassTimeout, ErrorClassConnection, ErrorClassServerError, ErrorClassRateLimited, ErrorClassNotFound,
+	ErrorClassClientError, ErrorClassRobotsDisallowed, ErrorClassInvalid, ErrorClassUnknown,
+}
+
+// RetryPolicy is how the crawler retries the failed crawls of an event. The zero fields, and a nil
+// Jitter, take the defaults of the crawler. The n-th retry waits InitialDelayMs * Multiplier^(n-1),
+// moved by up to Jitter of itself and capped by MaxDelayMs, and only the errors of RetryOn are retried.
+// It is stored as JSON in the retry_policy column.
+type RetryPolicy struct {
+	// MaxAttempts counts the first crawl, 1 never retries
+	MaxAttempts    int32    `json:"max_attempts,omitempty" yaml:"max_attempts,omitempty"`
+	InitialDelayMs int64    `json:"initial_delay_ms,omitempty" yaml:"initial_delay_ms,omitempty"`
+	Multiplier     float64  `json:"multiplier,omitempty" yaml:"multiplier,omitempty"`
+	MaxDelayMs     int64    `json:"max_delay_ms,omitempty" yaml:"max_delay_ms,omitempty"`
+	Jitter         *float64 `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+	RetryOn        []string `json:"retry_on,omitempty" yaml:"retry_on,omitempty"`
+}
+
+// Validate checks the policies which do not come through the API, the API checks them with the proto rules
+func (_self *RetryPolicy) Validate() error {
+	if _self == nil {
+		return nil
+	}
+	if _self.MaxAttempts < 0 || _self.MaxAttempts > MaxRetryAttempts {
+		return fmt.Errorf("retry_policy.max_attempts must be between 0 and %d", MaxRetryAttempts)
+	}
+	if _self.InitialDelayMs < 0 || _self.InitialDelayMs > MaxRetryDelayMs || _self.MaxDelayMs < 0 || _self.MaxDelayMs > MaxRetryDelayMs {
+		return fmt.Errorf("retry_policy delays must be between 0 and %d ms", MaxRetryDelayMs)
+	}
+	if _self.Multiplier != 0 && (_self.Multiplier < 1 || _self.Multiplier > 10) {
+		return fmt.Errorf("retry_policy.multiplier must be between 1 and 10")
+	}
+	if _self.Jitter != nil && (*_self.Jitter < 0 || *_self.Jitter > 1) {
+		return fmt.Errorf("retry_policy.jitter must be between 0 and 1")
+	}
+	for _, class := range _self.RetryOn {
+		if !slices.Contains(ErrorClasses, class) {
+			return fmt.Errorf("retry_policy.retry_on has unknown error class %q", class)
+		}
+	}
+	return nil
+}
+
+func (_self RetryPolicy) Value() (driver.Value, error) {
+	data, err := json.Marshal(_self)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (_self *RetryPolicy) Scan(value any) error {
+	switch data := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(data), _self)
+	case []byte:
+		return json.Unmarshal(data, _self)
+	default:
+		return fmt.Errorf("cannot scan %T into a retry policy", value)
+	}
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type StatusEnum string
@@ -12,6 +14,8 @@ const (
 	StatusFailed    StatusEnum = "failed"
 	StatusSuccessed StatusEnum = "successed"
 	StatusDelete    StatusEnum = "delete"
+	StatusCancelled StatusEnum = "cancelled"
+	StatusSkipped   StatusEnum = "skipped"
 )
 
 func GetStatusEnum(status string) StatusEnum {
@@ -26,6 +30,10 @@ func GetStatusEnum(status string) StatusEnum {
 		return StatusSuccessed
 	case string(StatusDelete):
 		return StatusDelete
+	case string(StatusCancelled):
+		return StatusCancelled
+	case string(StatusSkipped):
+		return StatusSkipped
 	default:
 		return ""
 	}
@@ -33,6 +41,8 @@ func GetStatusEnum(status string) StatusEnum {
 
 type SchedulerEvent struct {
 	Id          int64      `gorm:"column:id;primaryKey" json:"id"`
+	Name        string     `gorm:"column:name" json:"name"`
+	Team        string     `gorm:"column:team" json:"team"`
 	Url         string     `gorm:"column:url;type:text" json:"url"`
 	Method      string     `gorm:"column:method;type:text" json:"method"`
 	Description string     `gorm:"column:description"  json:"description"`
@@ -44,9 +54,15 @@ type SchedulerEvent struct {
 	SchedulerAt int64      `gorm:"column:scheduler_at" json:"scheduler_at"`
 	Status      StatusEnum `gorm:"column:status" json:"status"`
 	CronExp     string     `gorm:"column:cron_exp" json:"cron_exp"`
+	// Version is incremented by every write, a write with a stale version is rejected
+	Version int64 `gorm:"column:version;default:1" json:"version"`
+	// RetryPolicy of the failed crawls, nil takes the defaults of the crawler
+	RetryPolicy *RetryPolicy `gorm:"column:retry_policy;type:text" json:"retry_policy"`
 
 	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+	// DeletedAt makes gorm exclude soft deleted events from every query, use WithUnscoped to see them
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
 }
 
 func (u SchedulerEvent) TableName() string {
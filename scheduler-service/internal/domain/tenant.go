@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Tenant holds the quotas of a team, Name is the team of its events.
+// Teams without a tenant have no quota, a zero maximum is unlimited as well.
+type Tenant struct {
+	Id               int64  `gorm:"column:id;primaryKey" json:"id"`
+	Name             string `gorm:"column:name" json:"name"`
+	MaxActiveEvents  int64  `gorm:"column:max_active_events" json:"max_active_events"`
+	MaxCrawlsPerHour int64  `gorm:"column:max_crawls_per_hour" json:"max_crawls_per_hour"`
+	// MaxQueuePriority is the highest queue the events may use, see domain.Queue.Priority. The column
+	// defaults to 1 in the migration, gorm keeps no default so a tenant can be limited to 0.
+	MaxQueuePriority int `gorm:"column:max_queue_priority" json:"max_queue_priority"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (Tenant) TableName() string {
+	return "tenants"
+}
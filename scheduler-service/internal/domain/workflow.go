@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+)
+
+type Workflow struct {
+	Id          int64  `gorm:"column:id;primaryKey" json:"id"`
+	Name        string `gorm:"column:name" json:"name"`
+	Description string `gorm:"column:description" json:"description"`
+	// Team is the team of the events of the workflow, its runs have the same team
+	Team string `gorm:"column:team" json:"team"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (Workflow) TableName() string {
+	return "workflows"
+}
+
+// WorkflowStep is a node of the workflow DAG. The event runs after every event in DependsOn succeeded.
+type WorkflowStep struct {
+	Id         int64  `gorm:"column:id;primaryKey" json:"id"`
+	WorkflowId int64  `gorm:"column:workflow_id" json:"workflow_id"`
+	EventId    int64  `gorm:"column:event_id" json:"event_id"`
+	DependsOn  string `gorm:"column:depends_on" json:"depends_on"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (WorkflowStep) TableName() string {
+	return "workflow_steps"
+}
+
+type WorkflowRun struct {
+	Id         int64      `gorm:"column:id;primaryKey" json:"id"`
+	WorkflowId int64      `gorm:"column:workflow_id" json:"workflow_id"`
+	Status     StatusEnum `gorm:"column:status" json:"status"`
+	Team       string     `gorm:"column:team" json:"team"`
+	FinishedAt *time.Time `gorm:"column:finished_at" json:"finished_at"`
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (WorkflowRun) TableName() string {
+	return "workflow_runs"
+}
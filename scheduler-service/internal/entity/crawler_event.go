@@ -0,0 +1,36 @@
+package entity
+
+import "fmt"
+
+// CrawlerEvent is the message published to the crawler queues
+type CrawlerEvent struct {
+	SchedulerEvent
+	// RunId identifies one scheduled slot of the event, the crawler uses it to drop duplicate deliveries
+	RunId string `json:"run_id"`
+}
+
+func BuildRunId(eventId, scheduledAt int64) string {
+	return fmt.Sprintf("%d-%d", eventId, scheduledAt)
+}
+
+// BuildManualRunId identifies a run triggered by RunNow, it never collides with a scheduled slot
+func BuildManualRunId(eventId, triggeredAt int64) string {
+	return fmt.Sprintf("manual%d-%d", eventId, triggeredAt)
+}
+
+// BuildWorkflowRunId identifies the step of a workflow run
+func BuildWorkflowRunId(workflowRunId, eventId int64) string {
+	return fmt.Sprintf("wf%d-%d", workflowRunId, eventId)
+}
+
+// BuildReplayRunId identifies a replay of a dead letter, every replay is a new run
+func BuildReplayRunId(deadLetterId int64, replay int) string {
+	return fmt.Sprintf("replay%d-%d", deadLetterId, replay)
+}
+
+func NewCrawlerEvent(event SchedulerEvent, runId string) CrawlerEvent {
+	return CrawlerEvent{
+		SchedulerEvent: event,
+		RunId:          runId,
+	}
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+// DeadLetter is a message given up by the crawler workers, a pending dead letter can be replayed or discarded
+type DeadLetter struct {
+	Id          int64                       `json:"id"`
+	Queue       string                      `json:"queue"`
+	EventId     int64                       `json:"event_id"`
+	RunId       string                      `json:"run_id"`
+	Topic       string                      `json:"topic"`
+	Partition   int                         `json:"partition"`
+	Offset      int64                       `json:"offset"`
+	Key         string                      `json:"key"`
+	Payload     string                      `json:"payload"`
+	Error       string                      `json:"error"`
+	Attempts    int64                       `json:"attempts"`
+	Status      domain.DeadLetterStatusEnum `json:"status"`
+	Replays     int                         `json:"replays"`
+	FailedAt    time.Time                   `json:"failed_at"`
+	ReplayedAt  *time.Time                  `json:"replayed_at"`
+	DiscardedAt *time.Time                  `json:"discarded_at"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	UpdatedAt   time.Time                   `json:"updated_at"`
+
+	// PayloadBase64 is set when the message is not text, Payload is then base64 encoded
+	PayloadBase64 bool `json:"payload_base64"`
+}
+
+// DeadLetterFilter filters the dead letters, zero values are ignored
+type DeadLetterFilter struct {
+	Queue     string
+	Status    domain.DeadLetterStatusEnum
+	EventId   int64
+	PageSize  int
+	PageToken string
+}
+
+// DeadLetterReplay is the outcome of the replay of one dead letter, Error is set when it is not replayed
+type DeadLetterReplay struct {
+	Id    int64
+	RunId string
+	Error string
+}
@@ -0,0 +1,41 @@
+package entity
+
+import "github.com/namnv2496/scheduler/internal/domain"
+
+// EventManifest declares one event in the config repository, Name is its stable key
+type EventManifest struct {
+	Name        string `yaml:"name"`
+	Url         string `yaml:"url"`
+	Method      string `yaml:"method"`
+	Description string `yaml:"description"`
+	Queue       string `yaml:"queue"`
+	Domain      string `yaml:"domain"`
+	// IsActive defaults to true
+	IsActive    *bool  `yaml:"is_active"`
+	NextRunTime int64  `yaml:"next_run_time"`
+	RepeatTimes int64  `yaml:"repeat_times"`
+	SchedulerAt int64  `yaml:"scheduler_at"`
+	CronExp     string `yaml:"cron_exp"`
+	// RetryPolicy is the defaults of the crawler when it is not set
+	RetryPolicy *domain.RetryPolicy `yaml:"retry_policy"`
+	// File is where the manifest was read from
+	File string `yaml:"-"`
+}
+
+type SyncAction string
+
+const (
+	SyncActionCreate SyncAction = "create"
+	SyncActionUpdate SyncAction = "update"
+	SyncActionDelete SyncAction = "delete"
+)
+
+// SyncChange is one step of the plan to reconcile the database with the manifests
+type SyncChange struct {
+	Action SyncAction
+	Name   string
+	Id     int64
+	// Fields lists the changed columns of an update
+	Fields []string
+	Error  string
+}
@@ -1,6 +1,21 @@
 package entity
 
+import "time"
+
 const (
 	QueueTypeNormal   string = "normal"
 	QueueTypePriority string = "priority"
 )
+
+// Queue is a queue of the crawler workers, the zero values of a new queue take the defaults:
+// the topic of its name, weight 1, 10 messages per second and 1 crawl at once
+type Queue struct {
+	Id             int64     `json:"id"`
+	Name           string    `json:"name"`
+	Topic          string    `json:"topic"`
+	Weight         int       `json:"weight"`
+	RateLimit      int       `json:"rate_limit"`
+	MaxConcurrency int       `json:"max_concurrency"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
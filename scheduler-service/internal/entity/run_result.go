@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+// RunResult is the outcome of a run reported by the crawler
+type RunResult struct {
+	// EventId is checked against the run when it is set
+	EventId  int64
+	RunId    string
+	Status   domain.StatusEnum
+	Duration time.Duration
+	Error    string
+	ResultId string
+}
@@ -15,6 +15,8 @@ import (
 
 type SchedulerEvent struct {
 	Id          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Team        string            `json:"team"`
 	Url         string            `json:"url"`
 	Method      string            `json:"method"`
 	Description string            `json:"description"`
@@ -26,8 +28,12 @@ type SchedulerEvent struct {
 	SchedulerAt int64             `json:"scheduler_at"`
 	Status      domain.StatusEnum `json:"status"`
 	CronExp     string            `json:"cron_exp"`
+	Version     int64             `json:"version"`
 	CreatedAt   time.Time         `json:"created_at"`
 	UpdatedAt   time.Time         `json:"updated_at"`
+
+	// RetryPolicy of the failed crawls, nil takes the defaults of the crawler
+	RetryPolicy *domain.RetryPolicy `json:"retry_policy"`
 }
 
 func (_self SchedulerEvent) HashKey(key any) string {
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+// SchedulerEventFilter is the query of ListSchedulerEvents, zero values are ignored
+type SchedulerEventFilter struct {
+	Domain      string
+	Queue       string
+	Status      domain.StatusEnum
+	Method      string
+	IsActive    *bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+	Search      string
+	// OrderBy is "<field> [asc|desc]"
+	OrderBy   string
+	PageSize  int
+	PageToken string
+}
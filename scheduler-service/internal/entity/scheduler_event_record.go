@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+// SchedulerEventRecord is one event of an export, it is the format of the job catalogue kept in git
+type SchedulerEventRecord struct {
+	Id          int64             `json:"id" yaml:"id"`
+	Name        string            `json:"name" yaml:"name"`
+	Team        string            `json:"team" yaml:"team"`
+	Url         string            `json:"url" yaml:"url"`
+	Method      string            `json:"method" yaml:"method"`
+	Description string            `json:"description" yaml:"description"`
+	Queue       string            `json:"queue" yaml:"queue"`
+	Domain      string            `json:"domain" yaml:"domain"`
+	IsActive    bool              `json:"is_active" yaml:"is_active"`
+	NextRunTime int64             `json:"next_run_time" yaml:"next_run_time"`
+	RepeatTimes int64             `json:"repeat_times" yaml:"repeat_times"`
+	SchedulerAt int64             `json:"scheduler_at" yaml:"scheduler_at"`
+	Status      domain.StatusEnum `json:"status" yaml:"status"`
+	CronExp     string            `json:"cron_exp" yaml:"cron_exp"`
+
+	RetryPolicy *domain.RetryPolicy `json:"retry_policy,omitempty" yaml:"retry_policy,omitempty"`
+}
+
+// SchedulerEventRecordHeader is the CSV header, in the order of CSVRow
+var SchedulerEventRecordHeader = []string{
+	"id", "name", "team", "url", "method", "description", "queue", "domain", "is_active",
+	"next_run_time", "repeat_times", "scheduler_at", "status", "cron_exp", "retry_policy",
+}
+
+func (_self SchedulerEventRecord) CSVRow() []string {
+	return []string{
+		strconv.FormatInt(_self.Id, 10),
+		_self.Name,
+		_self.Team,
+		_self.Url,
+		_self.Method,
+		_self.Description,
+		_self.Queue,
+		_self.Domain,
+		strconv.FormatBool(_self.IsActive),
+		strconv.FormatInt(_self.NextRunTime, 10),
+		strconv.FormatInt(_self.RepeatTimes, 10),
+		strconv.FormatInt(_self.SchedulerAt, 10),
+		string(_self.Status),
+		_self.CronExp,
+		_self.retryPolicyJSON(),
+	}
+}
+
+// retryPolicyJSON is the retry policy in one CSV cell, empty for the defaults
+func (_self SchedulerEventRecord) retryPolicyJSON() string {
+	if _self.RetryPolicy == nil {
+		return ""
+	}
+	data, err := json.Marshal(_self.RetryPolicy)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+type BulkEventRow struct {
+	// Index is the position of the row in the request
+	Index int
+	Event *SchedulerEvent
+}
+
+type BulkEventResult struct {
+	Index  int
+	Id     int64
+	Status string
+	Error  string
+}
+
+const (
+	BulkStatusCreated = "created"
+	BulkStatusUpdated = "updated"
+	BulkStatusValid   = "valid"
+	BulkStatusError   = "error"
+)
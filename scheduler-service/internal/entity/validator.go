@@ -9,33 +9,63 @@ const (
 	OP_NE  = "ne"
 )
 
+// CrossFieldRule passes when the value matches Pattern, is one of AllowedValues and
+// "value Operator compare" holds, compare is Value or else the value of Field
 type CrossFieldRule struct {
-	Pattern       string
-	ErrorMsg      string
-	AllowedValues []string
-	Operator      string
-	Field         string
-	Value         string
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	// ErrorMsg replaces the message of the catalogue in the default language, ErrorMsgs in the others
+	ErrorMsg      string            `json:"error_msg,omitempty" yaml:"error_msg,omitempty"`
+	ErrorMsgs     map[string]string `json:"error_msgs,omitempty" yaml:"error_msgs,omitempty"`
+	AllowedValues []string          `json:"allowed_values,omitempty" yaml:"allowed_values,omitempty"`
+	Operator      string            `json:"operator,omitempty" yaml:"operator,omitempty"`
+	Field         string            `json:"field,omitempty" yaml:"field,omitempty"`
+	Value         string            `json:"value,omitempty" yaml:"value,omitempty"`
 }
 
 type FieldValidation struct {
-	Label     string `json:"label,omitempty"`
-	MinLength int    `json:"min_length,omitempty"`
-	MaxLength int    `json:"max_length,omitempty"`
-	MinValue  int    `json:"min_value,omitempty"`
-	MaxValue  int    `json:"max_value,omitempty"`
-	MinWord   int    `json:"min_word,omitempty"`
-	MaxWord   int    `json:"max_word,omitempty"`
-}
-
-type Requires struct {
-	Require map[string]map[string]FieldRequire `json:"label,omitempty"`
+	Label     string `json:"label,omitempty" yaml:"label,omitempty"`
+	MinLength int    `json:"min_length,omitempty" yaml:"min_length,omitempty"`
+	MaxLength int    `json:"max_length,omitempty" yaml:"max_length,omitempty"`
+	MinValue  int    `json:"min_value,omitempty" yaml:"min_value,omitempty"`
+	MaxValue  int    `json:"max_value,omitempty" yaml:"max_value,omitempty"`
+	MinWord   int    `json:"min_word,omitempty" yaml:"min_word,omitempty"`
+	MaxWord   int    `json:"max_word,omitempty" yaml:"max_word,omitempty"`
 }
 
+// FieldRequire maps "require" to "1" for an always required field, or the name of
+// another field to the value which makes the field required
 type FieldRequire map[string]string
 
+// ValidationRules is the rules file of the validator, Version grows on every change
+type ValidationRules struct {
+	Version int64 `json:"version" yaml:"version"`
+	// Requires are the required fields of every action, insert or edit
+	Requires map[string]map[string]FieldRequire `json:"requires" yaml:"requires"`
+	Values   map[string]FieldValidation         `json:"values" yaml:"values"`
+	Custom   map[string][]CrossFieldRule        `json:"custom" yaml:"custom"`
+	// Labels are the names of the fields shown in the messages by language
+	Labels map[string]map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	// Targets are the checks of url, cron_exp and next_run_time
+	Targets TargetRules `json:"targets,omitempty" yaml:"targets,omitempty"`
+}
+
 type FieldRequireCondition struct {
 	Conditions []string
 	Param      string
 	Name       string
 }
+
+// TargetRules are the checks of the crawl targets of the events. The host lists take exact hosts and
+// "*.example.com" for the subdomains, an empty allowed list allows every host which is not denied.
+type TargetRules struct {
+	// Schemes of the URLs, http and https when empty
+	Schemes      []string `json:"schemes,omitempty" yaml:"schemes,omitempty"`
+	AllowedHosts []string `json:"allowed_hosts,omitempty" yaml:"allowed_hosts,omitempty"`
+	DeniedHosts  []string `json:"denied_hosts,omitempty" yaml:"denied_hosts,omitempty"`
+	// AllowPrivateIPs lets the events crawl loopback, private and link-local addresses
+	AllowPrivateIPs bool `json:"allow_private_ips,omitempty" yaml:"allow_private_ips,omitempty"`
+	// ResolveHosts checks the addresses of the host names as well, not only the IP literals
+	ResolveHosts bool `json:"resolve_hosts,omitempty" yaml:"resolve_hosts,omitempty"`
+	// MinInterval in milliseconds between two runs of next_run_time or of cron_exp, 0 is no minimum
+	MinInterval int64 `json:"min_interval,omitempty" yaml:"min_interval,omitempty"`
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+type WorkflowStep struct {
+	EventId   int64   `json:"event_id"`
+	DependsOn []int64 `json:"depends_on"`
+}
+
+type Workflow struct {
+	Id          int64          `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Team        string         `json:"team"`
+	Steps       []WorkflowStep `json:"steps"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type WorkflowRunStep struct {
+	EventId    int64             `json:"event_id"`
+	RunId      string            `json:"run_id"`
+	DependsOn  []int64           `json:"depends_on"`
+	Status     domain.StatusEnum `json:"status"`
+	StartedAt  *time.Time        `json:"started_at"`
+	FinishedAt *time.Time        `json:"finished_at"`
+	Error      string            `json:"error"`
+}
+
+type WorkflowRun struct {
+	Id         int64             `json:"id"`
+	WorkflowId int64             `json:"workflow_id"`
+	Status     domain.StatusEnum `json:"status"`
+	Steps      []WorkflowRunStep `json:"steps"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+	FinishedAt *time.Time        `json:"finished_at"`
+}
@@ -0,0 +1,147 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	redisratev9 "github.com/go-redis/redis_rate/v9"
+	"github.com/namnv2496/scheduler/internal/auth"
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/namnv2496/scheduler/pkg/utils"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// metadata of the responses, the gateway returns them as the headers of the same name
+const (
+	LimitHeader      = "x-ratelimit-limit"
+	RemainingHeader  = "x-ratelimit-remaining"
+	ResetHeader      = "x-ratelimit-reset"
+	RetryAfterHeader = "retry-after"
+)
+
+// Interceptor limits every caller on its own, per RPC with the rules of the config. It is chained before
+// the authentication so that the requests it rejects are counted as well.
+type Interceptor struct {
+	rateLimit utils.IRateLimit
+	rules     map[string]redisratev9.Limit
+}
+
+func NewInterceptor(conf *configs.Config, rateLimit utils.IRateLimit) (*Interceptor, error) {
+	rules, err := parseRules(conf.RateLimit.Rules)
+	if err != nil {
+		return nil, err
+	}
+	return &Interceptor{
+		rateLimit: rateLimit,
+		rules:     rules,
+	}, nil
+}
+
+// check takes a request of the caller from the rule of the method and returns the metadata to send.
+// The RPCs without a rule are not limited, a redis failure lets the request pass.
+func (_self *Interceptor) check(ctx context.Context, fullMethod string) (metadata.MD, error) {
+	method, limit, ok := ruleOf(_self.rules, fullMethod)
+	if !ok {
+		return nil, nil
+	}
+	ctx = logging.AppendPrefix(ctx, "ratelimit")
+	key := CallerKey(ctx)
+	decision, err := _self.rateLimit.Check(ctx, method, key, limit)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to check rate limit of %s on %s: %v", key, method, err)
+		return nil, nil
+	}
+	md := metadata.Pairs(
+		LimitHeader, strconv.Itoa(decision.Limit.Burst),
+		RemainingHeader, strconv.Itoa(decision.Remaining),
+		ResetHeader, seconds(decision.ResetAfter),
+	)
+	if decision.Allowed {
+		return md, nil
+	}
+	md.Set(RetryAfterHeader, seconds(decision.RetryAfter))
+	st, detailErr := status.Newf(codes.ResourceExhausted, "rate limit of %s exceeded, retry after %s", method, seconds(decision.RetryAfter)+"s").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(decision.RetryAfter)})
+	if detailErr != nil {
+		return md, status.Errorf(codes.ResourceExhausted, "rate limit of %s exceeded", method)
+	}
+	return md, st.Err()
+}
+
+// CallerKey identifies the caller by its principal or its IP address. The interceptor runs before the
+// authentication, so the callers have no principal there and the flood of rejected credentials is
+// counted by address; the credentials are not keys, a caller rotating bad keys would get a new limit.
+func CallerKey(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok && principal.Subject != auth.AnonymousSubject {
+		return "principal:" + principal.Subject
+	}
+	return "ip:" + peerIP(ctx)
+}
+
+// peerIP trusts the address appended by the gateway only when the call comes from the local gateway
+func peerIP(ctx context.Context) string {
+	ip := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	if parsed := net.ParseIP(ip); parsed == nil || !parsed.IsLoopback() {
+		return ip
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		forwarded := strings.Split(values[len(values)-1], ",")
+		if last := strings.TrimSpace(forwarded[len(forwarded)-1]); last != "" {
+			return last
+		}
+	}
+	return ip
+}
+
+// seconds rounds up, a client waiting for the header value is never early
+func seconds(duration time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(duration.Seconds())), 10)
+}
+
+func (_self *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, err := _self.check(ctx, info.FullMethod)
+		if md != nil {
+			if headerErr := grpc.SetHeader(ctx, md); headerErr != nil {
+				logging.Errorf(ctx, "Failed to set rate limit headers: %v", headerErr)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (_self *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, err := _self.check(stream.Context(), info.FullMethod)
+		if md != nil {
+			if headerErr := stream.SetHeader(md); headerErr != nil {
+				logging.Errorf(stream.Context(), "Failed to set rate limit headers: %v", headerErr)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
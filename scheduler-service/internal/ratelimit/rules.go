@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	redisratev9 "github.com/go-redis/redis_rate/v9"
+	"github.com/namnv2496/scheduler/pkg/utils"
+)
+
+// anyMethod is the rule of the RPCs without their own rule
+const anyMethod = "*"
+
+// parseRules reads the "<method>=<rate>/<period>[/<burst>]" entries of the config, burst defaults to rate
+func parseRules(entries []string) (map[string]redisratev9.Limit, error) {
+	rules := make(map[string]redisratev9.Limit, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("rate limit rule %q must be <method>=<rate>/<period>[/<burst>]", entry)
+		}
+		parts := strings.Split(value, "/")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("rate limit rule %q must be <method>=<rate>/<period>[/<burst>]", entry)
+		}
+		rate, err := strconv.Atoi(parts[0])
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("rate limit rule %q has invalid rate", entry)
+		}
+		period, err := time.ParseDuration(parts[1])
+		if err != nil || period <= 0 {
+			return nil, fmt.Errorf("rate limit rule %q has invalid period", entry)
+		}
+		burst := rate
+		if len(parts) == 3 {
+			if burst, err = strconv.Atoi(parts[2]); err != nil || burst <= 0 {
+				return nil, fmt.Errorf("rate limit rule %q has invalid burst", entry)
+			}
+		}
+		rules[method] = utils.LimitCustom(rate, burst, period)
+	}
+	return rules, nil
+}
+
+// ruleOf returns the rule of the full gRPC method name, its short name or the default rule
+func ruleOf(rules map[string]redisratev9.Limit, fullMethod string) (string, redisratev9.Limit, bool) {
+	if limit, ok := rules[fullMethod]; ok {
+		return fullMethod, limit, true
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if limit, ok := rules[method]; ok {
+		return method, limit, true
+	}
+	limit, ok := rules[anyMethod]
+	return anyMethod, limit, ok
+}
@@ -0,0 +1,72 @@
+package crawlerservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/entity"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type ICrawlerService interface {
+	// PreviewCrawl runs one crawl of the event on a crawler worker, it is not retried
+	PreviewCrawl(ctx context.Context, event *entity.SchedulerEvent) (*entity.CrawlPreview, error)
+}
+
+// crawlerService calls the internal gRPC API of the crawler workers with the internal API key
+type crawlerService struct {
+	client  schedulerv1.CrawlerInternalServiceClient
+	apiKey  string
+	timeout time.Duration
+}
+
+func NewCrawlerService(conf *configs.Config) (ICrawlerService, error) {
+	conn, err := grpc.NewClient(
+		conf.CrawlerService.GRPCHost,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create crawler client: %w", err)
+	}
+	return &crawlerService{
+		client:  schedulerv1.NewCrawlerInternalServiceClient(conn),
+		apiKey:  conf.Internal.APIKey,
+		timeout: conf.CrawlerService.Timeout,
+	}, nil
+}
+
+var _ ICrawlerService = &crawlerService{}
+
+func (_self *crawlerService) PreviewCrawl(ctx context.Context, event *entity.SchedulerEvent) (*entity.CrawlPreview, error) {
+	ctx = logging.AppendPrefix(ctx, "PreviewCrawl")
+	ctx, cancel := context.WithTimeout(ctx, _self.timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+_self.apiKey)
+
+	resp, err := _self.client.PreviewCrawl(ctx, &schedulerv1.CrawlPreviewRequest{
+		Url:    event.Url,
+		Method: event.Method,
+		Queue:  event.Queue,
+		Domain: event.Domain,
+	})
+	if err != nil {
+		logging.Errorf(ctx, "Failed to preview the crawl of %s: %v", event.Url, err)
+		return nil, err
+	}
+	return &entity.CrawlPreview{
+		StatusCode:      int(resp.StatusCode),
+		FetchDuration:   time.Duration(resp.FetchMs) * time.Millisecond,
+		ExtractDuration: time.Duration(resp.ExtractMs) * time.Millisecond,
+		Duration:        time.Duration(resp.DurationMs) * time.Millisecond,
+		Record:          resp.Record,
+		Notification:    resp.Notification,
+		Violations:      resp.Violations,
+		Error:           resp.Error,
+	}, nil
+}
@@ -44,7 +44,17 @@ func NewDatabase(
 	// Set connection pool settings
 	sqlDB.SetMaxIdleConns(10)
 	sqlDB.SetMaxOpenConns(100)
-	db.AutoMigrate(&domain.SchedulerEvent{})
+	db.AutoMigrate(
+		&domain.SchedulerEvent{},
+		&domain.Outbox{},
+		&domain.EventRun{},
+		&domain.Workflow{},
+		&domain.WorkflowStep{},
+		&domain.WorkflowRun{},
+		&domain.Tenant{},
+		&domain.Queue{},
+		&domain.DeadLetter{},
+	)
 	return &Database{db: db}, nil
 }
 
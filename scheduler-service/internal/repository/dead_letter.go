@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+type IDeadLetterRepository interface {
+	IRepository[domain.DeadLetter]
+	// CreateDeadLetter skips a message which is already mirrored, it returns false for it
+	CreateDeadLetter(ctx context.Context, deadLetter *domain.DeadLetter, opts ...QueryOptionFunc) (bool, error)
+	GetDeadLetterByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.DeadLetter, error)
+	ListDeadLetters(ctx context.Context, query DeadLetterQuery, opts ...QueryOptionFunc) ([]*domain.DeadLetter, error)
+	UpdateDeadLetter(ctx context.Context, deadLetter *domain.DeadLetter, opts ...QueryOptionFunc) error
+}
+
+// DeadLetterQuery filters dead letters, zero values are ignored.
+// Rows are sorted from the newest, Before continues from the last id of the previous page.
+type DeadLetterQuery struct {
+	Queue   string
+	Status  domain.DeadLetterStatusEnum
+	EventId int64
+	Ids     []int64
+	Before  int64
+	Limit   int
+}
+
+type DeadLetterRepository struct {
+	baseRepository[domain.DeadLetter]
+}
+
+func NewDeadLetterRepository(
+	conf *configs.Config,
+	dbSource IDatabase,
+) IDeadLetterRepository {
+	return &DeadLetterRepository{
+		baseRepository: newBaseRepository[domain.DeadLetter](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+	}
+}
+
+func (_self *DeadLetterRepository) CreateDeadLetter(ctx context.Context, deadLetter *domain.DeadLetter, opts ...QueryOptionFunc) (bool, error) {
+	opts = append(opts, WithOnConflictDoNothing())
+	tx := _self.db.WithContext(ctx)
+	for _, opt := range opts {
+		tx = opt(tx)
+	}
+	result := tx.Create(deadLetter)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (_self *DeadLetterRepository) GetDeadLetterByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.DeadLetter, error) {
+	opts = append(opts, WithCondition("id = ?", id))
+	opts = append(opts, WithLimit(1))
+	return _self.Find(ctx, opts...)
+}
+
+func (_self *DeadLetterRepository) ListDeadLetters(ctx context.Context, query DeadLetterQuery, opts ...QueryOptionFunc) ([]*domain.DeadLetter, error) {
+	if query.Queue != "" {
+		opts = append(opts, WithCondition("queue = ?", query.Queue))
+	}
+	if query.Status != "" {
+		opts = append(opts, WithCondition("status = ?", query.Status))
+	}
+	if query.EventId > 0 {
+		opts = append(opts, WithCondition("event_id = ?", query.EventId))
+	}
+	if len(query.Ids) > 0 {
+		opts = append(opts, WithCondition("id IN ?", query.Ids))
+	}
+	if query.Before > 0 {
+		opts = append(opts, WithCondition("id < ?", query.Before))
+	}
+	opts = append(opts, WithOrderBy("id DESC"))
+	if query.Limit > 0 {
+		opts = append(opts, WithLimit(query.Limit))
+	}
+	return _self.Finds(ctx, opts...)
+}
+
+func (_self *DeadLetterRepository) UpdateDeadLetter(ctx context.Context, deadLetter *domain.DeadLetter, opts ...QueryOptionFunc) error {
+	opts = append(opts, WithCondition("id = ?", deadLetter.Id))
+	return _self.UpdateOnce(ctx, deadLetter, opts...)
+}
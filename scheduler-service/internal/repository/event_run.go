@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+type IEventRunRepository interface {
+	IRepository[domain.EventRun]
+	CreateEventRuns(ctx context.Context, runs []*domain.EventRun, opts ...QueryOptionFunc) error
+	UpdateEventRun(ctx context.Context, run *domain.EventRun, opts ...QueryOptionFunc) error
+	GetEventRunByRunId(ctx context.Context, runId string, opts ...QueryOptionFunc) (*domain.EventRun, error)
+	GetEventRunsByWorkflowRunId(ctx context.Context, workflowRunId int64, opts ...QueryOptionFunc) ([]*domain.EventRun, error)
+	GetEventRunsByRunIds(ctx context.Context, runIds []string, opts ...QueryOptionFunc) ([]*domain.EventRun, error)
+	GetUnfinishedEventRunsByEventId(ctx context.Context, eventId int64, opts ...QueryOptionFunc) ([]*domain.EventRun, error)
+}
+
+type EventRunRepository struct {
+	baseRepository[domain.EventRun]
+}
+
+func NewEventRunRepository(
+	conf *configs.Config,
+	dbSource IDatabase,
+) IEventRunRepository {
+	return &EventRunRepository{
+		baseRepository: newBaseRepository[domain.EventRun](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+	}
+}
+
+func (_self *EventRunRepository) CreateEventRuns(ctx context.Context, runs []*domain.EventRun, opts ...QueryOptionFunc) error {
+	if len(runs) == 0 {
+		return nil
+	}
+	return _self.Inserts(ctx, runs, opts...)
+}
+
+func (_self *EventRunRepository) UpdateEventRun(ctx context.Context, run *domain.EventRun, opts ...QueryOptionFunc) error {
+	opts = append(opts, WithCondition("id = ?", run.Id))
+	return _self.UpdateOnce(ctx, run, opts...)
+}
+
+func (_self *EventRunRepository) GetEventRunByRunId(ctx context.Context, runId string, opts ...QueryOptionFunc) (*domain.EventRun, error) {
+	opts = append(opts, WithCondition("run_id = ?", runId))
+	opts = append(opts, WithLimit(1))
+	return _self.Find(ctx, opts...)
+}
+
+func (_self *EventRunRepository) GetEventRunsByWorkflowRunId(ctx context.Context, workflowRunId int64, opts ...QueryOptionFunc) ([]*domain.EventRun, error) {
+	opts = append(opts, WithCondition("workflow_run_id = ?", workflowRunId))
+	opts = append(opts, WithOrderBy("id"))
+	return _self.Finds(ctx, opts...)
+}
+
+func (_self *EventRunRepository) GetEventRunsByRunIds(ctx context.Context, runIds []string, opts ...QueryOptionFunc) ([]*domain.EventRun, error) {
+	if len(runIds) == 0 {
+		return nil, nil
+	}
+	opts = append(opts, WithCondition("run_id IN ?", runIds))
+	return _self.Finds(ctx, opts...)
+}
+
+func (_self *EventRunRepository) GetUnfinishedEventRunsByEventId(ctx context.Context, eventId int64, opts ...QueryOptionFunc) ([]*domain.EventRun, error) {
+	opts = append(opts, WithCondition("event_id = ? AND status IN ?", eventId, []domain.StatusEnum{domain.StatusPending, domain.StatusRunning}))
+	return _self.Finds(ctx, opts...)
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+type IOutboxRepository interface {
+	IRepository[domain.Outbox]
+	GetPendingOutboxes(ctx context.Context, now time.Time, limit int, opts ...QueryOptionFunc) ([]*domain.Outbox, error)
+	// ClaimOutboxes moves the next attempt of the messages to until, the other relays skip them meanwhile
+	ClaimOutboxes(ctx context.Context, ids []int64, until time.Time, opts ...QueryOptionFunc) error
+	UpdateOutbox(ctx context.Context, outbox *domain.Outbox, opts ...QueryOptionFunc) error
+	CancelOutboxesByRunIds(ctx context.Context, runIds []string, opts ...QueryOptionFunc) error
+	CancelOutboxesByEventId(ctx context.Context, eventId int64, opts ...QueryOptionFunc) error
+}
+
+type OutboxRepository struct {
+	baseRepository[domain.Outbox]
+}
+
+func NewOutboxRepository(
+	conf *configs.Config,
+	dbSource IDatabase,
+) IOutboxRepository {
+	return &OutboxRepository{
+		baseRepository: newBaseRepository[domain.Outbox](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+	}
+}
+
+// GetPendingOutboxes locks due messages so concurrent relays skip each other's rows.
+// Pass WithTx to keep the lock until the relay claims them.
+func (_self *OutboxRepository) GetPendingOutboxes(ctx context.Context, now time.Time, limit int, opts ...QueryOptionFunc) ([]*domain.Outbox, error) {
+	opts = append(opts, WithCondition("status = ? AND next_attempt_at <= ?", domain.OutboxStatusPending, now))
+	opts = append(opts, WithOrderBy("id"))
+	opts = append(opts, WithLimit(limit))
+	opts = append(opts, WithForUpdate())
+	return _self.Finds(ctx, opts...)
+}
+
+func (_self *OutboxRepository) ClaimOutboxes(ctx context.Context, ids []int64, until time.Time, opts ...QueryOptionFunc) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tx := _self.db.WithContext(ctx)
+	for _, opt := range opts {
+		tx = opt(tx)
+	}
+	return tx.Model(&domain.Outbox{}).Where("id IN ?", ids).
+		Updates(map[string]any{"next_attempt_at": until, "updated_at": time.Now()}).Error
+}
+
+func (_self *OutboxRepository) UpdateOutbox(ctx context.Context, outbox *domain.Outbox, opts ...QueryOptionFunc) error {
+	opts = append(opts, WithCondition("id = ?", outbox.Id))
+	return _self.UpdateOnce(ctx, outbox, opts...)
+}
+
+// CancelOutboxesByRunIds stops the relay from publishing runs which are not sent yet
+func (_self *OutboxRepository) CancelOutboxesByRunIds(ctx context.Context, runIds []string, opts ...QueryOptionFunc) error {
+	if len(runIds) == 0 {
+		return nil
+	}
+	tx := _self.db.WithContext(ctx)
+	for _, opt := range opts {
+		tx = opt(tx)
+	}
+	return tx.Where("run_id IN ? AND status = ?", runIds, domain.OutboxStatusPending).
+		Update("status", domain.OutboxStatusCancelled).Error
+}
+
+// CancelOutboxesByEventId drops every message of the event which is not sent yet
+func (_self *OutboxRepository) CancelOutboxesByEventId(ctx context.Context, eventId int64, opts ...QueryOptionFunc) error {
+	tx := _self.db.WithContext(ctx)
+	for _, opt := range opts {
+		tx = opt(tx)
+	}
+	return tx.Where("event_id = ? AND status = ?", eventId, domain.OutboxStatusPending).
+		Update("status", domain.OutboxStatusCancelled).Error
+}
@@ -19,6 +19,13 @@ type LimitOption struct {
 	OrderBy string
 }
 
+// ShardFilter restricts a query to the events whose id falls into Shards (id % Total).
+// A zero Total disables the filter.
+type ShardFilter struct {
+	Total  int
+	Shards []int
+}
+
 type QueryOptionFunc func(tx *gorm.DB) *gorm.DB
 
 type FunctionExec func(ctx context.Context, tx *gorm.DB) (isPass bool, err error)
@@ -81,6 +88,9 @@ func (_self *baseRepository[E]) UpdateOnce(ctx context.Context, entity *E, opts
 
 func (_self *baseRepository[E]) Updates(ctx context.Context, entities []*E, opts ...QueryOptionFunc) error {
 	tx := _self.db.WithContext(ctx)
+	for _, opt := range opts {
+		tx = opt(tx)
+	}
 	for _, entity := range entities {
 		if err := tx.Model(entity).Updates(entity).Error; err != nil {
 			return err
@@ -166,6 +176,14 @@ func (_self *baseRepository[E]) RunWithTransaction(ctx context.Context, txName s
 	return err
 }
 
+// WithTx binds the query to a transaction opened by RunWithTransaction.
+// It must be the first option because it replaces the session.
+func WithTx(tx *gorm.DB) QueryOptionFunc {
+	return func(db *gorm.DB) *gorm.DB {
+		return tx.Model(db.Statement.Model)
+	}
+}
+
 func WithOrderBy(orderBy string) QueryOptionFunc {
 	return func(tx *gorm.DB) *gorm.DB {
 		return tx.Order(orderBy)
@@ -196,6 +214,15 @@ func WithOrCondition(condition string, args ...any) QueryOptionFunc {
 	}
 }
 
+func WithShards(shard ShardFilter) QueryOptionFunc {
+	return func(tx *gorm.DB) *gorm.DB {
+		if shard.Total <= 0 {
+			return tx
+		}
+		return tx.Where("id % ? IN ?", shard.Total, shard.Shards)
+	}
+}
+
 func WithForUpdate() QueryOptionFunc {
 	return func(tx *gorm.DB) *gorm.DB {
 		return tx.Clauses(clause.Locking{
@@ -205,6 +232,38 @@ func WithForUpdate() QueryOptionFunc {
 	}
 }
 
+// WithRowLock waits for the row lock, use it to serialize updates of one row
+func WithRowLock() QueryOptionFunc {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.Locking{
+			Strength: "UPDATE",
+		})
+	}
+}
+
+// WithShareLock locks the rows against the updates and deletes of the other transactions, not against their share locks
+func WithShareLock() QueryOptionFunc {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.Locking{
+			Strength: "SHARE",
+		})
+	}
+}
+
+// WithOnConflictDoNothing skips the inserted rows breaking a unique constraint
+func WithOnConflictDoNothing() QueryOptionFunc {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{DoNothing: true})
+	}
+}
+
+// WithUnscoped includes soft deleted rows, DeleteOnce/DeleteById remove the row permanently
+func WithUnscoped() QueryOptionFunc {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped()
+	}
+}
+
 func WithIsolationLevel(isolationLevel int) QueryOptionFunc {
 	return func(tx *gorm.DB) *gorm.DB {
 		iso := sql.IsolationLevel(isolationLevel)
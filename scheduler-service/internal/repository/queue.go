@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+type IQueueRepository interface {
+	IRepository[domain.Queue]
+	GetQueueByName(ctx context.Context, name string, opts ...QueryOptionFunc) (*domain.Queue, error)
+	GetQueueByTopic(ctx context.Context, topic string, opts ...QueryOptionFunc) (*domain.Queue, error)
+	GetQueues(ctx context.Context) ([]*domain.Queue, error)
+}
+
+type QueueRepository struct {
+	baseRepository[domain.Queue]
+}
+
+func NewQueueRepository(
+	conf *configs.Config,
+	dbSource IDatabase,
+) IQueueRepository {
+	return &QueueRepository{
+		baseRepository: newBaseRepository[domain.Queue](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+	}
+}
+
+func (_self *QueueRepository) GetQueueByName(ctx context.Context, name string, opts ...QueryOptionFunc) (*domain.Queue, error) {
+	opts = append(opts, WithCondition("name = ?", name))
+	opts = append(opts, WithLimit(1))
+	return _self.Find(ctx, opts...)
+}
+
+func (_self *QueueRepository) GetQueueByTopic(ctx context.Context, topic string, opts ...QueryOptionFunc) (*domain.Queue, error) {
+	opts = append(opts, WithCondition("topic = ?", topic))
+	opts = append(opts, WithLimit(1))
+	return _self.Find(ctx, opts...)
+}
+
+func (_self *QueueRepository) GetQueues(ctx context.Context) ([]*domain.Queue, error) {
+	return _self.Finds(ctx, WithOrderBy("name"))
+}
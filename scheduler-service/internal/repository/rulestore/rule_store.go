@@ -0,0 +1,145 @@
+package rulestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+const (
+	rulesKey       = "scheduler.validation.rules"
+	changedChannel = "scheduler.validation.rules.changed"
+)
+
+// save the rules as the next version when the stored version is the expected one, 0 skips the check
+var saveScript = goredislib.NewScript(`
+local current = tonumber(redis.call("HGET", KEYS[1], "version") or "0")
+local expected = tonumber(ARGV[1])
+if expected > 0 and expected ~= current then
+	return {0, current}
+end
+local next = current + 1
+redis.call("HSET", KEYS[1], "version", next, "content", ARGV[2])
+redis.call("PUBLISH", KEYS[2], next)
+return {1, next}`)
+
+// seed the rules with their version only when it is newer than the stored one
+var seedScript = goredislib.NewScript(`
+local current = tonumber(redis.call("HGET", KEYS[1], "version") or "0")
+if tonumber(ARGV[1]) <= current then
+	return 0
+end
+redis.call("HSET", KEYS[1], "version", ARGV[1], "content", ARGV[2])
+redis.call("PUBLISH", KEYS[2], ARGV[1])
+return 1`)
+
+// VersionConflictError is returned by Save when the stored rules are not at the expected version
+type VersionConflictError struct {
+	Current int64
+}
+
+func (_self *VersionConflictError) Error() string {
+	return fmt.Sprintf("validation rules are at version %d", _self.Current)
+}
+
+type IRuleStore interface {
+	// Get returns the shared rules and their version, version 0 when no rules are stored
+	Get(ctx context.Context) ([]byte, int64, error)
+	// Save stores the rules as the next version and notifies the replicas, expected 0 skips the version check
+	Save(ctx context.Context, content []byte, expected int64) (int64, error)
+	// Seed stores the rules with their version when it is newer than the stored one
+	Seed(ctx context.Context, content []byte, version int64) (bool, error)
+	// Changes receives the version of every stored rules until ctx is done
+	Changes(ctx context.Context) <-chan int64
+}
+
+type RuleStore struct {
+	client *goredislib.Client
+}
+
+func NewRuleStore(
+	conf *configs.Config,
+) IRuleStore {
+	client := goredislib.NewClient(&goredislib.Options{
+		Addr:     conf.Redis.Addr,
+		Password: conf.Redis.Password,
+		DB:       conf.Redis.DB,
+	})
+	return &RuleStore{
+		client: client,
+	}
+}
+
+func (_self *RuleStore) Get(ctx context.Context) ([]byte, int64, error) {
+	values, err := _self.client.HMGet(ctx, rulesKey, "version", "content").Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	version, _ := values[0].(string)
+	content, _ := values[1].(string)
+	if version == "" {
+		return nil, 0, nil
+	}
+	number, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid version %q of the shared validation rules", version)
+	}
+	return []byte(content), number, nil
+}
+
+func (_self *RuleStore) Save(ctx context.Context, content []byte, expected int64) (int64, error) {
+	result, err := saveScript.Run(ctx, _self.client, []string{rulesKey, changedChannel}, expected, string(content)).Int64Slice()
+	if err != nil {
+		return 0, err
+	}
+	if len(result) != 2 {
+		return 0, errors.New("unexpected result of saving validation rules")
+	}
+	if result[0] == 0 {
+		return 0, &VersionConflictError{Current: result[1]}
+	}
+	return result[1], nil
+}
+
+func (_self *RuleStore) Seed(ctx context.Context, content []byte, version int64) (bool, error) {
+	seeded, err := seedScript.Run(ctx, _self.client, []string{rulesKey, changedChannel}, version, string(content)).Int64()
+	if err != nil {
+		return false, err
+	}
+	return seeded == 1, nil
+}
+
+func (_self *RuleStore) Changes(ctx context.Context) <-chan int64 {
+	pubsub := _self.client.Subscribe(ctx, changedChannel)
+	changes := make(chan int64)
+	go func() {
+		defer close(changes)
+		defer pubsub.Close()
+		// the channel of go-redis resubscribes after a reconnect, the messages sent meanwhile are lost
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				version, err := strconv.ParseInt(message.Payload, 10, 64)
+				if err != nil {
+					continue
+				}
+				select {
+				case changes <- version:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return changes
+}
@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/namnv2496/scheduler/internal/configs"
 	"github.com/namnv2496/scheduler/internal/domain"
@@ -10,14 +13,48 @@ import (
 
 type ISchedulerEventRepository interface {
 	IRepository[domain.SchedulerEvent]
-	CreateSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent) (int64, error)
-	GetSchedulerEvents(ctx context.Context, limit, offset int32) ([]*domain.SchedulerEvent, error)
-	UpdateSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent) error
+	CreateSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent, opts ...QueryOptionFunc) (int64, error)
+	GetSchedulerEvents(ctx context.Context, limit, offset int32, opts ...QueryOptionFunc) ([]*domain.SchedulerEvent, error)
 	UpdateSchedulerEvents(ctx context.Context, events []*domain.SchedulerEvent) error
-	GetSchedulerEventByID(ctx context.Context, id int64) (*domain.SchedulerEvent, error)
+	GetSchedulerEventByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.SchedulerEvent, error)
 	GetSchedulerEventByDomainAndQueue(ctx context.Context, urlDomain, queue string, limit, offset int) ([]*domain.SchedulerEvent, error)
 	CountSchedulerEventByDomainsAndQueues(ctx context.Context, domains, queues []string) (int64, error)
-	GetSchedulerEventByStatusAndSchedulerAt(ctx context.Context, status domain.StatusEnum, schedulerAt int64) ([]*domain.SchedulerEvent, error)
+	DispatchSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent, run *domain.EventRun, outboxes ...*domain.Outbox) error
+	GetSchedulerEventByStatusAndSchedulerAt(ctx context.Context, status domain.StatusEnum, schedulerAt int64, shard ShardFilter) ([]*domain.SchedulerEvent, error)
+	ListSchedulerEvents(ctx context.Context, query SchedulerEventQuery, opts ...QueryOptionFunc) ([]*domain.SchedulerEvent, error)
+	UpdateSchedulerEventFields(ctx context.Context, id, version int64, fields map[string]any, opts ...QueryOptionFunc) error
+	GetNamedSchedulerEvents(ctx context.Context, opts ...QueryOptionFunc) ([]*domain.SchedulerEvent, error)
+	CountActiveSchedulerEventsByTeam(ctx context.Context, team string, opts ...QueryOptionFunc) (int64, error)
+	// CountSchedulerEventsByQueue counts the events of the queue which are not deleted
+	CountSchedulerEventsByQueue(ctx context.Context, queue string, opts ...QueryOptionFunc) (int64, error)
+	DeleteSchedulerEvent(ctx context.Context, id int64, opts ...QueryOptionFunc) error
+	RestoreSchedulerEvent(ctx context.Context, id int64, opts ...QueryOptionFunc) error
+	PurgeSchedulerEvent(ctx context.Context, id int64, opts ...QueryOptionFunc) error
+}
+
+// SchedulerEventQuery filters events, zero values are ignored.
+// Rows are sorted by OrderBy then id, After continues from the last row of the previous page.
+type SchedulerEventQuery struct {
+	Domain      string
+	Queue       string
+	Status      domain.StatusEnum
+	Method      string
+	IsActive    *bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+	Search      string
+	// OrderBy must be a column name checked by the caller
+	OrderBy string
+	Desc    bool
+	After   *SchedulerEventCursor
+	Limit   int
+}
+
+type SchedulerEventCursor struct {
+	Value any
+	Id    int64
 }
 
 type SchedulerEventRepository struct {
@@ -35,25 +72,37 @@ func NewSchedulerEventRepository(
 	}
 }
 
-func (_self *SchedulerEventRepository) CreateSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent) (int64, error) {
-	err := _self.InsertOnce(ctx, event)
-	return event.Id, err
+// advanceEventIdSequence moves the id sequence past an id given by the caller, it never moves it back.
+// A table without sequence is left as is.
+const advanceEventIdSequence = `SELECT setval(pg_get_serial_sequence('scheduler_events', 'id'),
+	GREATEST(?, COALESCE(pg_sequence_last_value(pg_get_serial_sequence('scheduler_events', 'id')::regclass), 0)))
+WHERE pg_get_serial_sequence('scheduler_events', 'id') IS NOT NULL`
+
+func (_self *SchedulerEventRepository) CreateSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent, opts ...QueryOptionFunc) (int64, error) {
+	explicitId := event.Id > 0
+	if err := _self.InsertOnce(ctx, event, opts...); err != nil {
+		return 0, err
+	}
+	if explicitId {
+		// the sequence does not see an explicit id, the next generated id would collide with it
+		tx := _self.db.WithContext(ctx)
+		for _, opt := range opts {
+			tx = opt(tx)
+		}
+		if err := tx.Exec(advanceEventIdSequence, event.Id).Error; err != nil {
+			return 0, err
+		}
+	}
+	return event.Id, nil
 }
 
-func (_self *SchedulerEventRepository) GetSchedulerEvents(ctx context.Context, limit, offset int32) ([]*domain.SchedulerEvent, error) {
-	var opts []QueryOptionFunc
+func (_self *SchedulerEventRepository) GetSchedulerEvents(ctx context.Context, limit, offset int32, opts ...QueryOptionFunc) ([]*domain.SchedulerEvent, error) {
 	opts = append(opts, WithLimit((int(limit))))
 	opts = append(opts, WithOffset((int(offset))))
 
 	return _self.Finds(ctx, opts...)
 }
 
-func (_self *SchedulerEventRepository) UpdateSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent) error {
-	var opts []QueryOptionFunc
-	opts = append(opts, WithCondition("id = ?", event.Id))
-	return _self.UpdateOnce(ctx, event, opts...)
-}
-
 // example
 func (_self *SchedulerEventRepository) UpdateSchedulerEvents(ctx context.Context, events []*domain.SchedulerEvent) error {
 	funcs := []FunctionExec{
@@ -82,8 +131,48 @@ func (_self *SchedulerEventRepository) UpdateSchedulerEvents(ctx context.Context
 		funcs...)
 }
 
-func (_self *SchedulerEventRepository) GetSchedulerEventByID(ctx context.Context, id int64) (*domain.SchedulerEvent, error) {
-	var opts []QueryOptionFunc
+// DispatchSchedulerEvent saves the new schedule of the event, its run history and its outbox messages in one transaction.
+// It fails with gorm.ErrRecordNotFound when the event was changed since it was read.
+func (_self *SchedulerEventRepository) DispatchSchedulerEvent(ctx context.Context, event *domain.SchedulerEvent, run *domain.EventRun, outboxes ...*domain.Outbox) error {
+	funcs := []FunctionExec{
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			// only the schedule is written, the version check rejects an event edited or paused since it was read
+			fields := map[string]any{
+				"status":        event.Status,
+				"scheduler_at":  event.SchedulerAt,
+				"repeat_times":  event.RepeatTimes,
+				"next_run_time": event.NextRunTime,
+			}
+			if err := _self.UpdateSchedulerEventFields(ctx, event.Id, event.Version, fields, WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if run == nil {
+				return true, nil
+			}
+			if err := tx.WithContext(ctx).Model(run).Create(run).Error; err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			for _, outbox := range outboxes {
+				if err := tx.WithContext(ctx).Model(outbox).Create(outbox).Error; err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		},
+	}
+	return _self.RunWithTransaction(
+		ctx,
+		"DispatchSchedulerEvent",
+		funcs...)
+}
+
+func (_self *SchedulerEventRepository) GetSchedulerEventByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.SchedulerEvent, error) {
 	opts = append(opts, WithCondition("id = ?", id))
 	opts = append(opts, WithLimit(1))
 	return _self.Find(ctx, opts...)
@@ -105,10 +194,142 @@ func (_self *SchedulerEventRepository) CountSchedulerEventByDomainsAndQueues(ctx
 	return _self.CountOnce(ctx, opts...)
 }
 
-func (_self *SchedulerEventRepository) GetSchedulerEventByStatusAndSchedulerAt(ctx context.Context, status domain.StatusEnum, schedulerAt int64) ([]*domain.SchedulerEvent, error) {
+func (_self *SchedulerEventRepository) GetSchedulerEventByStatusAndSchedulerAt(ctx context.Context, status domain.StatusEnum, schedulerAt int64, shard ShardFilter) ([]*domain.SchedulerEvent, error) {
 	var opts []QueryOptionFunc
 	opts = append(opts, WithIsolationLevel(_self.isolationLevel))
 	opts = append(opts, WithCondition("status = ? AND scheduler_at <= ? AND is_active=true AND repeat_times != 0", status, schedulerAt))
+	opts = append(opts, WithShards(shard))
 	opts = append(opts, WithForUpdate())
 	return _self.Finds(ctx, opts...)
 }
+
+func (_self *SchedulerEventRepository) ListSchedulerEvents(ctx context.Context, query SchedulerEventQuery, opts ...QueryOptionFunc) ([]*domain.SchedulerEvent, error) {
+	if query.Domain != "" {
+		opts = append(opts, WithCondition("domain = ?", query.Domain))
+	}
+	if query.Queue != "" {
+		opts = append(opts, WithCondition("queue = ?", query.Queue))
+	}
+	if query.Status != "" {
+		opts = append(opts, WithCondition("status = ?", query.Status))
+	}
+	if query.Method != "" {
+		opts = append(opts, WithCondition("method = ?", query.Method))
+	}
+	if query.IsActive != nil {
+		opts = append(opts, WithCondition("is_active = ?", *query.IsActive))
+	}
+	if !query.CreatedFrom.IsZero() {
+		opts = append(opts, WithCondition("created_at >= ?", query.CreatedFrom))
+	}
+	if !query.CreatedTo.IsZero() {
+		opts = append(opts, WithCondition("created_at <= ?", query.CreatedTo))
+	}
+	if !query.UpdatedFrom.IsZero() {
+		opts = append(opts, WithCondition("updated_at >= ?", query.UpdatedFrom))
+	}
+	if !query.UpdatedTo.IsZero() {
+		opts = append(opts, WithCondition("updated_at <= ?", query.UpdatedTo))
+	}
+	if query.Search != "" {
+		// served by the trigram index on description
+		opts = append(opts, WithCondition("description ILIKE ?", "%"+escapeLike(query.Search)+"%"))
+	}
+
+	orderBy := query.OrderBy
+	if orderBy == "" {
+		orderBy = "id"
+	}
+	direction, compare := "ASC", ">"
+	if query.Desc {
+		direction, compare = "DESC", "<"
+	}
+	if query.After != nil {
+		if orderBy == "id" {
+			opts = append(opts, WithCondition(fmt.Sprintf("id %s ?", compare), query.After.Id))
+		} else {
+			opts = append(opts, WithCondition(fmt.Sprintf("(%s, id) %s (?, ?)", orderBy, compare), query.After.Value, query.After.Id))
+		}
+	}
+	if orderBy == "id" {
+		opts = append(opts, WithOrderBy(fmt.Sprintf("id %s", direction)))
+	} else {
+		opts = append(opts, WithOrderBy(fmt.Sprintf("%s %s, id %s", orderBy, direction, direction)))
+	}
+	opts = append(opts, WithLimit(query.Limit))
+	return _self.Finds(ctx, opts...)
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// UpdateSchedulerEventFields writes the given columns, zero values included, and increments the version.
+// A version above zero is compared with the stored one, gorm.ErrRecordNotFound is returned when no row is written.
+func (_self *SchedulerEventRepository) UpdateSchedulerEventFields(ctx context.Context, id, version int64, fields map[string]any, opts ...QueryOptionFunc) error {
+	tx := _self.db.WithContext(ctx)
+	for _, opt := range opts {
+		tx = opt(tx)
+	}
+	updates := make(map[string]any, len(fields)+2)
+	for column, value := range fields {
+		updates[column] = value
+	}
+	updates["version"] = gorm.Expr("version + 1")
+	updates["updated_at"] = time.Now()
+	tx = tx.Model(&domain.SchedulerEvent{}).Where("id = ?", id)
+	if version > 0 {
+		tx = tx.Where("version = ?", version)
+	}
+	result := tx.Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetNamedSchedulerEvents returns the events managed by manifests
+func (_self *SchedulerEventRepository) GetNamedSchedulerEvents(ctx context.Context, opts ...QueryOptionFunc) ([]*domain.SchedulerEvent, error) {
+	opts = append(opts, WithCondition("name <> ''"))
+	opts = append(opts, WithOrderBy("name"))
+	return _self.Finds(ctx, opts...)
+}
+
+func (_self *SchedulerEventRepository) CountActiveSchedulerEventsByTeam(ctx context.Context, team string, opts ...QueryOptionFunc) (int64, error) {
+	opts = append(opts, WithCondition("team = ? AND is_active = true", team))
+	return _self.CountOnce(ctx, opts...)
+}
+
+func (_self *SchedulerEventRepository) CountSchedulerEventsByQueue(ctx context.Context, queue string, opts ...QueryOptionFunc) (int64, error) {
+	opts = append(opts, WithCondition("queue = ?", queue))
+	return _self.CountOnce(ctx, opts...)
+}
+
+// DeleteSchedulerEvent soft deletes the event
+func (_self *SchedulerEventRepository) DeleteSchedulerEvent(ctx context.Context, id int64, opts ...QueryOptionFunc) error {
+	return _self.DeleteById(ctx, &domain.SchedulerEvent{Id: id}, opts...)
+}
+
+func (_self *SchedulerEventRepository) RestoreSchedulerEvent(ctx context.Context, id int64, opts ...QueryOptionFunc) error {
+	tx := _self.db.WithContext(ctx)
+	for _, opt := range opts {
+		tx = opt(tx)
+	}
+	result := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// PurgeSchedulerEvent removes the event permanently, deleted or not
+func (_self *SchedulerEventRepository) PurgeSchedulerEvent(ctx context.Context, id int64, opts ...QueryOptionFunc) error {
+	opts = append(opts, WithUnscoped())
+	return _self.DeleteById(ctx, &domain.SchedulerEvent{Id: id}, opts...)
+}
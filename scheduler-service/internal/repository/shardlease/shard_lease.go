@@ -0,0 +1,228 @@
+package shardlease
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+const (
+	membersKey     = "scheduler.shard.members"
+	leaseKeyPrefix = "scheduler.shard.lease."
+)
+
+// renew the lease only when it is still held by the caller
+var renewScript = goredislib.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// release the lease only when it is still held by the caller
+var releaseScript = goredislib.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+type IShardLease interface {
+	// Start joins the member list and keeps leases balanced until Stop is called or ctx is done
+	Start(ctx context.Context)
+	// Stop releases the leases and leaves the member list, the peers take the shards over without waiting for the TTL
+	Stop(ctx context.Context)
+	// Shards returns the shards currently leased by this replica
+	Shards() []int
+	// Owns tells whether the shard of the event is leased by this replica
+	Owns(id int64) bool
+	// Total is the number of shards events are partitioned into
+	Total() int
+}
+
+type ShardLease struct {
+	client         *goredislib.Client
+	memberId       string
+	total          int
+	leaseTTL       time.Duration
+	rebalanceEvery time.Duration
+	mutex          sync.RWMutex
+	owned          map[int]bool
+	cancel         context.CancelFunc
+	stopped        chan struct{}
+}
+
+func NewShardLease(
+	conf *configs.Config,
+) IShardLease {
+	client := goredislib.NewClient(&goredislib.Options{
+		Addr:     conf.Redis.Addr,
+		Password: conf.Redis.Password,
+		DB:       conf.Redis.DB,
+	})
+	hostname, _ := os.Hostname()
+	return &ShardLease{
+		client:         client,
+		memberId:       fmt.Sprintf("%s-%s", hostname, uuid.New().String()),
+		total:          conf.Shard.Total,
+		leaseTTL:       conf.Shard.LeaseTTL,
+		rebalanceEvery: conf.Shard.RebalanceEvery,
+		owned:          make(map[int]bool),
+	}
+}
+
+// ShardOf maps an event id to its shard
+func ShardOf(id int64, total int) int {
+	if total <= 0 {
+		return 0
+	}
+	return int(id % int64(total))
+}
+
+func (_self *ShardLease) Start(ctx context.Context) {
+	ctx = logging.AppendPrefix(ctx, "ShardLease")
+	ctx, _self.cancel = context.WithCancel(ctx)
+	_self.stopped = make(chan struct{})
+	if err := _self.rebalance(ctx); err != nil {
+		logging.Errorf(ctx, "rebalance shards failed: %s", err)
+	}
+	go func() {
+		defer close(_self.stopped)
+		ticker := time.NewTicker(_self.rebalanceEvery)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := _self.rebalance(ctx); err != nil {
+					logging.Errorf(ctx, "rebalance shards failed: %s", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (_self *ShardLease) Stop(ctx context.Context) {
+	if _self.cancel == nil {
+		return
+	}
+	ctx = logging.AppendPrefix(ctx, "ShardLease")
+	// a rebalance in flight would claim the shards again after they are released
+	_self.cancel()
+	select {
+	case <-_self.stopped:
+	case <-ctx.Done():
+	}
+	_self.leave(ctx)
+	logging.Infof(ctx, "member %s left, its shards are released", _self.memberId)
+}
+
+func (_self *ShardLease) Shards() []int {
+	_self.mutex.RLock()
+	defer _self.mutex.RUnlock()
+	shards := make([]int, 0, len(_self.owned))
+	for shard := range _self.owned {
+		shards = append(shards, shard)
+	}
+	sort.Ints(shards)
+	return shards
+}
+
+func (_self *ShardLease) Total() int {
+	return _self.total
+}
+
+func (_self *ShardLease) Owns(id int64) bool {
+	return _self.isOwned(ShardOf(id, _self.total))
+}
+
+// rebalance heartbeats this member, computes the shards it should own from the
+// live member list, then claims/renews those and releases the rest.
+// A shard still leased by a departed replica is picked up after its lease expires.
+func (_self *ShardLease) rebalance(ctx context.Context) error {
+	now := time.Now()
+	if err := _self.client.ZAdd(ctx, membersKey, goredislib.Z{
+		Score:  float64(now.UnixMilli()),
+		Member: _self.memberId,
+	}).Err(); err != nil {
+		return err
+	}
+	expiredBefore := now.Add(-_self.leaseTTL).UnixMilli()
+	if err := _self.client.ZRemRangeByScore(ctx, membersKey, "-inf", strconv.FormatInt(expiredBefore, 10)).Err(); err != nil {
+		return err
+	}
+	members, err := _self.client.ZRange(ctx, membersKey, 0, -1).Result()
+	if err != nil {
+		return err
+	}
+	sort.Strings(members)
+	index := sort.SearchStrings(members, _self.memberId)
+	if index >= len(members) || members[index] != _self.memberId {
+		return fmt.Errorf("member %s is not registered", _self.memberId)
+	}
+
+	owned := make(map[int]bool)
+	for shard := 0; shard < _self.total; shard++ {
+		key := buildLeaseKey(shard)
+		if shard%len(members) != index {
+			if _self.isOwned(shard) {
+				if err := releaseScript.Run(ctx, _self.client, []string{key}, _self.memberId).Err(); err != nil {
+					logging.Errorf(ctx, "release shard %d failed: %s", shard, err)
+				}
+			}
+			continue
+		}
+		acquired, err := _self.client.SetNX(ctx, key, _self.memberId, _self.leaseTTL).Result()
+		if err != nil {
+			return err
+		}
+		if !acquired {
+			renewed, err := renewScript.Run(ctx, _self.client, []string{key}, _self.memberId, _self.leaseTTL.Milliseconds()).Int()
+			if err != nil {
+				return err
+			}
+			acquired = renewed == 1
+		}
+		if acquired {
+			owned[shard] = true
+		}
+	}
+
+	_self.mutex.Lock()
+	_self.owned = owned
+	_self.mutex.Unlock()
+	logging.Infof(ctx, "member %s owns shards %v of %d (members: %d)", _self.memberId, _self.Shards(), _self.total, len(members))
+	return nil
+}
+
+func (_self *ShardLease) isOwned(shard int) bool {
+	_self.mutex.RLock()
+	defer _self.mutex.RUnlock()
+	return _self.owned[shard]
+}
+
+func (_self *ShardLease) leave(ctx context.Context) {
+	for _, shard := range _self.Shards() {
+		if err := releaseScript.Run(ctx, _self.client, []string{buildLeaseKey(shard)}, _self.memberId).Err(); err != nil {
+			logging.Errorf(ctx, "release shard %d failed: %s", shard, err)
+		}
+	}
+	if err := _self.client.ZRem(ctx, membersKey, _self.memberId).Err(); err != nil {
+		logging.Errorf(ctx, "leave member list failed: %s", err)
+	}
+	_self.mutex.Lock()
+	_self.owned = make(map[int]bool)
+	_self.mutex.Unlock()
+}
+
+func buildLeaseKey(shard int) string {
+	return leaseKeyPrefix + strconv.Itoa(shard)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+type ITenantRepository interface {
+	IRepository[domain.Tenant]
+	GetTenantByName(ctx context.Context, name string, opts ...QueryOptionFunc) (*domain.Tenant, error)
+	GetTenants(ctx context.Context) ([]*domain.Tenant, error)
+}
+
+type TenantRepository struct {
+	baseRepository[domain.Tenant]
+}
+
+func NewTenantRepository(
+	conf *configs.Config,
+	dbSource IDatabase,
+) ITenantRepository {
+	return &TenantRepository{
+		baseRepository: newBaseRepository[domain.Tenant](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+	}
+}
+
+func (_self *TenantRepository) GetTenantByName(ctx context.Context, name string, opts ...QueryOptionFunc) (*domain.Tenant, error) {
+	opts = append(opts, WithCondition("name = ?", name))
+	opts = append(opts, WithLimit(1))
+	return _self.Find(ctx, opts...)
+}
+
+func (_self *TenantRepository) GetTenants(ctx context.Context) ([]*domain.Tenant, error) {
+	return _self.Finds(ctx)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+	"gorm.io/gorm"
+)
+
+type IWorkflowRepository interface {
+	IRepository[domain.Workflow]
+	CreateWorkflow(ctx context.Context, workflow *domain.Workflow, steps []*domain.WorkflowStep) (int64, error)
+	GetWorkflowByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.Workflow, error)
+	GetWorkflowSteps(ctx context.Context, workflowId int64) ([]*domain.WorkflowStep, error)
+}
+
+type WorkflowRepository struct {
+	baseRepository[domain.Workflow]
+	steps baseRepository[domain.WorkflowStep]
+}
+
+func NewWorkflowRepository(
+	conf *configs.Config,
+	dbSource IDatabase,
+) IWorkflowRepository {
+	return &WorkflowRepository{
+		baseRepository: newBaseRepository[domain.Workflow](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+		steps:          newBaseRepository[domain.WorkflowStep](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+	}
+}
+
+func (_self *WorkflowRepository) CreateWorkflow(ctx context.Context, workflow *domain.Workflow, steps []*domain.WorkflowStep) (int64, error) {
+	funcs := []FunctionExec{
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if err := _self.InsertOnce(ctx, workflow, WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			for _, step := range steps {
+				step.WorkflowId = workflow.Id
+			}
+			if err := _self.steps.Inserts(ctx, steps, WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	}
+	err := _self.RunWithTransaction(
+		ctx,
+		"CreateWorkflow",
+		funcs...)
+	return workflow.Id, err
+}
+
+func (_self *WorkflowRepository) GetWorkflowByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.Workflow, error) {
+	opts = append(opts, WithCondition("id = ?", id))
+	opts = append(opts, WithLimit(1))
+	return _self.Find(ctx, opts...)
+}
+
+func (_self *WorkflowRepository) GetWorkflowSteps(ctx context.Context, workflowId int64) ([]*domain.WorkflowStep, error) {
+	var opts []QueryOptionFunc
+	opts = append(opts, WithCondition("workflow_id = ?", workflowId))
+	opts = append(opts, WithOrderBy("id"))
+	return _self.steps.Finds(ctx, opts...)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+)
+
+type IWorkflowRunRepository interface {
+	IRepository[domain.WorkflowRun]
+	CreateWorkflowRun(ctx context.Context, run *domain.WorkflowRun, opts ...QueryOptionFunc) (int64, error)
+	UpdateWorkflowRun(ctx context.Context, run *domain.WorkflowRun, opts ...QueryOptionFunc) error
+	GetWorkflowRunByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.WorkflowRun, error)
+}
+
+type WorkflowRunRepository struct {
+	baseRepository[domain.WorkflowRun]
+}
+
+func NewWorkflowRunRepository(
+	conf *configs.Config,
+	dbSource IDatabase,
+) IWorkflowRunRepository {
+	return &WorkflowRunRepository{
+		baseRepository: newBaseRepository[domain.WorkflowRun](dbSource.GetDB(), conf.DatabaseConfig.Timeout),
+	}
+}
+
+func (_self *WorkflowRunRepository) CreateWorkflowRun(ctx context.Context, run *domain.WorkflowRun, opts ...QueryOptionFunc) (int64, error) {
+	err := _self.InsertOnce(ctx, run, opts...)
+	return run.Id, err
+}
+
+func (_self *WorkflowRunRepository) UpdateWorkflowRun(ctx context.Context, run *domain.WorkflowRun, opts ...QueryOptionFunc) error {
+	opts = append(opts, WithCondition("id = ?", run.Id))
+	return _self.UpdateOnce(ctx, run, opts...)
+}
+
+func (_self *WorkflowRunRepository) GetWorkflowRunByID(ctx context.Context, id int64, opts ...QueryOptionFunc) (*domain.WorkflowRun, error) {
+	opts = append(opts, WithCondition("id = ?", id))
+	opts = append(opts, WithLimit(1))
+	return _self.Find(ctx, opts...)
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository/crawlerservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ICrawlPreviewService interface {
+	// PreviewCrawl crawls the event once on a crawler worker, the event is not saved
+	PreviewCrawl(ctx context.Context, event *entity.SchedulerEvent) (*entity.CrawlPreview, error)
+}
+
+type CrawlPreviewService struct {
+	conf           *configs.Config
+	crawlerService crawlerservice.ICrawlerService
+}
+
+func NewCrawlPreviewService(
+	conf *configs.Config,
+	crawlerService crawlerservice.ICrawlerService,
+) *CrawlPreviewService {
+	return &CrawlPreviewService{
+		conf:           conf,
+		crawlerService: crawlerService,
+	}
+}
+
+func (_self *CrawlPreviewService) PreviewCrawl(ctx context.Context, event *entity.SchedulerEvent) (*entity.CrawlPreview, error) {
+	// the crawler accepts the internal API key only, without it every preview is denied
+	if _self.conf.Internal.APIKey == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "crawl preview needs internal_api_key")
+	}
+	return _self.crawlerService.PreviewCrawl(ctx, event)
+}
@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/namnv2496/scheduler/internal/configs"
@@ -12,10 +15,12 @@ import (
 	"github.com/namnv2496/scheduler/internal/entity"
 	"github.com/namnv2496/scheduler/internal/repository"
 	"github.com/namnv2496/scheduler/internal/repository/distributedlock"
-	"github.com/namnv2496/scheduler/internal/service/mq"
+	"github.com/namnv2496/scheduler/internal/repository/shardlease"
 	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/namnv2496/scheduler/pkg/utils"
 
 	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
 )
 
 const (
@@ -25,7 +30,9 @@ const (
 )
 
 type ICrawlerCronJob interface {
-	Start() error
+	Start(ctx context.Context) error
+	// Stop waits for the tick in flight, then stops the relay and releases the shards
+	Stop(ctx context.Context) error
 }
 
 type CrawlerCronJob struct {
@@ -33,27 +40,41 @@ type CrawlerCronJob struct {
 	domains            []string
 	SchedulerEventRepo repository.ISchedulerEventRepository
 	distributedLock    distributedlock.IDistributedLock
-	producers          mq.IProducer
+	shardLease         shardlease.IShardLease
+	outboxRelay        IOutboxRelay
+	tenantRepo         repository.ITenantRepository
+	rateLimit          utils.IRateLimit
+	cronJob            *cron.Cron
+	cancel             context.CancelFunc
 }
 
 func NewUrlCronJob(
 	conf *configs.Config,
 	SchedulerEventRepo repository.ISchedulerEventRepository,
 	distributedLock distributedlock.IDistributedLock,
-	producers mq.IProducer,
+	shardLease shardlease.IShardLease,
+	outboxRelay IOutboxRelay,
+	tenantRepo repository.ITenantRepository,
+	rateLimit utils.IRateLimit,
 ) ICrawlerCronJob {
 	return &CrawlerCronJob{
 		conf:               conf,
 		domains:            conf.AppConfig.Domains,
 		SchedulerEventRepo: SchedulerEventRepo,
 		distributedLock:    distributedLock,
-		producers:          producers,
+		shardLease:         shardLease,
+		outboxRelay:        outboxRelay,
+		tenantRepo:         tenantRepo,
+		rateLimit:          rateLimit,
 	}
 }
 
-func (_self *CrawlerCronJob) Start() error {
+func (_self *CrawlerCronJob) Start(ctx context.Context) error {
 	cronJob := cron.New()
-	ctx := context.Background()
+	ctx, _self.cancel = context.WithCancel(ctx)
+	// claim shards before the first tick so replicas split the events between them
+	_self.shardLease.Start(ctx)
+	_self.outboxRelay.Start(ctx)
 	_, err := cronJob.AddFunc(
 		_self.conf.Cron.CronExpression,
 		_self.ExecuteEvent(ctx),
@@ -64,24 +85,53 @@ func (_self *CrawlerCronJob) Start() error {
 		return err
 	}
 	cronJob.Start()
+	_self.cronJob = cronJob
 	return nil
 }
 
+func (_self *CrawlerCronJob) Stop(ctx context.Context) error {
+	if _self.cronJob == nil {
+		return nil
+	}
+	var err error
+	select {
+	case <-_self.cronJob.Stop().Done():
+	case <-ctx.Done():
+		err = fmt.Errorf("tick in flight is not finished: %w", ctx.Err())
+	}
+	_self.cancel()
+	_self.shardLease.Stop(ctx)
+	return err
+}
+
 func (_self *CrawlerCronJob) ExecuteEvent(ctx context.Context) func() {
 	ctx = logging.AppendPrefix(ctx, "ExecuteEvent")
 
 	return func() {
 		logging.Infof(ctx, "Acquire and execute event")
+		shards := _self.shardLease.Shards()
+		if len(shards) == 0 {
+			logging.Infof(ctx, "No shard is leased by this worker, skip")
+			return
+		}
 		now := time.Now().UnixMilli()
-		events, err := _self.SchedulerEventRepo.GetSchedulerEventByStatusAndSchedulerAt(ctx, domain.StatusPending, now)
+		events, err := _self.SchedulerEventRepo.GetSchedulerEventByStatusAndSchedulerAt(ctx, domain.StatusPending, now, repository.ShardFilter{
+			Total:  _self.shardLease.Total(),
+			Shards: shards,
+		})
 		if err != nil {
 			logging.Errorf(ctx, "Failed to get crawler events: %v", err)
 			return
 		}
+		tenants, err := _self.getTenants(ctx)
+		if err != nil {
+			logging.Errorf(ctx, "Failed to get tenants: %v", err)
+			return
+		}
 
-		updateEventsChan := make(chan *domain.SchedulerEvent, len(events))
 		semaphore := make(chan struct{}, MaxWorker)
 		var wg sync.WaitGroup
+		var dispatched atomic.Int32
 
 		for _, event := range events {
 			// separate go routine to work as much as possible
@@ -98,7 +148,28 @@ func (_self *CrawlerCronJob) ExecuteEvent(ctx context.Context) func() {
 				}
 				defer mutex.Unlock()
 
-				if err := _self.publishToCrawler(ctx, entity.SchedulerEvent(*e)); err != nil {
+				// the shard may be handed over since the query, its new owner dispatches the event
+				if !_self.shardLease.Owns(e.Id) {
+					logging.Infof(ctx, "shard of event %d is not leased by this worker anymore, skip", e.Id)
+					return
+				}
+				if !_self.allowCrawl(ctx, tenants[e.Team]) {
+					logging.Infof(ctx, "tenant %s is over its hourly crawls, event %d waits for the next tick", e.Team, e.Id)
+					return
+				}
+
+				startedAt := time.Now()
+				run := &domain.EventRun{
+					RunId:       entity.BuildRunId(e.Id, e.SchedulerAt),
+					EventId:     e.Id,
+					Trigger:     domain.TriggerCron,
+					Status:      domain.StatusRunning,
+					ScheduledAt: e.SchedulerAt,
+					StartedAt:   &startedAt,
+				}
+				outbox, err := buildCrawlerOutbox(e, run.RunId)
+				if err != nil {
+					logging.Errorf(ctx, "Failed to build outbox for event %d: %v", e.Id, err)
 					return
 				}
 
@@ -119,34 +190,70 @@ func (_self *CrawlerCronJob) ExecuteEvent(ctx context.Context) func() {
 				} else {
 					e.Status = domain.StatusFailed
 				}
-				updateEventsChan <- e
+				// status change and outbox message are committed together, the relay publishes it
+				if err := _self.SchedulerEventRepo.DispatchSchedulerEvent(ctx, e, run, outbox); err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						// the event was edited or deleted since it was read, the next tick sees the new version
+						logging.Infof(ctx, "event %d changed before dispatch, skip", e.Id)
+						return
+					}
+					logging.Errorf(ctx, "Failed to dispatch event %d: %v", e.Id, err)
+					return
+				}
+				dispatched.Add(1)
 			}(event)
 		}
+		wg.Wait()
 
-		go func() {
-			wg.Wait()
-			close(updateEventsChan)
-		}()
+		logging.Infof(ctx, "dispatched events: %d", dispatched.Load())
+	}
+}
 
-		updateEvents := make([]*domain.SchedulerEvent, 0)
-		for event := range updateEventsChan {
-			updateEvents = append(updateEvents, event)
+// getTenants returns the tenants with an hourly crawl quota by team
+func (_self *CrawlerCronJob) getTenants(ctx context.Context) (map[string]*domain.Tenant, error) {
+	tenants, err := _self.tenantRepo.GetTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := make(map[string]*domain.Tenant, len(tenants))
+	for _, tenant := range tenants {
+		if tenant.MaxCrawlsPerHour > 0 {
+			resp[tenant.Name] = tenant
 		}
+	}
+	return resp, nil
+}
 
-		logging.Infof(ctx, "update events: %d", len(updateEvents))
-		if err := _self.SchedulerEventRepo.Updates(ctx, updateEvents); err != nil {
-			logging.Errorf(ctx, "error update events: %s", err)
-		}
+// allowCrawl takes one crawl from the hourly quota of the tenant, it fails open when redis is down
+func (_self *CrawlerCronJob) allowCrawl(ctx context.Context, tenant *domain.Tenant) bool {
+	if tenant == nil {
+		return true
+	}
+	limit := int(tenant.MaxCrawlsPerHour)
+	result, err := _self.rateLimit.Consume(ctx, tenantCrawlsPath, tenant.Name, utils.LimitCustom(limit, limit, time.Hour))
+	if err != nil {
+		logging.Errorf(ctx, "Failed to check crawls of tenant %s: %v", tenant.Name, err)
+		return true
 	}
+	return result == nil || result.Allowed > 0
 }
 
-func (_self *CrawlerCronJob) publishToCrawler(ctx context.Context, eventData entity.SchedulerEvent) error {
-	logging.AppendPrefix(ctx, "publishToCrawler")
-	err := _self.producers.Publish(ctx, eventData.Queue, strconv.Itoa(int(eventData.Id)), eventData)
+func buildCrawlerOutbox(event *domain.SchedulerEvent, runId string) (*domain.Outbox, error) {
+	var eventData entity.SchedulerEvent
+	if err := utils.Copy(&eventData, event); err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(entity.NewCrawlerEvent(eventData, runId))
 	if err != nil {
-		// Can apply retry at here
-		logging.Infof(ctx, "Publish message to kafka failed: %+v, err: %s", eventData, err)
-		return err
+		return nil, err
 	}
-	return nil
+	return &domain.Outbox{
+		EventId:       eventData.Id,
+		RunId:         runId,
+		Topic:         eventData.Queue,
+		Key:           strconv.Itoa(int(eventData.Id)),
+		Payload:       string(payload),
+		Status:        domain.OutboxStatusPending,
+		NextAttemptAt: time.Now(),
+	}, nil
 }
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/namnv2496/scheduler/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type IDeadLetterService interface {
+	// ListDeadLetters returns the newest dead letters first and the token of the next page
+	ListDeadLetters(ctx context.Context, filter entity.DeadLetterFilter) ([]*entity.DeadLetter, string, error)
+	GetDeadLetter(ctx context.Context, id int64) (*entity.DeadLetter, error)
+	// ReplayDeadLetter publishes the event of the dead letter again as a new run, it returns the run id.
+	// A dead letter which is already replayed needs force.
+	ReplayDeadLetter(ctx context.Context, id int64, force bool) (*entity.DeadLetter, string, error)
+	// BulkReplayDeadLetters replays the given dead letters, or the pending ones of the queue when ids is empty
+	BulkReplayDeadLetters(ctx context.Context, ids []int64, queue string, limit int, force bool) ([]*entity.DeadLetterReplay, error)
+	// DiscardDeadLetter keeps the dead letter for the history, it cannot be replayed anymore
+	DiscardDeadLetter(ctx context.Context, id int64) (*entity.DeadLetter, error)
+}
+
+type DeadLetterService struct {
+	conf           *configs.Config
+	deadLetterRepo repository.IDeadLetterRepository
+	eventRepo      repository.ISchedulerEventRepository
+	eventRunRepo   repository.IEventRunRepository
+	outboxRepo     repository.IOutboxRepository
+}
+
+func NewDeadLetterService(
+	conf *configs.Config,
+	deadLetterRepo repository.IDeadLetterRepository,
+	eventRepo repository.ISchedulerEventRepository,
+	eventRunRepo repository.IEventRunRepository,
+	outboxRepo repository.IOutboxRepository,
+) *DeadLetterService {
+	return &DeadLetterService{
+		conf:           conf,
+		deadLetterRepo: deadLetterRepo,
+		eventRepo:      eventRepo,
+		eventRunRepo:   eventRunRepo,
+		outboxRepo:     outboxRepo,
+	}
+}
+
+func (_self *DeadLetterService) ListDeadLetters(ctx context.Context, filter entity.DeadLetterFilter) ([]*entity.DeadLetter, string, error) {
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	before, err := decodeDeadLetterPageToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	// one more row tells whether there is a next page
+	deadLetters, err := _self.deadLetterRepo.ListDeadLetters(ctx, repository.DeadLetterQuery{
+		Queue:   filter.Queue,
+		Status:  filter.Status,
+		EventId: filter.EventId,
+		Before:  before,
+		Limit:   pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	nextPageToken := ""
+	if len(deadLetters) > pageSize {
+		deadLetters = deadLetters[:pageSize]
+		nextPageToken = encodeDeadLetterPageToken(deadLetters[pageSize-1].Id)
+	}
+	resp := make([]*entity.DeadLetter, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		elem, err := toDeadLetter(deadLetter)
+		if err != nil {
+			return nil, "", err
+		}
+		resp = append(resp, elem)
+	}
+	return resp, nextPageToken, nil
+}
+
+func (_self *DeadLetterService) GetDeadLetter(ctx context.Context, id int64) (*entity.DeadLetter, error) {
+	deadLetter, err := _self.getDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDeadLetter(deadLetter)
+}
+
+func (_self *DeadLetterService) ReplayDeadLetter(ctx context.Context, id int64, force bool) (*entity.DeadLetter, string, error) {
+	ctx = logging.AppendPrefix(ctx, "ReplayDeadLetter")
+	var replayed *domain.DeadLetter
+	var runId string
+	err := _self.deadLetterRepo.RunWithTransaction(ctx, "ReplayDeadLetter",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			deadLetter, err := _self.getDeadLetter(ctx, id, repository.WithTx(tx), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			if deadLetter.Status == domain.DeadLetterStatusDiscarded {
+				return false, status.Errorf(codes.FailedPrecondition, "dead letter %d is discarded", id)
+			}
+			if deadLetter.Status == domain.DeadLetterStatusReplayed && !force {
+				return false, status.Errorf(codes.FailedPrecondition, "dead letter %d is already replayed, pass force to replay it again", id)
+			}
+			event, err := _self.replayEvent(ctx, tx, deadLetter)
+			if err != nil {
+				return false, err
+			}
+			now := time.Now()
+			runId = entity.BuildReplayRunId(deadLetter.Id, deadLetter.Replays+1)
+			outbox, err := buildCrawlerOutbox(event, runId)
+			if err != nil {
+				return false, err
+			}
+			run := &domain.EventRun{
+				RunId:       runId,
+				EventId:     event.Id,
+				Trigger:     domain.TriggerReplay,
+				Status:      domain.StatusRunning,
+				ScheduledAt: now.UnixMilli(),
+				StartedAt:   &now,
+			}
+			if err := _self.eventRunRepo.CreateEventRuns(ctx, []*domain.EventRun{run}, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			if err := _self.outboxRepo.InsertOnce(ctx, outbox, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			deadLetter.Status = domain.DeadLetterStatusReplayed
+			deadLetter.Replays += 1
+			deadLetter.ReplayedAt = &now
+			deadLetter.UpdatedAt = now
+			if err := _self.deadLetterRepo.UpdateDeadLetter(ctx, deadLetter, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			replayed = deadLetter
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	logging.Infof(ctx, "dead letter %d of event %d is replayed, run %s", id, replayed.EventId, runId)
+	resp, err := toDeadLetter(replayed)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp, runId, nil
+}
+
+func (_self *DeadLetterService) BulkReplayDeadLetters(ctx context.Context, ids []int64, queue string, limit int, force bool) ([]*entity.DeadLetterReplay, error) {
+	ctx = logging.AppendPrefix(ctx, "BulkReplayDeadLetters")
+	maxReplays := _self.conf.DeadLetter.BulkReplayLimit
+	if len(ids) > maxReplays {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d dead letters are replayed at once", maxReplays)
+	}
+	if len(ids) == 0 {
+		if queue == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "ids or queue is required")
+		}
+		if limit <= 0 || limit > maxReplays {
+			limit = maxReplays
+		}
+		deadLetters, err := _self.deadLetterRepo.ListDeadLetters(ctx, repository.DeadLetterQuery{
+			Queue:  queue,
+			Status: domain.DeadLetterStatusPending,
+			Limit:  limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, deadLetter := range deadLetters {
+			ids = append(ids, deadLetter.Id)
+		}
+	}
+	// every dead letter is replayed on its own, one which cannot be replayed does not stop the others
+	replays := make([]*entity.DeadLetterReplay, 0, len(ids))
+	replayed := 0
+	for _, id := range ids {
+		replay := &entity.DeadLetterReplay{Id: id}
+		if _, runId, err := _self.ReplayDeadLetter(ctx, id, force); err != nil {
+			replay.Error = err.Error()
+			if st, ok := status.FromError(err); ok {
+				replay.Error = st.Message()
+			}
+		} else {
+			replay.RunId = runId
+			replayed++
+		}
+		replays = append(replays, replay)
+	}
+	logging.Infof(ctx, "%d of %d dead letters are replayed", replayed, len(ids))
+	return replays, nil
+}
+
+func (_self *DeadLetterService) DiscardDeadLetter(ctx context.Context, id int64) (*entity.DeadLetter, error) {
+	ctx = logging.AppendPrefix(ctx, "DiscardDeadLetter")
+	var discarded *domain.DeadLetter
+	err := _self.deadLetterRepo.RunWithTransaction(ctx, "DiscardDeadLetter",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			deadLetter, err := _self.getDeadLetter(ctx, id, repository.WithTx(tx), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			if deadLetter.Status == domain.DeadLetterStatusDiscarded {
+				return false, status.Errorf(codes.FailedPrecondition, "dead letter %d is already discarded", id)
+			}
+			now := time.Now()
+			deadLetter.Status = domain.DeadLetterStatusDiscarded
+			deadLetter.DiscardedAt = &now
+			deadLetter.UpdatedAt = now
+			if err := _self.deadLetterRepo.UpdateDeadLetter(ctx, deadLetter, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			discarded = deadLetter
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	logging.Infof(ctx, "dead letter %d of event %d is discarded", id, discarded.EventId)
+	return toDeadLetter(discarded)
+}
+
+// replayEvent is the event of the payload as it is now, the crawler gets its current settings and
+// starts its retries again. The event must still exist and be active.
+func (_self *DeadLetterService) replayEvent(ctx context.Context, tx *gorm.DB, deadLetter *domain.DeadLetter) (*domain.SchedulerEvent, error) {
+	var payload entity.CrawlerEvent
+	if err := json.Unmarshal(deadLetter.Payload, &payload); err != nil || payload.Id == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "dead letter %d has no event to replay, discard it", deadLetter.Id)
+	}
+	event, err := _self.eventRepo.GetSchedulerEventByID(ctx, payload.Id, repository.WithTx(tx))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "event %d of dead letter %d is deleted, discard it", payload.Id, deadLetter.Id)
+		}
+		return nil, err
+	}
+	if !event.IsActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "event %d of dead letter %d is paused", event.Id, deadLetter.Id)
+	}
+	return event, nil
+}
+
+func (_self *DeadLetterService) getDeadLetter(ctx context.Context, id int64, opts ...repository.QueryOptionFunc) (*domain.DeadLetter, error) {
+	deadLetter, err := _self.deadLetterRepo.GetDeadLetterByID(ctx, id, opts...)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "dead letter %d is not found", id)
+	}
+	return deadLetter, err
+}
+
+func toDeadLetter(deadLetter *domain.DeadLetter) (*entity.DeadLetter, error) {
+	var resp entity.DeadLetter
+	if err := utils.Copy(&resp, deadLetter); err != nil {
+		return nil, err
+	}
+	resp.Payload = string(deadLetter.Payload)
+	if !utf8.Valid(deadLetter.Payload) || bytes.IndexByte(deadLetter.Payload, 0) >= 0 {
+		resp.Payload = base64.StdEncoding.EncodeToString(deadLetter.Payload)
+		resp.PayloadBase64 = true
+	}
+	return &resp, nil
+}
+
+// the page token of the dead letters is the last id of the page, they are listed from the newest
+func encodeDeadLetterPageToken(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeDeadLetterPageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+	id, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+	return id, nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/internal/service/mq"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/segmentio/kafka-go"
+)
+
+// deadLetterRetryDelay is the wait before a failed read or store of the mirror is tried again
+const deadLetterRetryDelay = 5 * time.Second
+
+type IDeadLetterMirror interface {
+	// Start mirrors the topic until Stop, the values of ctx are kept but not its end
+	Start(ctx context.Context)
+	// Stop ends the mirror and waits for the message in flight, a message not committed is read again
+	Stop(ctx context.Context) error
+}
+
+// DeadLetterMirror stores the messages of the dead-letter topic in Postgres. A message is committed
+// once it is stored and a redelivered message is skipped, so every dead letter is stored once.
+type DeadLetterMirror struct {
+	conf           *configs.Config
+	reader         mq.IReader
+	deadLetterRepo repository.IDeadLetterRepository
+	cancel         context.CancelFunc
+	stopped        chan struct{}
+}
+
+func NewDeadLetterMirror(
+	conf *configs.Config,
+	reader mq.IReader,
+	deadLetterRepo repository.IDeadLetterRepository,
+) IDeadLetterMirror {
+	return &DeadLetterMirror{
+		conf:           conf,
+		reader:         reader,
+		deadLetterRepo: deadLetterRepo,
+	}
+}
+
+func (_self *DeadLetterMirror) Start(ctx context.Context) {
+	ctx = logging.AppendPrefix(ctx, "DeadLetterMirror")
+	// the context of the start of fx ends once the app is started
+	ctx, _self.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	_self.stopped = make(chan struct{})
+	logging.Infof(ctx, "mirror dead letters of topic %s", _self.conf.DeadLetter.Topic)
+	go func() {
+		defer close(_self.stopped)
+		defer _self.reader.Close()
+		for {
+			message, err := _self.reader.FetchMessage(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				logging.Errorf(ctx, "read dead letter failed: %s", err)
+				if !sleepContext(ctx, deadLetterRetryDelay) {
+					return
+				}
+				continue
+			}
+			if !_self.store(ctx, message) {
+				return
+			}
+			if err := _self.reader.CommitMessages(ctx, message); err != nil && ctx.Err() == nil {
+				// the message is read again and skipped as a duplicate
+				logging.Errorf(ctx, "commit dead letter %d/%d failed: %s", message.Partition, message.Offset, err)
+			}
+		}
+	}()
+}
+
+func (_self *DeadLetterMirror) Stop(ctx context.Context) error {
+	if _self.cancel == nil {
+		return nil
+	}
+	_self.cancel()
+	select {
+	case <-_self.stopped:
+		logging.Infof(logging.AppendPrefix(ctx, "DeadLetterMirror"), "mirror of dead letters is stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// store retries until the message is stored, it returns false when the context is done first. A
+// message which Postgres refuses is stored as a placeholder with the reason instead. A placeholder
+// which is refused as well stops the mirror on the message: it is retried, never committed unstored.
+func (_self *DeadLetterMirror) store(ctx context.Context, message kafka.Message) bool {
+	deadLetter := fromDeadLetterMessage(message)
+	placeholder := false
+	for {
+		created, err := _self.deadLetterRepo.CreateDeadLetter(ctx, deadLetter)
+		if err == nil {
+			if created {
+				logging.Infof(ctx, "dead letter %d of queue %s, event %d: %s",
+					deadLetter.Id, deadLetter.Queue, deadLetter.EventId, deadLetter.Error)
+			}
+			return true
+		}
+		logging.Errorf(ctx, "store dead letter %d/%d failed: %s", message.Partition, message.Offset, err)
+		if isPermanentStoreError(err) && !placeholder {
+			placeholder = true
+			deadLetter.Payload = []byte{}
+			deadLetter.Error = textColumn(fmt.Sprintf("message is not stored: %s; %s", err, deadLetter.Error), 0)
+			continue
+		}
+		if placeholder && isPermanentStoreError(err) {
+			logging.Errorf(ctx, "placeholder of dead letter %d/%d is refused, the mirror is stuck until it is stored", message.Partition, message.Offset)
+		}
+		if !sleepContext(ctx, deadLetterRetryDelay) {
+			return false
+		}
+	}
+}
+
+// isPermanentStoreError is an error which the retries do not fix: invalid data (class 22) or a
+// violated constraint (class 23) of Postgres
+func isPermanentStoreError(err error) bool {
+	var pgErr interface{ SQLState() string }
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	state := pgErr.SQLState()
+	return strings.HasPrefix(state, "22") || strings.HasPrefix(state, "23")
+}
+
+// textColumn makes a header fit a text column: Postgres refuses NUL and invalid UTF-8, size is the
+// length of a varchar column, 0 for a text column
+func textColumn(value string, size int) string {
+	value = strings.ToValidUTF8(strings.ReplaceAll(value, "\x00", ""), "\uFFFD")
+	if size > 0 && utf8.RuneCountInString(value) > size {
+		value = string([]rune(value)[:size])
+	}
+	return value
+}
+
+// fromDeadLetterMessage reads the headers of the crawler workers, the event and the run are read from the payload when it is an event.
+// The payload is kept as read, the texts are made to fit their columns.
+func fromDeadLetterMessage(message kafka.Message) *domain.DeadLetter {
+	now := time.Now()
+	deadLetter := &domain.DeadLetter{
+		Queue:               textColumn(mq.Header(message, mq.HeaderDeadLetterQueue), 64),
+		Topic:               textColumn(mq.Header(message, mq.HeaderDeadLetterTopic), 255),
+		Key:                 textColumn(string(message.Key), 255),
+		Payload:             append([]byte{}, message.Value...),
+		Error:               textColumn(mq.Header(message, mq.HeaderDeadLetterError), 0),
+		Status:              domain.DeadLetterStatusPending,
+		DeadLetterPartition: message.Partition,
+		DeadLetterOffset:    message.Offset,
+		FailedAt:            message.Time,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	deadLetter.Partition, _ = strconv.Atoi(mq.Header(message, mq.HeaderDeadLetterPartition))
+	deadLetter.Offset, _ = strconv.ParseInt(mq.Header(message, mq.HeaderDeadLetterOffset), 10, 64)
+	deadLetter.Attempts, _ = strconv.ParseInt(mq.Header(message, mq.HeaderDeadLetterAttempts), 10, 64)
+	if failedAt, err := time.Parse(time.RFC3339Nano, mq.Header(message, mq.HeaderDeadLetterTime)); err == nil {
+		deadLetter.FailedAt = failedAt
+	}
+	if deadLetter.FailedAt.IsZero() {
+		deadLetter.FailedAt = now
+	}
+	var event struct {
+		Id    int64  `json:"id"`
+		Queue string `json:"queue"`
+		RunId string `json:"run_id"`
+	}
+	if err := json.Unmarshal(message.Value, &event); err == nil {
+		deadLetter.EventId = event.Id
+		deadLetter.RunId = textColumn(event.RunId, 255)
+		if deadLetter.Queue == "" {
+			deadLetter.Queue = textColumn(event.Queue, 64)
+		}
+	}
+	return deadLetter
+}
+
+// sleepContext waits for the delay, it returns false when the context is done first
+func sleepContext(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type IEventRunService interface {
+	// ReportEventRun records the result of a run reported by the crawler
+	ReportEventRun(ctx context.Context, result entity.RunResult) error
+}
+
+type EventRunService struct {
+	eventRunRepo    repository.IEventRunRepository
+	eventService    ISchedulerEventService
+	workflowService IWorkflowService
+}
+
+func NewEventRunService(
+	eventRunRepo repository.IEventRunRepository,
+	eventService ISchedulerEventService,
+	workflowService IWorkflowService,
+) *EventRunService {
+	return &EventRunService{
+		eventRunRepo:    eventRunRepo,
+		eventService:    eventService,
+		workflowService: workflowService,
+	}
+}
+
+func (_self *EventRunService) ReportEventRun(ctx context.Context, result entity.RunResult) error {
+	ctx = logging.AppendPrefix(ctx, "ReportEventRun")
+	runStatus := result.Status
+	if runStatus != domain.StatusSuccessed && runStatus != domain.StatusFailed {
+		return status.Errorf(codes.InvalidArgument, "run status must be %s or %s", domain.StatusSuccessed, domain.StatusFailed)
+	}
+	run, err := _self.eventRunRepo.GetEventRunByRunId(ctx, result.RunId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "run %s is not found", result.RunId)
+		}
+		return err
+	}
+	if result.EventId > 0 && result.EventId != run.EventId {
+		return status.Errorf(codes.InvalidArgument, "run %s does not belong to event %d", result.RunId, result.EventId)
+	}
+	if run.IsFinished() {
+		// the crawler may report a redelivered message twice
+		logging.Infof(ctx, "run %s is already %s, skip", result.RunId, run.Status)
+		return nil
+	}
+	finishedAt := time.Now()
+	run.Status = runStatus
+	run.Error = result.Error
+	run.DurationMs = result.Duration.Milliseconds()
+	run.ResultId = result.ResultId
+	run.FinishedAt = &finishedAt
+	if err := _self.eventRunRepo.UpdateEventRun(ctx, run); err != nil {
+		return err
+	}
+
+	switch run.Trigger {
+	case domain.TriggerCron:
+		return _self.eventService.UpdateEventStatus(ctx, run.EventId, runStatus)
+	case domain.TriggerWorkflow:
+		return _self.workflowService.AdvanceWorkflowRun(ctx, run.WorkflowRunId)
+	default:
+		// manual, backfill and replay runs do not touch the schedule of the event
+		return nil
+	}
+}
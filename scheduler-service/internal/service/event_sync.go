@@ -0,0 +1,305 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository"
+	internalvalidator "github.com/namnv2496/scheduler/internal/validator"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+type IEventSyncService interface {
+	// Sync reconciles the named events with the manifests and returns the plan.
+	// Nothing is written on dry run, prune soft deletes named events which have no manifest and
+	// is refused when there is no manifest at all.
+	Sync(ctx context.Context, manifests []entity.EventManifest, dryRun, prune bool) ([]entity.SyncChange, error)
+}
+
+type EventSyncService struct {
+	repo         repository.ISchedulerEventRepository
+	queueRepo    repository.IQueueRepository
+	eventService ISchedulerEventService
+	validator    internalvalidator.IValidate
+}
+
+func NewEventSyncService(
+	repo repository.ISchedulerEventRepository,
+	queueRepo repository.IQueueRepository,
+	eventService ISchedulerEventService,
+	validator internalvalidator.IValidate,
+) *EventSyncService {
+	return &EventSyncService{
+		repo:         repo,
+		queueRepo:    queueRepo,
+		eventService: eventService,
+		validator:    validator,
+	}
+}
+
+// LoadEventManifests reads every *.yaml/*.yml file under dir. A file holds one or more
+// documents, each document is a manifest or a list of manifests.
+func LoadEventManifests(dir string) ([]entity.EventManifest, error) {
+	manifests := make([]entity.EventManifest, 0)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if d.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			return nil
+		}
+		fileManifests, err := loadManifestFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		manifests = append(manifests, fileManifests...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return manifests, nil
+}
+
+func loadManifestFile(path string) ([]entity.EventManifest, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	manifests := make([]entity.EventManifest, 0)
+	decoder := yaml.NewDecoder(file)
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(node.Content) == 0 {
+			continue
+		}
+		var documents []entity.EventManifest
+		switch node.Content[0].Kind {
+		case yaml.SequenceNode:
+			err = decodeStrict(node.Content[0], &documents)
+		case yaml.MappingNode:
+			var manifest entity.EventManifest
+			err = decodeStrict(node.Content[0], &manifest)
+			documents = append(documents, manifest)
+		default:
+			err = fmt.Errorf("line %d: a document must be a manifest or a list of manifests", node.Line)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range documents {
+			documents[i].File = path
+		}
+		manifests = append(manifests, documents...)
+	}
+	return manifests, nil
+}
+
+// decodeStrict rejects unknown keys so a typo in a manifest is not silently dropped
+func decodeStrict(node *yaml.Node, out any) error {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	return decoder.Decode(out)
+}
+
+func (_self *EventSyncService) Sync(ctx context.Context, manifests []entity.EventManifest, dryRun, prune bool) ([]entity.SyncChange, error) {
+	ctx = logging.AppendPrefix(ctx, "Sync")
+	if prune && len(manifests) == 0 {
+		// an empty or wrong directory would delete every named event
+		return nil, errors.New("no manifest is read, prune is refused")
+	}
+	desired, err := _self.validateManifests(ctx, manifests)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := _self.repo.GetNamedSchedulerEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingByName := make(map[string]*domain.SchedulerEvent, len(existing))
+	for _, event := range existing {
+		existingByName[event.Name] = event
+	}
+
+	names := make([]string, 0, len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	changes := make([]entity.SyncChange, 0)
+	for _, name := range names {
+		event, ok := existingByName[name]
+		if !ok {
+			changes = append(changes, entity.SyncChange{Action: entity.SyncActionCreate, Name: name})
+			continue
+		}
+		if fields := diffManifest(desired[name], event); len(fields) > 0 {
+			changes = append(changes, entity.SyncChange{Action: entity.SyncActionUpdate, Name: name, Id: event.Id, Fields: fields})
+		}
+	}
+	if prune {
+		for _, event := range existing {
+			if _, ok := desired[event.Name]; !ok {
+				changes = append(changes, entity.SyncChange{Action: entity.SyncActionDelete, Name: event.Name, Id: event.Id})
+			}
+		}
+	}
+	if dryRun {
+		return changes, nil
+	}
+
+	failed := 0
+	for i := range changes {
+		if err := _self.apply(ctx, &changes[i], desired[changes[i].Name]); err != nil {
+			changes[i].Error = err.Error()
+			failed++
+		}
+	}
+	if failed > 0 {
+		return changes, fmt.Errorf("%d of %d changes failed", failed, len(changes))
+	}
+	return changes, nil
+}
+
+// validateManifests checks every manifest before anything is written
+func (_self *EventSyncService) validateManifests(ctx context.Context, manifests []entity.EventManifest) (map[string]entity.EventManifest, error) {
+	desired := make(map[string]entity.EventManifest, len(manifests))
+	problems := make([]string, 0)
+	for _, manifest := range manifests {
+		if manifest.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: name is required", manifest.File))
+			continue
+		}
+		if previous, ok := desired[manifest.Name]; ok {
+			problems = append(problems, fmt.Sprintf("%s: name %q is already declared in %s", manifest.File, manifest.Name, previous.File))
+			continue
+		}
+		desired[manifest.Name] = manifest
+		if err := manifest.RetryPolicy.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s: %s", manifest.File, manifest.Name, err))
+		}
+		fields := manifestToEvent(manifest).ToMap()
+		if err := _self.validator.Validate(ctx, internalvalidator.ActionInsert, fields); err != nil {
+			if st, ok := status.FromError(err); ok {
+				err = errors.New(st.Message())
+			}
+			problems = append(problems, fmt.Sprintf("%s: %s: %s", manifest.File, manifest.Name, err))
+		}
+		if _, err := getEventQueue(ctx, _self.queueRepo, manifest.Queue); err != nil {
+			if st, ok := status.FromError(err); ok {
+				err = errors.New(st.Message())
+			}
+			problems = append(problems, fmt.Sprintf("%s: %s: %s", manifest.File, manifest.Name, err))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid manifests:\n%s", strings.Join(problems, "\n"))
+	}
+	return desired, nil
+}
+
+func (_self *EventSyncService) apply(ctx context.Context, change *entity.SyncChange, manifest entity.EventManifest) error {
+	switch change.Action {
+	case entity.SyncActionCreate:
+		id, err := _self.eventService.CreateSchedulerEvent(ctx, manifestToEvent(manifest))
+		change.Id = id
+		return err
+	case entity.SyncActionUpdate:
+		// the service checks the queue and reschedules the event when its cron_exp changes
+		_, err := _self.eventService.PatchSchedulerEvent(ctx, change.Id, manifestToEvent(manifest), change.Fields, 0)
+		return err
+	case entity.SyncActionDelete:
+		return _self.eventService.DeleteSchedulerEvent(ctx, change.Id)
+	}
+	return nil
+}
+
+func manifestToEvent(manifest entity.EventManifest) *entity.SchedulerEvent {
+	isActive := true
+	if manifest.IsActive != nil {
+		isActive = *manifest.IsActive
+	}
+	return &entity.SchedulerEvent{
+		Name:        manifest.Name,
+		Url:         manifest.Url,
+		Method:      manifest.Method,
+		Description: manifest.Description,
+		Queue:       manifest.Queue,
+		Domain:      manifest.Domain,
+		IsActive:    isActive,
+		NextRunTime: manifest.NextRunTime,
+		RepeatTimes: manifest.RepeatTimes,
+		SchedulerAt: manifest.SchedulerAt,
+		Status:      domain.StatusPending,
+		CronExp:     manifest.CronExp,
+		RetryPolicy: manifest.RetryPolicy,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// manifestFields are the columns kept in sync. scheduler_at and repeat_times only seed a new
+// event, the cron moves them afterwards and a sync must not reset the schedule.
+func manifestFields(manifest entity.EventManifest) map[string]any {
+	event := manifestToEvent(manifest)
+	return map[string]any{
+		"url":           event.Url,
+		"method":        event.Method,
+		"description":   event.Description,
+		"queue":         event.Queue,
+		"domain":        event.Domain,
+		"is_active":     event.IsActive,
+		"next_run_time": event.NextRunTime,
+		"cron_exp":      event.CronExp,
+		"retry_policy":  event.RetryPolicy,
+	}
+}
+
+func diffManifest(manifest entity.EventManifest, event *domain.SchedulerEvent) []string {
+	current := map[string]any{
+		"url":           event.Url,
+		"method":        event.Method,
+		"description":   event.Description,
+		"queue":         event.Queue,
+		"domain":        event.Domain,
+		"is_active":     event.IsActive,
+		"next_run_time": event.NextRunTime,
+		"cron_exp":      event.CronExp,
+		"retry_policy":  event.RetryPolicy,
+	}
+	fields := make([]string, 0)
+	for field, value := range manifestFields(manifest) {
+		if !reflect.DeepEqual(current[field], value) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
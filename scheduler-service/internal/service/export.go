@@ -0,0 +1,146 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ExportFormatJSON = "json"
+	ExportFormatCSV  = "csv"
+	ExportFormatYAML = "yaml"
+
+	exportBatchSize = 500
+)
+
+// exportEncoder turns batches of events into chunks of one document
+type exportEncoder interface {
+	ContentType() string
+	Begin() ([]byte, error)
+	Batch(records []entity.SchedulerEventRecord) ([]byte, error)
+	End() ([]byte, error)
+}
+
+func newExportEncoder(format string) (exportEncoder, error) {
+	switch format {
+	case "", ExportFormatJSON:
+		return &jsonExportEncoder{}, nil
+	case ExportFormatCSV:
+		return &csvExportEncoder{}, nil
+	case ExportFormatYAML:
+		return &yamlExportEncoder{}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format %q, use json, csv or yaml", format)
+	}
+}
+
+func toSchedulerEventRecord(event *domain.SchedulerEvent) entity.SchedulerEventRecord {
+	return entity.SchedulerEventRecord{
+		Id:          event.Id,
+		Name:        event.Name,
+		Team:        event.Team,
+		Url:         event.Url,
+		Method:      event.Method,
+		Description: event.Description,
+		Queue:       event.Queue,
+		Domain:      event.Domain,
+		IsActive:    event.IsActive,
+		NextRunTime: event.NextRunTime,
+		RepeatTimes: event.RepeatTimes,
+		SchedulerAt: event.SchedulerAt,
+		Status:      event.Status,
+		CronExp:     event.CronExp,
+		RetryPolicy: event.RetryPolicy,
+	}
+}
+
+// jsonExportEncoder writes one JSON array
+type jsonExportEncoder struct {
+	count int
+}
+
+func (_self *jsonExportEncoder) ContentType() string { return "application/json" }
+
+func (_self *jsonExportEncoder) Begin() ([]byte, error) { return []byte("["), nil }
+
+func (_self *jsonExportEncoder) Batch(records []entity.SchedulerEventRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		if _self.count > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+		buf.Write(data)
+		_self.count++
+	}
+	return buf.Bytes(), nil
+}
+
+func (_self *jsonExportEncoder) End() ([]byte, error) { return []byte("\n]\n"), nil }
+
+type csvExportEncoder struct{}
+
+func (_self *csvExportEncoder) ContentType() string { return "text/csv" }
+
+func (_self *csvExportEncoder) Begin() ([]byte, error) {
+	return _self.write([][]string{entity.SchedulerEventRecordHeader})
+}
+
+func (_self *csvExportEncoder) Batch(records []entity.SchedulerEventRecord) ([]byte, error) {
+	rows := make([][]string, len(records))
+	for i, record := range records {
+		rows[i] = record.CSVRow()
+	}
+	return _self.write(rows)
+}
+
+func (_self *csvExportEncoder) End() ([]byte, error) { return nil, nil }
+
+func (_self *csvExportEncoder) write(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// yamlExportEncoder writes one sequence, the batches are appended items of it
+type yamlExportEncoder struct {
+	count int
+}
+
+func (_self *yamlExportEncoder) ContentType() string { return "application/yaml" }
+
+func (_self *yamlExportEncoder) Begin() ([]byte, error) { return nil, nil }
+
+func (_self *yamlExportEncoder) Batch(records []entity.SchedulerEventRecord) ([]byte, error) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+	_self.count += len(records)
+	data, err := yaml.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("marshal yaml: %w", err)
+	}
+	return data, nil
+}
+
+func (_self *yamlExportEncoder) End() ([]byte, error) {
+	if _self.count == 0 {
+		return []byte("[]\n"), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,46 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/segmentio/kafka-go"
+)
+
+// headers of a dead letter written by the crawler workers, the key and the value are the ones of the given up message
+const (
+	HeaderDeadLetterError     = "dead-letter-error"
+	HeaderDeadLetterQueue     = "dead-letter-queue"
+	HeaderDeadLetterTopic     = "dead-letter-topic"
+	HeaderDeadLetterPartition = "dead-letter-partition"
+	HeaderDeadLetterOffset    = "dead-letter-offset"
+	HeaderDeadLetterAttempts  = "dead-letter-attempts"
+	HeaderDeadLetterTime      = "dead-letter-time"
+)
+
+type IReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// NewDeadLetterReader reads the dead-letter topic in the consumer group of the scheduler workers
+func NewDeadLetterReader(
+	conf *configs.Config,
+) IReader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers: conf.KafkaConsumerConfig.Brokers,
+		Topic:   conf.DeadLetter.Topic,
+		GroupID: conf.DeadLetter.GroupID,
+	})
+}
+
+// Header returns the value of the header, empty when the message does not have it
+func Header(message kafka.Message, key string) string {
+	for _, header := range message.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
@@ -3,52 +3,88 @@ package mq
 import (
 	"context"
 	"encoding/json"
+	"sync"
+	"time"
 
 	"github.com/namnv2496/scheduler/internal/configs"
 	"github.com/segmentio/kafka-go"
 )
 
+// writeBatchTimeout bounds the wait of the writer for more messages, the callers write their batches at once
+const writeBatchTimeout = 10 * time.Millisecond
+
 type IProducer interface {
 	Publish(ctx context.Context, topic, key string, value any) error
+	// PublishBatch writes the messages in one call, a partial failure is a kafka.WriteErrors with the
+	// error of every message
+	PublishBatch(ctx context.Context, topic string, messages []Message) error
+}
+
+// Message is a message of a batch, its value is written as JSON
+type Message struct {
+	Key   string
+	Value any
 }
 
+// Producer writes to any topic, the writer of a topic is created by its first message
 type Producer struct {
-	client map[string]*kafka.Writer
+	brokers []string
+	mutex   sync.Mutex
+	client  map[string]*kafka.Writer
 }
 
 func NewKafkaProducer(
 	conf *configs.Config,
 ) IProducer {
-	clients := make(map[string]*kafka.Writer, 0)
-	for _, topic := range conf.KafkaProducerConfig.Topic {
-		clients[topic] = &kafka.Writer{
-			Addr:                   kafka.TCP(conf.KafkaConsumerConfig.Brokers...),
-			Balancer:               &kafka.LeastBytes{},
-			Topic:                  topic,
-			AllowAutoTopicCreation: true,
-		}
-	}
 	return &Producer{
-		client: clients,
+		brokers: conf.KafkaConsumerConfig.Brokers,
+		client:  make(map[string]*kafka.Writer),
 	}
 }
 
 func (p *Producer) Publish(ctx context.Context, topic, key string, value any) error {
 	// deferFunc := logging.AppendPrefix("Publish")
 	// defer deferFunc()
-	producer := p.client[topic]
-	if producer == nil {
-		// logging.Error(ctx, "topic %s not found", topic)
-		return nil
-	}
 	jsonData, err := json.Marshal(value)
 	if err != nil {
 		return err
 	}
-	return producer.WriteMessages(context.Background(),
+	return p.writer(topic).WriteMessages(ctx,
 		kafka.Message{
 			Key:   []byte(key),
 			Value: []byte(jsonData),
 		},
 	)
 }
+
+func (p *Producer) PublishBatch(ctx context.Context, topic string, messages []Message) error {
+	batch := make([]kafka.Message, 0, len(messages))
+	for _, message := range messages {
+		jsonData, err := json.Marshal(message.Value)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, kafka.Message{
+			Key:   []byte(message.Key),
+			Value: jsonData,
+		})
+	}
+	return p.writer(topic).WriteMessages(ctx, batch...)
+}
+
+func (p *Producer) writer(topic string) *kafka.Writer {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	producer := p.client[topic]
+	if producer == nil {
+		producer = &kafka.Writer{
+			Addr:                   kafka.TCP(p.brokers...),
+			Balancer:               &kafka.LeastBytes{},
+			Topic:                  topic,
+			AllowAutoTopicCreation: true,
+			BatchTimeout:           writeBatchTimeout,
+		}
+		p.client[topic] = producer
+	}
+	return producer
+}
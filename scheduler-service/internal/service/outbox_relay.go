@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/internal/service/mq"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+)
+
+type IOutboxRelay interface {
+	Start(ctx context.Context)
+}
+
+// OutboxRelay publishes outbox messages to Kafka and marks them sent.
+// A message is retried with a growing delay until MaxAttempts, which gives
+// at-least-once dispatch: a crash after publishing only causes a re-publish
+// once the claim of the message times out.
+type OutboxRelay struct {
+	conf       *configs.Config
+	outboxRepo repository.IOutboxRepository
+	queueRepo  repository.IQueueRepository
+	producers  mq.IProducer
+}
+
+func NewOutboxRelay(
+	conf *configs.Config,
+	outboxRepo repository.IOutboxRepository,
+	queueRepo repository.IQueueRepository,
+	producers mq.IProducer,
+) IOutboxRelay {
+	return &OutboxRelay{
+		conf:       conf,
+		outboxRepo: outboxRepo,
+		queueRepo:  queueRepo,
+		producers:  producers,
+	}
+}
+
+func (_self *OutboxRelay) Start(ctx context.Context) {
+	ctx = logging.AppendPrefix(ctx, "OutboxRelay")
+	go func() {
+		ticker := time.NewTicker(_self.conf.Outbox.RelayInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := _self.relay(ctx); err != nil {
+					logging.Errorf(ctx, "relay outbox failed: %s", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// relay claims the due messages in a short transaction and publishes them outside of it, the row
+// locks are not held while Kafka is written. The messages are written in one batch per queue.
+func (_self *OutboxRelay) relay(ctx context.Context) error {
+	outboxes, err := _self.claim(ctx)
+	if err != nil || len(outboxes) == 0 {
+		return err
+	}
+	topics, err := _self.queueTopics(ctx)
+	if err != nil {
+		// the claimed messages are published again once their claim times out
+		return err
+	}
+	now := time.Now()
+	batches := make(map[string][]*domain.Outbox)
+	for _, outbox := range outboxes {
+		batches[outbox.Topic] = append(batches[outbox.Topic], outbox)
+	}
+	for queue, batch := range batches {
+		_self.publish(ctx, queue, batch, topics, now)
+	}
+	for _, outbox := range outboxes {
+		// a message cancelled while it was published keeps its status
+		err := _self.outboxRepo.UpdateOutbox(ctx, outbox, repository.WithCondition("status = ?", domain.OutboxStatusPending))
+		if err != nil {
+			// the message is published again once its claim times out
+			logging.Errorf(ctx, "mark outbox %d failed: %s", outbox.Id, err)
+		}
+	}
+	logging.Infof(ctx, "relayed outbox messages: %d", len(outboxes))
+	return nil
+}
+
+// claim locks the due messages and moves their next attempt after the claim timeout, the other relays
+// skip them until then
+func (_self *OutboxRelay) claim(ctx context.Context) ([]*domain.Outbox, error) {
+	var outboxes []*domain.Outbox
+	err := _self.outboxRepo.RunWithTransaction(ctx, "ClaimOutbox",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			now := time.Now()
+			outboxes, err = _self.outboxRepo.GetPendingOutboxes(ctx, now, _self.conf.Outbox.BatchSize, repository.WithTx(tx))
+			if err != nil {
+				return false, err
+			}
+			ids := make([]int64, 0, len(outboxes))
+			for _, outbox := range outboxes {
+				ids = append(ids, outbox.Id)
+			}
+			if err := _self.outboxRepo.ClaimOutboxes(ctx, ids, now.Add(_self.conf.Outbox.ClaimTimeout), repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return outboxes, nil
+}
+
+// queueTopics are the topics of the queues by the name of the queue, they are read once per batch
+func (_self *OutboxRelay) queueTopics(ctx context.Context) (map[string]string, error) {
+	queues, err := _self.queueRepo.GetQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	topics := make(map[string]string, len(queues))
+	for _, queue := range queues {
+		topics[queue.Name] = queue.Topic
+	}
+	return topics, nil
+}
+
+// publish writes the messages of a queue and sets the outcome of every message
+func (_self *OutboxRelay) publish(ctx context.Context, queue string, outboxes []*domain.Outbox, topics map[string]string, now time.Time) {
+	topic, ok := topics[queue]
+	if !ok {
+		// the queue may be created again before the last attempt
+		for _, outbox := range outboxes {
+			_self.setOutcome(ctx, outbox, fmt.Errorf("queue %s does not exist", queue), now)
+		}
+		return
+	}
+	messages := make([]mq.Message, 0, len(outboxes))
+	for _, outbox := range outboxes {
+		messages = append(messages, mq.Message{Key: outbox.Key, Value: json.RawMessage(outbox.Payload)})
+	}
+	err := _self.producers.PublishBatch(ctx, topic, messages)
+	var writeErrors kafka.WriteErrors
+	partial := errors.As(err, &writeErrors) && len(writeErrors) == len(outboxes)
+	for i, outbox := range outboxes {
+		if partial {
+			_self.setOutcome(ctx, outbox, writeErrors[i], now)
+		} else {
+			_self.setOutcome(ctx, outbox, err, now)
+		}
+	}
+}
+
+func (_self *OutboxRelay) setOutcome(ctx context.Context, outbox *domain.Outbox, err error, now time.Time) {
+	outbox.Attempts++
+	if err == nil {
+		outbox.Status = domain.OutboxStatusSent
+		outbox.SentAt = &now
+		return
+	}
+	logging.Errorf(ctx, "publish outbox %d to queue %s failed (attempt %d): %s", outbox.Id, outbox.Topic, outbox.Attempts, err)
+	outbox.LastError = err.Error()
+	if outbox.Attempts >= _self.conf.Outbox.MaxAttempts {
+		outbox.Status = domain.OutboxStatusFailed
+		return
+	}
+	outbox.NextAttemptAt = now.Add(_self.conf.Outbox.RetryDelay * time.Duration(outbox.Attempts))
+}
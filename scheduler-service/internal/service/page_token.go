@@ -0,0 +1,120 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type orderKind int
+
+const (
+	orderKindInt orderKind = iota
+	orderKindTime
+	orderKindString
+)
+
+// eventOrderColumns are the fields ListSchedulerEvents can be sorted by, each one is indexed with id
+var eventOrderColumns = map[string]orderKind{
+	"id":           orderKindInt,
+	"created_at":   orderKindTime,
+	"updated_at":   orderKindTime,
+	"scheduler_at": orderKindInt,
+	"domain":       orderKindString,
+	"queue":        orderKindString,
+}
+
+// pageToken is the position after the last row of a page. The client gets it base64 encoded
+// and must send it back with the same order_by.
+type pageToken struct {
+	OrderBy string `json:"o"`
+	Value   string `json:"v"`
+	Id      int64  `json:"i"`
+}
+
+// parseOrderBy validates "<field> [asc|desc]" and returns the column and direction
+func parseOrderBy(orderBy string) (string, bool, error) {
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) == 0 {
+		return "id", false, nil
+	}
+	if len(parts) > 2 {
+		return "", false, status.Errorf(codes.InvalidArgument, "invalid order_by %q", orderBy)
+	}
+	if _, ok := eventOrderColumns[parts[0]]; !ok {
+		return "", false, status.Errorf(codes.InvalidArgument, "events cannot be ordered by %q", parts[0])
+	}
+	desc := false
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return "", false, status.Errorf(codes.InvalidArgument, "invalid order direction %q", parts[1])
+		}
+	}
+	return parts[0], desc, nil
+}
+
+func encodePageToken(orderBy, column string, last *domain.SchedulerEvent) string {
+	token := pageToken{
+		OrderBy: orderBy,
+		Id:      last.Id,
+	}
+	switch column {
+	case "created_at":
+		token.Value = last.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		token.Value = last.UpdatedAt.Format(time.RFC3339Nano)
+	case "scheduler_at":
+		token.Value = strconv.FormatInt(last.SchedulerAt, 10)
+	case "domain":
+		token.Value = last.Domain
+	case "queue":
+		token.Value = last.Queue
+	}
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(value, orderBy, column string) (*repository.SchedulerEventCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+	if token.OrderBy != orderBy {
+		return nil, status.Errorf(codes.InvalidArgument, "page_token was issued for another order_by")
+	}
+	cursor := &repository.SchedulerEventCursor{Id: token.Id}
+	switch eventOrderColumns[column] {
+	case orderKindTime:
+		cursor.Value, err = time.Parse(time.RFC3339Nano, token.Value)
+	case orderKindInt:
+		if column != "id" {
+			cursor.Value, err = strconv.ParseInt(token.Value, 10, 64)
+		}
+	case orderKindString:
+		cursor.Value = token.Value
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/configs"
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"github.com/namnv2496/scheduler/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// defaults of the settings left at zero
+const (
+	defaultQueueWeight         = 1
+	defaultQueueRateLimit      = 10
+	defaultQueueMaxConcurrency = 1
+)
+
+type IQueueService interface {
+	CreateQueue(ctx context.Context, queue *entity.Queue) (*entity.Queue, error)
+	GetQueue(ctx context.Context, name string) (*entity.Queue, error)
+	ListQueues(ctx context.Context) ([]*entity.Queue, error)
+	// UpdateQueue replaces the settings of the queue, the topic of a queue cannot change
+	UpdateQueue(ctx context.Context, name string, queue *entity.Queue) (*entity.Queue, error)
+	// DeleteQueue removes a queue without events, the deleted events count until they are purged
+	DeleteQueue(ctx context.Context, name string) error
+}
+
+type QueueService struct {
+	conf      *configs.Config
+	queueRepo repository.IQueueRepository
+	eventRepo repository.ISchedulerEventRepository
+}
+
+func NewQueueService(
+	conf *configs.Config,
+	queueRepo repository.IQueueRepository,
+	eventRepo repository.ISchedulerEventRepository,
+) *QueueService {
+	return &QueueService{
+		conf:      conf,
+		queueRepo: queueRepo,
+		eventRepo: eventRepo,
+	}
+}
+
+func (_self *QueueService) CreateQueue(ctx context.Context, queue *entity.Queue) (*entity.Queue, error) {
+	ctx = logging.AppendPrefix(ctx, "CreateQueue")
+	if queue.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name of the queue is required")
+	}
+	_, err := _self.queueRepo.GetQueueByName(ctx, queue.Name)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "queue %s already exists", queue.Name)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	request := &domain.Queue{
+		Name:      queue.Name,
+		Topic:     queue.Topic,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if request.Topic == "" {
+		request.Topic = queue.Name
+	}
+	if err := _self.checkTopic(request.Topic); err != nil {
+		return nil, err
+	}
+	used, err := _self.queueRepo.GetQueueByTopic(ctx, request.Topic)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "topic %s is used by queue %s", request.Topic, used.Name)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	applyQueueSettings(request, queue)
+	if err := _self.queueRepo.InsertOnce(ctx, request); err != nil {
+		// a queue created meanwhile with the same name or topic
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "queue %s or topic %s already exists", request.Name, request.Topic)
+		}
+		return nil, err
+	}
+	logging.Infof(ctx, "queue %s is created on topic %s", request.Name, request.Topic)
+	return toQueue(request)
+}
+
+func (_self *QueueService) GetQueue(ctx context.Context, name string) (*entity.Queue, error) {
+	queue, err := _self.getQueue(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return toQueue(queue)
+}
+
+func (_self *QueueService) ListQueues(ctx context.Context) ([]*entity.Queue, error) {
+	queues, err := _self.queueRepo.GetQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*entity.Queue, 0, len(queues))
+	for _, queue := range queues {
+		elem, err := toQueue(queue)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, elem)
+	}
+	return resp, nil
+}
+
+func (_self *QueueService) UpdateQueue(ctx context.Context, name string, queue *entity.Queue) (*entity.Queue, error) {
+	ctx = logging.AppendPrefix(ctx, "UpdateQueue")
+	var updated *domain.Queue
+	err := _self.queueRepo.RunWithTransaction(ctx, "UpdateQueue",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			current, err := _self.getQueue(ctx, name, repository.WithTx(tx), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			// the messages of the old topic would be left behind, a new queue moves the events instead
+			if queue.Topic != "" && queue.Topic != current.Topic {
+				return false, status.Errorf(codes.FailedPrecondition, "topic of queue %s cannot change from %s", name, current.Topic)
+			}
+			if err := _self.checkTopic(current.Topic); err != nil {
+				return false, err
+			}
+			applyQueueSettings(current, queue)
+			current.UpdatedAt = time.Now()
+			if err := _self.queueRepo.UpdateOnce(ctx, current, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			updated = current
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	logging.Infof(ctx, "queue %s is updated: weight %d, rate limit %d, max concurrency %d",
+		updated.Name, updated.Weight, updated.RateLimit, updated.MaxConcurrency)
+	return toQueue(updated)
+}
+
+func (_self *QueueService) DeleteQueue(ctx context.Context, name string) error {
+	ctx = logging.AppendPrefix(ctx, "DeleteQueue")
+	err := _self.queueRepo.RunWithTransaction(ctx, "DeleteQueue",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			// the events being written share lock their queue, the row lock waits for them and counts them
+			queue, err := _self.getQueue(ctx, name, repository.WithTx(tx), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			// the deleted events are counted as well, a restored event needs its queue
+			count, err := _self.eventRepo.CountSchedulerEventsByQueue(ctx, name, repository.WithTx(tx), repository.WithUnscoped())
+			if err != nil {
+				return false, err
+			}
+			if count > 0 {
+				return false, status.Errorf(codes.FailedPrecondition, "queue %s has %d events, move or purge them first", name, count)
+			}
+			if err := _self.queueRepo.DeleteById(ctx, &domain.Queue{Id: queue.Id}, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	logging.Infof(ctx, "queue %s is deleted", name)
+	return nil
+}
+
+// checkTopic refuses the dead-letter topic, its messages are mirrored into dead_letters and are not crawls
+func (_self *QueueService) checkTopic(topic string) error {
+	if topic == _self.conf.DeadLetter.Topic {
+		return status.Errorf(codes.InvalidArgument, "topic %s is the dead-letter topic", topic)
+	}
+	return nil
+}
+
+func (_self *QueueService) getQueue(ctx context.Context, name string, opts ...repository.QueryOptionFunc) (*domain.Queue, error) {
+	queue, err := _self.queueRepo.GetQueueByName(ctx, name, opts...)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "queue %s is not found", name)
+	}
+	return queue, err
+}
+
+// isUniqueViolation is the error of Postgres for a violated unique index (23505)
+func isUniqueViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+	return errors.As(err, &pgErr) && pgErr.SQLState() == "23505"
+}
+
+// applyQueueSettings copies the settings of queue to target, the zero settings take the defaults
+func applyQueueSettings(target *domain.Queue, queue *entity.Queue) {
+	target.Weight = defaultIfZero(queue.Weight, defaultQueueWeight)
+	target.RateLimit = defaultIfZero(queue.RateLimit, defaultQueueRateLimit)
+	target.MaxConcurrency = defaultIfZero(queue.MaxConcurrency, defaultQueueMaxConcurrency)
+}
+
+func defaultIfZero(value, defaultValue int) int {
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func toQueue(queue *domain.Queue) (*entity.Queue, error) {
+	var resp entity.Queue
+	if err := utils.Copy(&resp, queue); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// getEventQueue returns the queue of an event, an event cannot use a queue which does not exist
+func getEventQueue(ctx context.Context, queueRepo repository.IQueueRepository, name string, opts ...repository.QueryOptionFunc) (*domain.Queue, error) {
+	queue, err := queueRepo.GetQueueByName(ctx, name, opts...)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.InvalidArgument, "queue %s does not exist", name)
+	}
+	return queue, err
+}
@@ -2,32 +2,81 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/namnv2496/scheduler/internal/configs"
 	"github.com/namnv2496/scheduler/internal/domain"
 	"github.com/namnv2496/scheduler/internal/entity"
 	"github.com/namnv2496/scheduler/internal/repository"
 	"github.com/namnv2496/scheduler/internal/repository/cache"
+	"github.com/namnv2496/scheduler/pkg/logging"
 	"github.com/namnv2496/scheduler/pkg/utils"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type ISchedulerEventService interface {
 	CreateSchedulerEvent(ctx context.Context, SchedulerEvent *entity.SchedulerEvent) (int64, error)
 	GetSchedulerEvents(ctx context.Context, limit, offset int32) ([]*entity.SchedulerEvent, error)
-	UpdateSchedulerEvent(ctx context.Context, id int64, SchedulerEvent *entity.SchedulerEvent) error
+	GetSchedulerEvent(ctx context.Context, id int64) (*entity.SchedulerEvent, error)
+	// ListSchedulerEvents returns a page of events and the token of the next page, empty on the last page
+	ListSchedulerEvents(ctx context.Context, filter entity.SchedulerEventFilter) ([]*entity.SchedulerEvent, string, error)
+	// UpdateSchedulerEvent replaces the fields of the event, version 0 skips the version check
+	UpdateSchedulerEvent(ctx context.Context, id int64, SchedulerEvent *entity.SchedulerEvent, version int64) (*entity.SchedulerEvent, error)
+	// PatchSchedulerEvent writes only the fields of the event named by paths, version 0 skips the version check
+	PatchSchedulerEvent(ctx context.Context, id int64, SchedulerEvent *entity.SchedulerEvent, paths []string, version int64) (*entity.SchedulerEvent, error)
 	UpdateEventStatus(ctx context.Context, id int64, status domain.StatusEnum) error
+	// BulkSaveSchedulerEvents creates the rows, or updates the rows with an id when upsert is set.
+	// Every row is saved on its own and gets its result, nothing is written on dry run.
+	BulkSaveSchedulerEvents(ctx context.Context, rows []entity.BulkEventRow, upsert, dryRun bool) []entity.BulkEventResult
+	// ExportSchedulerEvents encodes every event in format and passes the document to write chunk by chunk
+	ExportSchedulerEvents(ctx context.Context, format string, write func(contentType string, chunk []byte) error) error
+	// DeleteSchedulerEvent soft deletes the event and cancels its dispatches which are not finished
+	DeleteSchedulerEvent(ctx context.Context, id int64) error
+	RestoreSchedulerEvent(ctx context.Context, id int64) error
+	PurgeSchedulerEvent(ctx context.Context, id int64) error
+	// RunNow dispatches the event immediately, the schedule is not changed
+	RunNow(ctx context.Context, id int64) (string, error)
+	Pause(ctx context.Context, id int64) error
+	Resume(ctx context.Context, id int64) error
+	// SkipNext moves the event to its following slot and returns the skipped slot
+	SkipNext(ctx context.Context, id int64) (skippedAt int64, schedulerAt int64, err error)
+	// Backfill dispatches the past slots in [from, to] which have no run yet
+	Backfill(ctx context.Context, id int64, from, to int64) (runIds []string, skipped int, err error)
 }
 
 type SchedulerEventService struct {
-	repo  repository.ISchedulerEventRepository
-	cache cache.ICache[entity.SchedulerEvent]
+	conf         *configs.Config
+	repo         repository.ISchedulerEventRepository
+	eventRunRepo repository.IEventRunRepository
+	outboxRepo   repository.IOutboxRepository
+	workflowSvc  IWorkflowService
+	tenantRepo   repository.ITenantRepository
+	queueRepo    repository.IQueueRepository
+	cache        cache.ICache[entity.SchedulerEvent]
 }
 
 func NewSchedulerEventService(
+	conf *configs.Config,
 	repo repository.ISchedulerEventRepository,
+	eventRunRepo repository.IEventRunRepository,
+	outboxRepo repository.IOutboxRepository,
+	workflowSvc IWorkflowService,
+	tenantRepo repository.ITenantRepository,
+	queueRepo repository.IQueueRepository,
 ) *SchedulerEventService {
 	return &SchedulerEventService{
-		repo: repo,
+		conf:         conf,
+		repo:         repo,
+		eventRunRepo: eventRunRepo,
+		outboxRepo:   outboxRepo,
+		workflowSvc:  workflowSvc,
+		tenantRepo:   tenantRepo,
+		queueRepo:    queueRepo,
 	}
 }
 
@@ -37,7 +86,19 @@ func (_self *SchedulerEventService) CreateSchedulerEvent(ctx context.Context, Sc
 	if err != nil {
 		return 0, err
 	}
-	id, err := _self.repo.CreateSchedulerEvent(ctx, &request)
+	request.Team = ownerTeam(ctx, request.Team)
+	var id int64
+	err = _self.repo.RunWithTransaction(ctx, "CreateSchedulerEvent",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if err := checkEventQuota(ctx, tx, _self.tenantRepo, _self.queueRepo, _self.repo, nil, &request); err != nil {
+				return false, err
+			}
+			if id, err = _self.repo.CreateSchedulerEvent(ctx, &request, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -59,7 +120,7 @@ func (_self *SchedulerEventService) GetSchedulerEvents(ctx context.Context, limi
 		}
 		return resp, nil
 	}
-	urls, err := _self.repo.GetSchedulerEvents(ctx, limit, offset)
+	urls, err := _self.repo.GetSchedulerEvents(ctx, limit, offset, teamScope(ctx)...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,38 +135,632 @@ func (_self *SchedulerEventService) GetSchedulerEvents(ctx context.Context, limi
 	return resp, nil
 }
 
-func (_self *SchedulerEventService) UpdateSchedulerEvent(ctx context.Context, id int64, SchedulerEvent *entity.SchedulerEvent) error {
-	existingUrl, err := _self.repo.GetSchedulerEventByID(ctx, id)
+func (_self *SchedulerEventService) GetSchedulerEvent(ctx context.Context, id int64) (*entity.SchedulerEvent, error) {
+	event, err := _self.getEvent(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var resp entity.SchedulerEvent
+	if err := utils.Copy(&resp, event); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (_self *SchedulerEventService) ListSchedulerEvents(ctx context.Context, filter entity.SchedulerEventFilter) ([]*entity.SchedulerEvent, string, error) {
+	column, desc, err := parseOrderBy(filter.OrderBy)
+	if err != nil {
+		return nil, "", err
+	}
+	orderBy := column + " asc"
+	if desc {
+		orderBy = column + " desc"
+	}
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	query := repository.SchedulerEventQuery{
+		Domain:      filter.Domain,
+		Queue:       filter.Queue,
+		Status:      filter.Status,
+		Method:      filter.Method,
+		IsActive:    filter.IsActive,
+		CreatedFrom: filter.CreatedFrom,
+		CreatedTo:   filter.CreatedTo,
+		UpdatedFrom: filter.UpdatedFrom,
+		UpdatedTo:   filter.UpdatedTo,
+		Search:      filter.Search,
+		OrderBy:     column,
+		Desc:        desc,
+		// one more row tells whether there is a next page
+		Limit: pageSize + 1,
+	}
+	if filter.PageToken != "" {
+		if query.After, err = decodePageToken(filter.PageToken, orderBy, column); err != nil {
+			return nil, "", err
+		}
+	}
+	events, err := _self.repo.ListSchedulerEvents(ctx, query, teamScope(ctx)...)
+	if err != nil {
+		return nil, "", err
+	}
+	var nextPageToken string
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		nextPageToken = encodePageToken(orderBy, column, events[len(events)-1])
+	}
+	resp := make([]*entity.SchedulerEvent, 0, len(events))
+	for _, event := range events {
+		var elem entity.SchedulerEvent
+		if err := utils.Copy(&elem, event); err != nil {
+			return nil, "", err
+		}
+		resp = append(resp, &elem)
+	}
+	return resp, nextPageToken, nil
+}
+
+func (_self *SchedulerEventService) UpdateSchedulerEvent(ctx context.Context, id int64, SchedulerEvent *entity.SchedulerEvent, version int64) (*entity.SchedulerEvent, error) {
+	ctx = logging.AppendPrefix(ctx, "UpdateSchedulerEvent")
+	return _self.writeSchedulerEvent(ctx, id, version, eventColumns(SchedulerEvent))
+}
+
+func (_self *SchedulerEventService) PatchSchedulerEvent(ctx context.Context, id int64, SchedulerEvent *entity.SchedulerEvent, paths []string, version int64) (*entity.SchedulerEvent, error) {
+	ctx = logging.AppendPrefix(ctx, "PatchSchedulerEvent")
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is empty")
+	}
+	columns := eventColumns(SchedulerEvent)
+	fields := make(map[string]any, len(paths))
+	for _, path := range paths {
+		value, ok := columns[path]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field %s cannot be patched", path)
+		}
+		fields[path] = value
+	}
+	return _self.writeSchedulerEvent(ctx, id, version, fields)
+}
+
+// writeSchedulerEvent writes fields to the locked event when it is still at version
+func (_self *SchedulerEventService) writeSchedulerEvent(ctx context.Context, id, version int64, fields map[string]any) (*entity.SchedulerEvent, error) {
+	var updated *domain.SchedulerEvent
+	err := _self.repo.RunWithTransaction(ctx, "WriteSchedulerEvent",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			event, err := _self.getEvent(ctx, id, repository.WithTx(tx), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			if version > 0 && event.Version != version {
+				return false, status.Errorf(codes.Aborted, "event %d is at version %d, not %d", id, event.Version, version)
+			}
+			next := *event
+			if isActive, ok := fields["is_active"].(bool); ok {
+				next.IsActive = isActive
+			}
+			if queue, ok := fields["queue"].(string); ok {
+				next.Queue = queue
+			}
+			if err := checkEventQuota(ctx, tx, _self.tenantRepo, _self.queueRepo, _self.repo, event, &next); err != nil {
+				return false, err
+			}
+			fields, err := rescheduleFields(event, fields)
+			if err != nil {
+				return false, err
+			}
+			if err := _self.repo.UpdateSchedulerEventFields(ctx, id, event.Version, fields, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			if updated, err = _self.getEvent(ctx, id, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	logging.Infof(ctx, "event %d is written at version %d", id, updated.Version)
+	var resp entity.SchedulerEvent
+	if err := utils.Copy(&resp, updated); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// rescheduleFields moves scheduler_at to the next run of a new cron_exp when the write does not set it
+func rescheduleFields(event *domain.SchedulerEvent, fields map[string]any) (map[string]any, error) {
+	cronExp, ok := fields["cron_exp"].(string)
+	if !ok || cronExp == "" || cronExp == event.CronExp {
+		return fields, nil
+	}
+	if _, ok := fields["scheduler_at"]; ok {
+		return fields, nil
 	}
-	existingUrl.Url = SchedulerEvent.Url
-	existingUrl.Description = SchedulerEvent.Description
-	existingUrl.Queue = SchedulerEvent.Queue
-	existingUrl.IsActive = SchedulerEvent.IsActive
-	existingUrl.Method = SchedulerEvent.Method
-	existingUrl.CronExp = SchedulerEvent.CronExp
-	existingUrl.NextRunTime = SchedulerEvent.NextRunTime
-	existingUrl.RepeatTimes = SchedulerEvent.RepeatTimes
-	existingUrl.SchedulerAt = SchedulerEvent.SchedulerAt
+	schedule, err := cron.ParseStandard(cronExp)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cron_exp %q: %v", cronExp, err)
+	}
+	rescheduled := make(map[string]any, len(fields)+1)
+	for column, value := range fields {
+		rescheduled[column] = value
+	}
+	rescheduled["scheduler_at"] = schedule.Next(time.Now()).UnixMilli()
+	return rescheduled, nil
+}
+
+// eventColumns are the columns written by clients, status and version belong to the scheduler
+func eventColumns(event *entity.SchedulerEvent) map[string]any {
+	return map[string]any{
+		"name":          event.Name,
+		"url":           event.Url,
+		"method":        event.Method,
+		"description":   event.Description,
+		"queue":         event.Queue,
+		"domain":        event.Domain,
+		"is_active":     event.IsActive,
+		"next_run_time": event.NextRunTime,
+		"repeat_times":  event.RepeatTimes,
+		"scheduler_at":  event.SchedulerAt,
+		"cron_exp":      event.CronExp,
+		"retry_policy":  event.RetryPolicy,
+	}
+}
+
+func (_self *SchedulerEventService) UpdateEventStatus(ctx context.Context, id int64, status domain.StatusEnum) error {
+	return _self.repo.UpdateSchedulerEventFields(ctx, id, 0, map[string]any{"status": status}, teamScope(ctx)...)
+}
 
-	err = _self.repo.UpdateSchedulerEvent(ctx, existingUrl)
+func (_self *SchedulerEventService) BulkSaveSchedulerEvents(ctx context.Context, rows []entity.BulkEventRow, upsert, dryRun bool) []entity.BulkEventResult {
+	ctx = logging.AppendPrefix(ctx, "BulkSaveSchedulerEvents")
+	results := make([]entity.BulkEventResult, len(rows))
+	for i, row := range rows {
+		result := entity.BulkEventResult{
+			Index: row.Index,
+			Id:    row.Event.Id,
+		}
+		saved, err := _self.saveSchedulerEvent(ctx, row.Event, upsert, dryRun)
+		if err != nil {
+			result.Status = entity.BulkStatusError
+			result.Error = err.Error()
+		} else {
+			result.Status = saved
+			result.Id = row.Event.Id
+		}
+		results[i] = result
+	}
+	return results
+}
+
+func (_self *SchedulerEventService) saveSchedulerEvent(ctx context.Context, event *entity.SchedulerEvent, upsert, dryRun bool) (string, error) {
+	if dryRun {
+		// the writes check the queue in their transaction, a dry run reports it as they would
+		if _, err := getEventQueue(ctx, _self.queueRepo, event.Queue); err != nil {
+			return "", err
+		}
+	}
+	if event.Id == 0 {
+		if dryRun {
+			return entity.BulkStatusValid, nil
+		}
+		id, err := _self.CreateSchedulerEvent(ctx, event)
+		if err != nil {
+			return "", err
+		}
+		event.Id = id
+		return entity.BulkStatusCreated, nil
+	}
+	if !upsert {
+		return "", fmt.Errorf("id must be empty to create an event")
+	}
+	_, err := _self.repo.GetSchedulerEventByID(ctx, event.Id, teamScope(ctx)...)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// the id may be held by a deleted event or by an event of another team
+		if err := _self.checkEventIdFree(ctx, event.Id); err != nil {
+			return "", err
+		}
+		// upsert keeps the id of the catalogue
+		if dryRun {
+			return entity.BulkStatusValid, nil
+		}
+		event.Version = 0
+		if _, err := _self.CreateSchedulerEvent(ctx, event); err != nil {
+			return "", err
+		}
+		return entity.BulkStatusCreated, nil
+	}
 	if err != nil {
+		return "", err
+	}
+	if dryRun {
+		return entity.BulkStatusValid, nil
+	}
+	if _, err := _self.UpdateSchedulerEvent(ctx, event.Id, event, event.Version); err != nil {
+		return "", err
+	}
+	return entity.BulkStatusUpdated, nil
+}
+
+// checkEventIdFree fails when a row the caller cannot update holds the id
+func (_self *SchedulerEventService) checkEventIdFree(ctx context.Context, id int64) error {
+	opts := append([]repository.QueryOptionFunc{repository.WithUnscoped()}, teamScope(ctx)...)
+	event, err := _self.repo.GetSchedulerEventByID(ctx, id, opts...)
+	if err == nil && event.DeletedAt.Valid {
+		return fmt.Errorf("event %d is deleted, restore it before the upsert", id)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
+	count, err := _self.repo.CountOnce(ctx, repository.WithUnscoped(), repository.WithCondition("id = ?", id))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("id %d is used by another event, leave the id empty to create it", id)
+	}
 	return nil
 }
 
-func (_self *SchedulerEventService) UpdateEventStatus(ctx context.Context, id int64, status domain.StatusEnum) error {
-	existingUrl, err := _self.repo.GetSchedulerEventByID(ctx, id)
+func (_self *SchedulerEventService) ExportSchedulerEvents(ctx context.Context, format string, write func(contentType string, chunk []byte) error) error {
+	encoder, err := newExportEncoder(format)
 	if err != nil {
 		return err
 	}
-	existingUrl.Status = status
+	emit := func(chunk []byte, err error) error {
+		if err != nil || len(chunk) == 0 {
+			return err
+		}
+		return write(encoder.ContentType(), chunk)
+	}
+	if err := emit(encoder.Begin()); err != nil {
+		return err
+	}
+	query := repository.SchedulerEventQuery{
+		OrderBy: "id",
+		Limit:   exportBatchSize,
+	}
+	for {
+		events, err := _self.repo.ListSchedulerEvents(ctx, query, teamScope(ctx)...)
+		if err != nil {
+			return err
+		}
+		records := make([]entity.SchedulerEventRecord, len(events))
+		for i, event := range events {
+			records[i] = toSchedulerEventRecord(event)
+		}
+		if err := emit(encoder.Batch(records)); err != nil {
+			return err
+		}
+		if len(events) < exportBatchSize {
+			break
+		}
+		query.After = &repository.SchedulerEventCursor{Id: events[len(events)-1].Id}
+	}
+	return emit(encoder.End())
+}
+
+func (_self *SchedulerEventService) DeleteSchedulerEvent(ctx context.Context, id int64) error {
+	ctx = logging.AppendPrefix(ctx, "DeleteSchedulerEvent")
+	return _self.removeSchedulerEvent(ctx, id, func(ctx context.Context, tx *gorm.DB) error {
+		if _, err := _self.getEvent(ctx, id, repository.WithTx(tx), repository.WithRowLock()); err != nil {
+			return err
+		}
+		return _self.repo.DeleteSchedulerEvent(ctx, id, repository.WithTx(tx))
+	})
+}
+
+func (_self *SchedulerEventService) RestoreSchedulerEvent(ctx context.Context, id int64) error {
+	return _self.repo.RunWithTransaction(ctx, "RestoreSchedulerEvent",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			event, err := _self.getEvent(ctx, id, repository.WithTx(tx), repository.WithUnscoped(), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			if !event.DeletedAt.Valid {
+				return false, status.Errorf(codes.NotFound, "deleted event %d is not found", id)
+			}
+			// a deleted event is not counted, an active one counts in its tenant again once restored
+			previous := *event
+			previous.IsActive = false
+			if err := checkEventQuota(ctx, tx, _self.tenantRepo, _self.queueRepo, _self.repo, &previous, event); err != nil {
+				return false, err
+			}
+			if err := _self.repo.RestoreSchedulerEvent(ctx, id, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+}
 
-	err = _self.repo.UpdateSchedulerEvent(ctx, existingUrl)
+func (_self *SchedulerEventService) PurgeSchedulerEvent(ctx context.Context, id int64) error {
+	ctx = logging.AppendPrefix(ctx, "PurgeSchedulerEvent")
+	return _self.removeSchedulerEvent(ctx, id, func(ctx context.Context, tx *gorm.DB) error {
+		if _, err := _self.getEvent(ctx, id, repository.WithTx(tx), repository.WithUnscoped(), repository.WithRowLock()); err != nil {
+			return err
+		}
+		return _self.repo.PurgeSchedulerEvent(ctx, id, repository.WithTx(tx))
+	})
+}
+
+// removeSchedulerEvent runs remove with the cancellation of the outbox messages and runs of the event,
+// then lets the workflows of the cancelled runs fail
+func (_self *SchedulerEventService) removeSchedulerEvent(ctx context.Context, id int64, remove func(ctx context.Context, tx *gorm.DB) error) error {
+	workflowRunIds := make([]int64, 0)
+	err := _self.repo.RunWithTransaction(ctx, "RemoveSchedulerEvent",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if err := remove(ctx, tx); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if err := _self.outboxRepo.CancelOutboxesByEventId(ctx, id, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			runs, err := _self.eventRunRepo.GetUnfinishedEventRunsByEventId(ctx, id, repository.WithTx(tx))
+			if err != nil {
+				return false, err
+			}
+			finishedAt := time.Now()
+			for _, run := range runs {
+				run.Status = domain.StatusCancelled
+				run.Error = "event is deleted"
+				run.FinishedAt = &finishedAt
+				if err := _self.eventRunRepo.UpdateEventRun(ctx, run, repository.WithTx(tx)); err != nil {
+					return false, err
+				}
+				if run.WorkflowRunId > 0 {
+					workflowRunIds = append(workflowRunIds, run.WorkflowRunId)
+				}
+			}
+			return true, nil
+		},
+	)
 	if err != nil {
 		return err
 	}
+	for _, workflowRunId := range workflowRunIds {
+		if err := _self.workflowSvc.AdvanceWorkflowRun(ctx, workflowRunId); err != nil {
+			logging.Errorf(ctx, "advance workflow run %d failed: %s", workflowRunId, err)
+		}
+	}
 	return nil
 }
+
+func (_self *SchedulerEventService) RunNow(ctx context.Context, id int64) (string, error) {
+	ctx = logging.AppendPrefix(ctx, "RunNow")
+	event, err := _self.getActiveEvent(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	run := &domain.EventRun{
+		RunId:       entity.BuildManualRunId(id, now.UnixMilli()),
+		EventId:     id,
+		Trigger:     domain.TriggerManual,
+		Status:      domain.StatusRunning,
+		ScheduledAt: now.UnixMilli(),
+		StartedAt:   &now,
+	}
+	if err := _self.dispatchRuns(ctx, event, []*domain.EventRun{run}); err != nil {
+		return "", err
+	}
+	logging.Infof(ctx, "event %d is dispatched manually, run %s", id, run.RunId)
+	return run.RunId, nil
+}
+
+func (_self *SchedulerEventService) Pause(ctx context.Context, id int64) error {
+	return _self.changeSchedule(ctx, id, domain.TriggerPause, func(event *domain.SchedulerEvent) error {
+		if !event.IsActive {
+			return status.Errorf(codes.FailedPrecondition, "event %d is already paused", id)
+		}
+		event.IsActive = false
+		return nil
+	})
+}
+
+func (_self *SchedulerEventService) Resume(ctx context.Context, id int64) error {
+	return _self.changeSchedule(ctx, id, domain.TriggerResume, func(event *domain.SchedulerEvent) error {
+		if event.IsActive {
+			return status.Errorf(codes.FailedPrecondition, "event %d is not paused", id)
+		}
+		event.IsActive = true
+		// slots missed while paused are not fired, use Backfill to run them
+		now := time.Now().UnixMilli()
+		if event.NextRunTime > 0 && event.SchedulerAt < now {
+			event.SchedulerAt += (now - event.SchedulerAt + event.NextRunTime - 1) / event.NextRunTime * event.NextRunTime
+		}
+		return nil
+	})
+}
+
+func (_self *SchedulerEventService) SkipNext(ctx context.Context, id int64) (int64, int64, error) {
+	var skippedAt int64
+	var schedulerAt int64
+	err := _self.changeSchedule(ctx, id, domain.TriggerSkip, func(event *domain.SchedulerEvent) error {
+		if event.NextRunTime <= 0 {
+			return status.Errorf(codes.FailedPrecondition, "event %d has no next run", id)
+		}
+		skippedAt = event.SchedulerAt
+		event.SchedulerAt += event.NextRunTime
+		schedulerAt = event.SchedulerAt
+		return nil
+	})
+	return skippedAt, schedulerAt, err
+}
+
+func (_self *SchedulerEventService) Backfill(ctx context.Context, id int64, from, to int64) ([]string, int, error) {
+	ctx = logging.AppendPrefix(ctx, "Backfill")
+	if from > to {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "from must not be after to")
+	}
+	if to > time.Now().UnixMilli() {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "to must not be in the future")
+	}
+	event, err := _self.getActiveEvent(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if event.NextRunTime <= 0 {
+		return nil, 0, status.Errorf(codes.FailedPrecondition, "event %d has no interval to backfill", id)
+	}
+
+	// slots are SchedulerAt + k * NextRunTime for any integer k
+	first := event.SchedulerAt + floorDiv(from-event.SchedulerAt+event.NextRunTime-1, event.NextRunTime)*event.NextRunTime
+	runIds := make([]string, 0)
+	slots := make(map[string]int64)
+	for slot := first; slot <= to; slot += event.NextRunTime {
+		if len(runIds) == _self.conf.Backfill.MaxSlots {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "backfill is limited to %d slots", _self.conf.Backfill.MaxSlots)
+		}
+		runId := entity.BuildRunId(id, slot)
+		runIds = append(runIds, runId)
+		slots[runId] = slot
+	}
+
+	existing, err := _self.eventRunRepo.GetEventRunsByRunIds(ctx, runIds)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, run := range existing {
+		delete(slots, run.RunId)
+	}
+	now := time.Now()
+	runs := make([]*domain.EventRun, 0, len(slots))
+	dispatched := make([]string, 0, len(slots))
+	for _, runId := range runIds {
+		slot, ok := slots[runId]
+		if !ok {
+			continue
+		}
+		runs = append(runs, &domain.EventRun{
+			RunId:       runId,
+			EventId:     id,
+			Trigger:     domain.TriggerBackfill,
+			Status:      domain.StatusRunning,
+			ScheduledAt: slot,
+			StartedAt:   &now,
+		})
+		dispatched = append(dispatched, runId)
+	}
+	if err := _self.dispatchRuns(ctx, event, runs); err != nil {
+		return nil, 0, err
+	}
+	logging.Infof(ctx, "event %d backfilled %d slots, skipped %d", id, len(dispatched), len(existing))
+	return dispatched, len(existing), nil
+}
+
+// changeSchedule applies change to the locked event and records it in the run history
+func (_self *SchedulerEventService) changeSchedule(ctx context.Context, id int64, trigger domain.TriggerEnum, change func(event *domain.SchedulerEvent) error) error {
+	return _self.repo.RunWithTransaction(ctx, string(trigger),
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			event, err := _self.getEvent(ctx, id, repository.WithTx(tx), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			previous := *event
+			if err := change(event); err != nil {
+				return false, err
+			}
+			// a resumed event counts in the active events of its tenant again
+			if err := checkEventQuota(ctx, tx, _self.tenantRepo, _self.queueRepo, _self.repo, &previous, event); err != nil {
+				return false, err
+			}
+			fields := map[string]any{
+				"is_active":    event.IsActive,
+				"scheduler_at": event.SchedulerAt,
+			}
+			if err := _self.repo.UpdateSchedulerEventFields(ctx, id, event.Version, fields, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			now := time.Now()
+			runStatus := domain.StatusSuccessed
+			if trigger == domain.TriggerSkip {
+				runStatus = domain.StatusSkipped
+			}
+			run := &domain.EventRun{
+				RunId:       fmt.Sprintf("%s%d-%d", trigger, id, now.UnixMilli()),
+				EventId:     id,
+				Trigger:     trigger,
+				Status:      runStatus,
+				ScheduledAt: event.SchedulerAt,
+				StartedAt:   &now,
+				FinishedAt:  &now,
+			}
+			if err := _self.eventRunRepo.CreateEventRuns(ctx, []*domain.EventRun{run}, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+}
+
+// dispatchRuns saves the runs with their outbox messages, the relay publishes them
+func (_self *SchedulerEventService) dispatchRuns(ctx context.Context, event *domain.SchedulerEvent, runs []*domain.EventRun) error {
+	if len(runs) == 0 {
+		return nil
+	}
+	outboxes := make([]*domain.Outbox, len(runs))
+	for i, run := range runs {
+		outbox, err := buildCrawlerOutbox(event, run.RunId)
+		if err != nil {
+			return err
+		}
+		outboxes[i] = outbox
+	}
+	return _self.repo.RunWithTransaction(ctx, "DispatchRuns",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if err := _self.eventRunRepo.CreateEventRuns(ctx, runs, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if err := _self.outboxRepo.Inserts(ctx, outboxes, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+}
+
+// getEvent returns the event when it belongs to the team of the caller
+func (_self *SchedulerEventService) getEvent(ctx context.Context, id int64, opts ...repository.QueryOptionFunc) (*domain.SchedulerEvent, error) {
+	opts = append(opts, teamScope(ctx)...)
+	event, err := _self.repo.GetSchedulerEventByID(ctx, id, opts...)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "event %d is not found", id)
+		}
+		return nil, err
+	}
+	return event, nil
+}
+
+// getActiveEvent returns the event only when it can be dispatched, the crawler drops paused events
+func (_self *SchedulerEventService) getActiveEvent(ctx context.Context, id int64) (*domain.SchedulerEvent, error) {
+	event, err := _self.getEvent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "event %d is paused", id)
+	}
+	return event, nil
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
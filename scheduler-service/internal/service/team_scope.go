@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/namnv2496/scheduler/internal/auth"
+	"github.com/namnv2496/scheduler/internal/repository"
+)
+
+// teamScope limits the queries of a caller to the events of its team.
+// Admins and the jobs of the scheduler, which have no caller, see every team.
+func teamScope(ctx context.Context) []repository.QueryOptionFunc {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || !principal.IsScoped() {
+		return nil
+	}
+	return []repository.QueryOptionFunc{repository.WithCondition("team = ?", principal.Team)}
+}
+
+// ownerTeam is the team of a new event, only admins create events for another team
+func ownerTeam(ctx context.Context, team string) string {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || !principal.IsScoped() {
+		return team
+	}
+	return principal.Team
+}
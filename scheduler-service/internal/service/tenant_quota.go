@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// rate limit path of the hourly crawls of the tenants
+const tenantCrawlsPath = "tenant_crawls"
+
+// getTenant returns nil for the teams without a tenant, they have no quota
+func getTenant(ctx context.Context, tenantRepo repository.ITenantRepository, team string, opts ...repository.QueryOptionFunc) (*domain.Tenant, error) {
+	if team == "" {
+		return nil, nil
+	}
+	tenant, err := tenantRepo.GetTenantByName(ctx, team, opts...)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return tenant, err
+}
+
+// checkEventQuota rejects an event of the tenant over its active events or queue priority. previous is the
+// stored event, nil on create: the event is counted when it becomes active and its queue is checked when it is new.
+// It runs in tx with the tenant row locked, the concurrent writes of a tenant are counted one after the other,
+// and with the new queue share locked, the queue cannot be deleted before the event is written.
+func checkEventQuota(
+	ctx context.Context,
+	tx *gorm.DB,
+	tenantRepo repository.ITenantRepository,
+	queueRepo repository.IQueueRepository,
+	eventRepo repository.ISchedulerEventRepository,
+	previous, event *domain.SchedulerEvent,
+) error {
+	var queue *domain.Queue
+	if previous == nil || previous.Queue != event.Queue {
+		var err error
+		if queue, err = getEventQueue(ctx, queueRepo, event.Queue, repository.WithTx(tx), repository.WithShareLock()); err != nil {
+			return err
+		}
+	}
+	tenant, err := getTenant(ctx, tenantRepo, event.Team, repository.WithTx(tx), repository.WithRowLock())
+	if err != nil || tenant == nil {
+		return err
+	}
+	if queue != nil {
+		if priority := queue.Priority(); priority > tenant.MaxQueuePriority {
+			return quotaError(tenant, "max_queue_priority",
+				fmt.Sprintf("queue %s has priority %d, the tenant allows up to %d", event.Queue, priority, tenant.MaxQueuePriority))
+		}
+	}
+	becomesActive := event.IsActive && (previous == nil || !previous.IsActive)
+	if tenant.MaxActiveEvents <= 0 || !becomesActive {
+		return nil
+	}
+	count, err := eventRepo.CountActiveSchedulerEventsByTeam(ctx, tenant.Name, repository.WithTx(tx))
+	if err != nil {
+		return err
+	}
+	if count >= tenant.MaxActiveEvents {
+		return quotaError(tenant, "max_active_events",
+			fmt.Sprintf("tenant has %d active events, the quota is %d", count, tenant.MaxActiveEvents))
+	}
+	return nil
+}
+
+// quotaError is ResourceExhausted with the exceeded quota of the tenant as QuotaFailure details
+func quotaError(tenant *domain.Tenant, quota, description string) error {
+	st := status.Newf(codes.ResourceExhausted, "tenant %s is over its %s quota", tenant.Name, quota)
+	detailed, err := st.WithDetails(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     "tenant:" + tenant.Name,
+			Description: description,
+		}},
+	}, &errdetails.ErrorInfo{
+		Reason:   "TENANT_QUOTA_EXCEEDED",
+		Domain:   "scheduler",
+		Metadata: map[string]string{"tenant": tenant.Name, "quota": quota},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
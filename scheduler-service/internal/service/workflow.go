@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/domain"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type IWorkflowService interface {
+	CreateWorkflow(ctx context.Context, workflow *entity.Workflow) (int64, error)
+	GetWorkflow(ctx context.Context, id int64) (*entity.Workflow, error)
+	StartWorkflowRun(ctx context.Context, workflowId int64) (*entity.WorkflowRun, error)
+	GetWorkflowRun(ctx context.Context, id int64) (*entity.WorkflowRun, error)
+	CancelWorkflowRun(ctx context.Context, id int64) (*entity.WorkflowRun, error)
+	// AdvanceWorkflowRun dispatches the steps whose upstreams all succeeded and finishes the run
+	AdvanceWorkflowRun(ctx context.Context, workflowRunId int64) error
+}
+
+type WorkflowService struct {
+	workflowRepo    repository.IWorkflowRepository
+	workflowRunRepo repository.IWorkflowRunRepository
+	eventRunRepo    repository.IEventRunRepository
+	eventRepo       repository.ISchedulerEventRepository
+	outboxRepo      repository.IOutboxRepository
+}
+
+func NewWorkflowService(
+	workflowRepo repository.IWorkflowRepository,
+	workflowRunRepo repository.IWorkflowRunRepository,
+	eventRunRepo repository.IEventRunRepository,
+	eventRepo repository.ISchedulerEventRepository,
+	outboxRepo repository.IOutboxRepository,
+) *WorkflowService {
+	return &WorkflowService{
+		workflowRepo:    workflowRepo,
+		workflowRunRepo: workflowRunRepo,
+		eventRunRepo:    eventRunRepo,
+		eventRepo:       eventRepo,
+		outboxRepo:      outboxRepo,
+	}
+}
+
+func (_self *WorkflowService) CreateWorkflow(ctx context.Context, workflow *entity.Workflow) (int64, error) {
+	if err := validateWorkflowSteps(workflow.Steps); err != nil {
+		return 0, err
+	}
+	// the workflow belongs to the team of its events, its runs are scoped to that team
+	team := ""
+	for i, step := range workflow.Steps {
+		event, err := _self.getStepEvent(ctx, step.EventId)
+		if err != nil {
+			return 0, err
+		}
+		if i > 0 && event.Team != team {
+			return 0, status.Errorf(codes.InvalidArgument, "events of a workflow must belong to one team, event %d belongs to %q", event.Id, event.Team)
+		}
+		team = event.Team
+	}
+	steps := make([]*domain.WorkflowStep, len(workflow.Steps))
+	for i, step := range workflow.Steps {
+		steps[i] = &domain.WorkflowStep{
+			EventId:   step.EventId,
+			DependsOn: domain.FormatIds(step.DependsOn),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+	}
+	return _self.workflowRepo.CreateWorkflow(ctx, &domain.Workflow{
+		Name:        workflow.Name,
+		Description: workflow.Description,
+		Team:        team,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}, steps)
+}
+
+// GetWorkflow returns the workflow, a caller does not see the workflows of the other teams
+func (_self *WorkflowService) GetWorkflow(ctx context.Context, id int64) (*entity.Workflow, error) {
+	workflow, err := _self.workflowRepo.GetWorkflowByID(ctx, id, teamScope(ctx)...)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "workflow %d is not found", id)
+		}
+		return nil, err
+	}
+	steps, err := _self.workflowRepo.GetWorkflowSteps(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &entity.Workflow{
+		Id:          workflow.Id,
+		Name:        workflow.Name,
+		Description: workflow.Description,
+		Team:        workflow.Team,
+		CreatedAt:   workflow.CreatedAt,
+		UpdatedAt:   workflow.UpdatedAt,
+	}
+	for _, step := range steps {
+		resp.Steps = append(resp.Steps, entity.WorkflowStep{
+			EventId:   step.EventId,
+			DependsOn: domain.ParseIds(step.DependsOn),
+		})
+	}
+	return resp, nil
+}
+
+func (_self *WorkflowService) StartWorkflowRun(ctx context.Context, workflowId int64) (*entity.WorkflowRun, error) {
+	ctx = logging.AppendPrefix(ctx, "StartWorkflowRun")
+	workflow, err := _self.GetWorkflow(ctx, workflowId)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range workflow.Steps {
+		event, err := _self.getStepEvent(ctx, step.EventId)
+		if err != nil {
+			return nil, err
+		}
+		if !event.IsActive {
+			// the crawler skips inactive events, the run would never finish
+			return nil, status.Errorf(codes.FailedPrecondition, "event %d of the workflow is not active", step.EventId)
+		}
+	}
+
+	run := &domain.WorkflowRun{
+		WorkflowId: workflowId,
+		Status:     domain.StatusRunning,
+		Team:       workflow.Team,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	err = _self.workflowRunRepo.RunWithTransaction(ctx, "StartWorkflowRun",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			if _, err := _self.workflowRunRepo.CreateWorkflowRun(ctx, run, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			eventRuns := make([]*domain.EventRun, len(workflow.Steps))
+			for i, step := range workflow.Steps {
+				eventRuns[i] = &domain.EventRun{
+					RunId:         entity.BuildWorkflowRunId(run.Id, step.EventId),
+					EventId:       step.EventId,
+					WorkflowRunId: run.Id,
+					Trigger:       domain.TriggerWorkflow,
+					Status:        domain.StatusPending,
+					ScheduledAt:   time.Now().UnixMilli(),
+					DependsOn:     domain.FormatIds(step.DependsOn),
+					CreatedAt:     time.Now(),
+					UpdatedAt:     time.Now(),
+				}
+			}
+			if err := _self.eventRunRepo.CreateEventRuns(ctx, eventRuns, repository.WithTx(tx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			// dispatch the roots of the DAG
+			if err := _self.advance(ctx, tx, run); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	logging.Infof(ctx, "workflow %d started run %d", workflowId, run.Id)
+	return _self.GetWorkflowRun(ctx, run.Id)
+}
+
+// GetWorkflowRun returns the run with its steps, a caller does not see the runs of the other teams
+func (_self *WorkflowService) GetWorkflowRun(ctx context.Context, id int64) (*entity.WorkflowRun, error) {
+	run, err := _self.workflowRunRepo.GetWorkflowRunByID(ctx, id, teamScope(ctx)...)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "workflow run %d is not found", id)
+		}
+		return nil, err
+	}
+	eventRuns, err := _self.eventRunRepo.GetEventRunsByWorkflowRunId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &entity.WorkflowRun{
+		Id:         run.Id,
+		WorkflowId: run.WorkflowId,
+		Status:     run.Status,
+		CreatedAt:  run.CreatedAt,
+		UpdatedAt:  run.UpdatedAt,
+		FinishedAt: run.FinishedAt,
+	}
+	for _, eventRun := range eventRuns {
+		resp.Steps = append(resp.Steps, entity.WorkflowRunStep{
+			EventId:    eventRun.EventId,
+			RunId:      eventRun.RunId,
+			DependsOn:  domain.ParseIds(eventRun.DependsOn),
+			Status:     eventRun.Status,
+			StartedAt:  eventRun.StartedAt,
+			FinishedAt: eventRun.FinishedAt,
+			Error:      eventRun.Error,
+		})
+	}
+	return resp, nil
+}
+
+func (_self *WorkflowService) CancelWorkflowRun(ctx context.Context, id int64) (*entity.WorkflowRun, error) {
+	err := _self.workflowRunRepo.RunWithTransaction(ctx, "CancelWorkflowRun",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			opts := append([]repository.QueryOptionFunc{repository.WithTx(tx), repository.WithRowLock()}, teamScope(ctx)...)
+			run, err := _self.workflowRunRepo.GetWorkflowRunByID(ctx, id, opts...)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return false, status.Errorf(codes.NotFound, "workflow run %d is not found", id)
+				}
+				return false, err
+			}
+			if run.Status != domain.StatusRunning {
+				return false, status.Errorf(codes.FailedPrecondition, "workflow run %d is already %s", id, run.Status)
+			}
+			if err := _self.finish(ctx, tx, run, domain.StatusCancelled); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return _self.GetWorkflowRun(ctx, id)
+}
+
+func (_self *WorkflowService) AdvanceWorkflowRun(ctx context.Context, workflowRunId int64) error {
+	return _self.workflowRunRepo.RunWithTransaction(ctx, "AdvanceWorkflowRun",
+		func(ctx context.Context, tx *gorm.DB) (isPass bool, err error) {
+			// the row lock serializes upstreams finishing at the same time, so a fan-in step is dispatched once
+			run, err := _self.workflowRunRepo.GetWorkflowRunByID(ctx, workflowRunId, repository.WithTx(tx), repository.WithRowLock())
+			if err != nil {
+				return false, err
+			}
+			if run.Status != domain.StatusRunning {
+				return true, nil
+			}
+			if err := _self.advance(ctx, tx, run); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+	)
+}
+
+// advance must run in the transaction holding the workflow run
+func (_self *WorkflowService) advance(ctx context.Context, tx *gorm.DB, run *domain.WorkflowRun) error {
+	eventRuns, err := _self.eventRunRepo.GetEventRunsByWorkflowRunId(ctx, run.Id, repository.WithTx(tx))
+	if err != nil {
+		return err
+	}
+	statusByEvent := make(map[int64]domain.StatusEnum, len(eventRuns))
+	for _, eventRun := range eventRuns {
+		statusByEvent[eventRun.EventId] = eventRun.Status
+	}
+
+	successed := 0
+	for _, eventRun := range eventRuns {
+		switch eventRun.Status {
+		case domain.StatusFailed, domain.StatusCancelled:
+			// one failed upstream fails the whole run
+			return _self.finish(ctx, tx, run, domain.StatusFailed)
+		case domain.StatusSuccessed:
+			successed++
+		}
+	}
+	if successed == len(eventRuns) {
+		return _self.finish(ctx, tx, run, domain.StatusSuccessed)
+	}
+
+	for _, eventRun := range eventRuns {
+		if eventRun.Status != domain.StatusPending {
+			continue
+		}
+		ready := true
+		for _, upstream := range domain.ParseIds(eventRun.DependsOn) {
+			if statusByEvent[upstream] != domain.StatusSuccessed {
+				ready = false
+				break
+			}
+		}
+		if !ready {
+			continue
+		}
+		if err := _self.dispatch(ctx, tx, eventRun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (_self *WorkflowService) dispatch(ctx context.Context, tx *gorm.DB, eventRun *domain.EventRun) error {
+	event, err := _self.getStepEvent(ctx, eventRun.EventId)
+	if err != nil {
+		return err
+	}
+	outbox, err := buildCrawlerOutbox(event, eventRun.RunId)
+	if err != nil {
+		return err
+	}
+	if err := _self.outboxRepo.InsertOnce(ctx, outbox, repository.WithTx(tx)); err != nil {
+		return err
+	}
+	startedAt := time.Now()
+	eventRun.Status = domain.StatusRunning
+	eventRun.StartedAt = &startedAt
+	return _self.eventRunRepo.UpdateEventRun(ctx, eventRun, repository.WithTx(tx))
+}
+
+// getStepEvent returns the event of a step, a caller does not see the events of the other teams
+func (_self *WorkflowService) getStepEvent(ctx context.Context, id int64) (*domain.SchedulerEvent, error) {
+	event, err := _self.eventRepo.GetSchedulerEventByID(ctx, id, teamScope(ctx)...)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "event %d of the workflow is not found", id)
+	}
+	return event, err
+}
+
+func (_self *WorkflowService) finish(ctx context.Context, tx *gorm.DB, run *domain.WorkflowRun, runStatus domain.StatusEnum) error {
+	eventRuns, err := _self.eventRunRepo.GetEventRunsByWorkflowRunId(ctx, run.Id, repository.WithTx(tx))
+	if err != nil {
+		return err
+	}
+	finishedAt := time.Now()
+	runIds := make([]string, 0)
+	for _, eventRun := range eventRuns {
+		if eventRun.IsFinished() {
+			continue
+		}
+		eventRun.Status = domain.StatusCancelled
+		eventRun.FinishedAt = &finishedAt
+		if err := _self.eventRunRepo.UpdateEventRun(ctx, eventRun, repository.WithTx(tx)); err != nil {
+			return err
+		}
+		runIds = append(runIds, eventRun.RunId)
+	}
+	if err := _self.outboxRepo.CancelOutboxesByRunIds(ctx, runIds, repository.WithTx(tx)); err != nil {
+		return err
+	}
+	run.Status = runStatus
+	run.FinishedAt = &finishedAt
+	return _self.workflowRunRepo.UpdateWorkflowRun(ctx, run, repository.WithTx(tx))
+}
+
+// validateWorkflowSteps checks that every upstream is a step of the workflow and the steps form a DAG
+func validateWorkflowSteps(steps []entity.WorkflowStep) error {
+	if len(steps) == 0 {
+		return status.Errorf(codes.InvalidArgument, "workflow has no step")
+	}
+	inDegree := make(map[int64]int, len(steps))
+	downstreams := make(map[int64][]int64, len(steps))
+	for _, step := range steps {
+		if _, exist := inDegree[step.EventId]; exist {
+			return status.Errorf(codes.InvalidArgument, "event %d is used by more than one step", step.EventId)
+		}
+		inDegree[step.EventId] = 0
+	}
+	for _, step := range steps {
+		for _, upstream := range step.DependsOn {
+			if _, exist := inDegree[upstream]; !exist {
+				return status.Errorf(codes.InvalidArgument, "event %d depends on event %d which is not a step of the workflow", step.EventId, upstream)
+			}
+			inDegree[step.EventId]++
+			downstreams[upstream] = append(downstreams[upstream], step.EventId)
+		}
+	}
+	// Kahn's algorithm: every step is visited only when there is no cycle
+	queue := make([]int64, 0, len(steps))
+	for eventId, degree := range inDegree {
+		if degree == 0 {
+			queue = append(queue, eventId)
+		}
+	}
+	visited := 0
+	for len(queue) > 0 {
+		eventId := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, downstream := range downstreams[eventId] {
+			inDegree[downstream]--
+			if inDegree[downstream] == 0 {
+				queue = append(queue, downstream)
+			}
+		}
+	}
+	if visited != len(steps) {
+		return status.Errorf(codes.InvalidArgument, "workflow steps contain a cycle")
+	}
+	return nil
+}
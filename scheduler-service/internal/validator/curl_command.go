@@ -0,0 +1,197 @@
+package validator
+
+import (
+	"strings"
+)
+
+// curlFlags are the flags a curl command may use, true when the flag takes a value. The flags which
+// write or read local files, add other targets or send the request to another host than its URLs are
+// left out, and so are the positional arguments: every target is a --url.
+// The crawler runs the commands with the same lists, see crawler-service/internal/service/curl_command.go.
+var curlFlags = map[string]bool{
+	"url":             true,
+	"request":         true,
+	"header":          true,
+	"user-agent":      true,
+	"referer":         true,
+	"cookie":          true,
+	"user":            true,
+	"oauth2-bearer":   true,
+	"data":            true,
+	"data-ascii":      true,
+	"data-binary":     true,
+	"data-raw":        true,
+	"range":           true,
+	"max-time":        true,
+	"connect-timeout": true,
+	"max-filesize":    true,
+	"compressed":      false,
+	"get":             false,
+	"head":            false,
+	"location":        false,
+	"insecure":        false,
+	"basic":           false,
+	"http1.1":         false,
+	"http2":           false,
+	"silent":          false,
+	"show-error":      false,
+	"globoff":         false,
+	"path-as-is":      false,
+}
+
+// curlShortFlags are the short names of the flags, as copied from the browsers
+var curlShortFlags = map[byte]string{
+	'X': "request",
+	'H': "header",
+	'A': "user-agent",
+	'e': "referer",
+	'b': "cookie",
+	'u': "user",
+	'd': "data",
+	'r': "range",
+	'm': "max-time",
+	'G': "get",
+	'I': "head",
+	'L': "location",
+	'k': "insecure",
+	's': "silent",
+	'S': "show-error",
+	'g': "globoff",
+}
+
+// fileCurlFlags read a local file when their value starts with "@"
+var fileCurlFlags = map[string]bool{
+	"header":      true,
+	"data":        true,
+	"data-ascii":  true,
+	"data-binary": true,
+}
+
+// parseCurl reads the command as the crawler does and returns its --url targets: the command is split
+// as a shell would split it and every flag must be one of the allowed flags
+func parseCurl(command string) ([]string, *Violation) {
+	tokens, ok := splitCurlCommand(command)
+	if !ok || len(tokens) == 0 || tokens[0] != "curl" {
+		return nil, &Violation{Field: "url", Rule: RuleCurl}
+	}
+	var urls []string
+	for i := 1; i < len(tokens); i++ {
+		names, value, ok := curlFlagNames(tokens[i])
+		if !ok {
+			return nil, &Violation{Field: "url", Rule: RuleCurlFlag, Args: []string{tokens[i]}}
+		}
+		for _, name := range names {
+			if !curlFlags[name] {
+				continue
+			}
+			// the flag with a value is the last of a group of short flags
+			if value == "" {
+				if i+1 >= len(tokens) {
+					return nil, &Violation{Field: "url", Rule: RuleCurl}
+				}
+				i++
+				value = tokens[i]
+			}
+			if readsCurlFile(name, value) {
+				return nil, &Violation{Field: "url", Rule: RuleCurlFlag, Args: []string{"--" + name + " " + value}}
+			}
+			if name == "url" {
+				urls = append(urls, value)
+			}
+		}
+	}
+	if len(urls) == 0 {
+		return nil, &Violation{Field: "url", Rule: RuleCurl}
+	}
+	return urls, nil
+}
+
+// curlFlagNames are the long names of the flags of a token and the value glued to a short flag,
+// e.g. "-sSL" is silent, show-error and location and "-XPOST" is request POST. It is false for the
+// positional arguments and the flags which are not allowed.
+func curlFlagNames(token string) ([]string, string, bool) {
+	if name, ok := strings.CutPrefix(token, "--"); ok && name != "" {
+		_, allowed := curlFlags[name]
+		return []string{name}, "", allowed
+	}
+	if len(token) < 2 || token[0] != '-' || token == "--" {
+		return nil, "", false
+	}
+	names := make([]string, 0, len(token)-1)
+	for i := 1; i < len(token); i++ {
+		name, ok := curlShortFlags[token[i]]
+		if !ok {
+			return nil, "", false
+		}
+		names = append(names, name)
+		if curlFlags[name] {
+			return names, token[i+1:], true
+		}
+	}
+	return names, "", true
+}
+
+// readsCurlFile is true for the values which read a local file, a cookie without "=" is a cookie file
+func readsCurlFile(name, value string) bool {
+	return (fileCurlFlags[name] && strings.HasPrefix(value, "@")) ||
+		(name == "cookie" && !strings.Contains(value, "="))
+}
+
+// splitCurlCommand splits the command into words as a shell does: spaces separate the words, single
+// quotes and backticks keep their text, double quotes and backslashes escape, a backslash before a
+// newline continues the line. The backticks were the quotes of the older commands.
+func splitCurlCommand(command string) ([]string, bool) {
+	var tokens []string
+	var word strings.Builder
+	inWord := false
+	for i := 0; i < len(command); i++ {
+		c := command[i]
+		switch {
+		case c == '\\':
+			if i+1 >= len(command) {
+				return nil, false
+			}
+			i++
+			if command[i] != '\n' {
+				word.WriteByte(command[i])
+				inWord = true
+			}
+		case c == '\'' || c == '`':
+			end := strings.IndexByte(command[i+1:], c)
+			if end < 0 {
+				return nil, false
+			}
+			word.WriteString(command[i+1 : i+1+end])
+			i += end + 1
+			inWord = true
+		case c == '"':
+			i++
+			for ; i < len(command) && command[i] != '"'; i++ {
+				if command[i] == '\\' && i+1 < len(command) && strings.IndexByte("\"\\$`\n", command[i+1]) >= 0 {
+					i++
+					if command[i] == '\n' {
+						continue
+					}
+				}
+				word.WriteByte(command[i])
+			}
+			if i >= len(command) {
+				return nil, false
+			}
+			inWord = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inWord {
+				tokens = append(tokens, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		tokens = append(tokens, word.String())
+	}
+	return tokens, true
+}
@@ -0,0 +1,188 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// actions of the rules file
+const (
+	ActionInsert = "insert"
+	ActionEdit   = "edit"
+)
+
+// eventRequest is a request carrying an event, the event is checked with the rules file as well
+type eventRequest interface {
+	GetEvent() *schedulerv1.SchedulerEvent
+}
+
+// maskedRequest limits the rules to the fields of its mask when the mask is set
+type maskedRequest interface {
+	GetUpdateMask() *fieldmaskpb.FieldMask
+}
+
+// protoValidator is implemented by the messages generated by protoc-gen-validate
+type protoValidator interface {
+	ValidateAll() error
+}
+
+// pgvError is a violation of protoc-gen-validate, Cause is set for the embedded messages
+type pgvError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type pgvMultiError interface {
+	AllErrors() []error
+}
+
+// actionOf is insert for the RPCs creating the event of their request or previewing it before
+// it is created, and edit for the others
+func actionOf(fullMethod string) string {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if strings.HasPrefix(method, "Create") || strings.HasPrefix(method, "Preview") {
+		return ActionInsert
+	}
+	return ActionEdit
+}
+
+func (_self *Validate) ValidateRequest(ctx context.Context, fullMethod string, req any) error {
+	violations := protoViolations(req)
+	if request, ok := req.(eventRequest); ok && request.GetEvent() != nil {
+		var fields []string
+		if masked, ok := req.(maskedRequest); ok {
+			// a patch without fields writes nothing, the handler rejects it
+			if fields = masked.GetUpdateMask().GetPaths(); len(fields) == 0 {
+				return violationError(languageOf(ctx), _self.Rules(), violations)
+			}
+		}
+		eventMap, err := eventFields(request.GetEvent())
+		if err != nil {
+			return err
+		}
+		for _, violation := range _self.violations(ctx, actionOf(fullMethod), eventMap, fields) {
+			violation.Field = "event." + violation.Field
+			violations = append(violations, violation)
+		}
+	}
+	return violationError(languageOf(ctx), _self.Rules(), violations)
+}
+
+func (_self *Validate) ValidateEvent(ctx context.Context, action string, event *schedulerv1.SchedulerEvent) error {
+	violations := protoViolations(event)
+	eventMap, err := eventFields(event)
+	if err != nil {
+		return err
+	}
+	violations = append(violations, _self.violations(ctx, action, eventMap, nil)...)
+	return violationError(languageOf(ctx), _self.Rules(), violations)
+}
+
+// eventFields are the fields of the event by their proto name, as the rules file names them
+func eventFields(event *schedulerv1.SchedulerEvent) (map[string]string, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event fields: %w", err)
+	}
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to read event fields: %w", err)
+	}
+	fields := make(map[string]string, len(values))
+	for name, value := range values {
+		fields[name] = fmt.Sprint(value)
+	}
+	return fields, nil
+}
+
+// protoViolations are the constraints of the proto broken by the message, every violation is kept
+func protoViolations(msg any) []Violation {
+	validator, ok := msg.(protoValidator)
+	if !ok {
+		return nil
+	}
+	err := validator.ValidateAll()
+	if err == nil {
+		return nil
+	}
+	return flattenProtoError("", err)
+}
+
+func flattenProtoError(prefix string, err error) []Violation {
+	var multi pgvMultiError
+	if errors.As(err, &multi) {
+		violations := make([]Violation, 0)
+		for _, item := range multi.AllErrors() {
+			violations = append(violations, flattenProtoError(prefix, item)...)
+		}
+		return violations
+	}
+	var fieldErr pgvError
+	if !errors.As(err, &fieldErr) {
+		return []Violation{{Field: strings.TrimSuffix(prefix, "."), Rule: RuleProto, Args: []string{err.Error()}}}
+	}
+	field := prefix + snakeCase(fieldErr.Field())
+	if cause := fieldErr.Cause(); cause != nil {
+		var nested pgvError
+		var nestedMulti pgvMultiError
+		if errors.As(cause, &nested) || errors.As(cause, &nestedMulti) {
+			return flattenProtoError(field+".", cause)
+		}
+	}
+	return []Violation{{Field: field, Rule: RuleProto, Args: []string{fieldErr.Reason()}}}
+}
+
+// snakeCase turns the Go names of protoc-gen-validate into the proto names, NextRunTime is next_run_time
+func snakeCase(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				builder.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// UnaryServerInterceptor runs the validation of every request, it replaces the validator of go-grpc-middleware
+func UnaryServerInterceptor(validate IValidate) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validate.ValidateRequest(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(validate IValidate) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: stream, validate: validate, fullMethod: info.FullMethod})
+	}
+}
+
+// serverStream validates every received message
+type serverStream struct {
+	grpc.ServerStream
+	validate   IValidate
+	fullMethod string
+}
+
+func (_self *serverStream) RecvMsg(msg any) error {
+	if err := _self.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	return _self.validate.ValidateRequest(_self.Context(), _self.fullMethod, msg)
+}
@@ -0,0 +1,157 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/namnv2496/scheduler/internal/entity"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/metadata"
+)
+
+// rules of the violations, they are the reasons of the BadRequest field violations
+const (
+	RuleRequired      = "required"
+	RuleNumber        = "number"
+	RuleMinValue      = "min_value"
+	RuleMaxValue      = "max_value"
+	RuleMinLength     = "min_length"
+	RuleMaxLength     = "max_length"
+	RuleMinWord       = "min_word"
+	RuleMaxWord       = "max_word"
+	RulePattern       = "pattern"
+	RuleAllowedValues = "allowed_values"
+	// RuleProto is a constraint of the proto files, the reason of protoc-gen-validate is shown as its value
+	RuleProto = "proto"
+	// the rules of the crawl targets
+	RuleURL            = "url"
+	RuleScheme         = "scheme"
+	RuleHostDenied     = "host_denied"
+	RuleHostNotAllowed = "host_not_allowed"
+	RulePrivateAddress = "private_address"
+	RuleUnresolvedHost = "unresolved_host"
+	RuleCurl           = "curl"
+	RuleCurlFlag       = "curl_flag"
+	RuleCronExp        = "cron_exp"
+	RuleMinInterval    = "min_interval"
+	// the comparisons of the custom rules use their operator as rule
+)
+
+// DefaultLanguage is used when the caller accepts no language of the catalogue
+const DefaultLanguage = "vi"
+
+// messages is the catalogue by language and rule, %[1]s is the label of the field and %[2]s the value of the rule
+var messages = map[string]map[string]string{
+	"vi": {
+		RuleRequired:       `Vui lòng nhập thông tin "%[1]s"`,
+		RuleNumber:         `"%[1]s" phải là số`,
+		RuleMinValue:       `"%[1]s" phải >= %[2]s`,
+		RuleMaxValue:       `"%[1]s" phải <= %[2]s`,
+		RuleMinLength:      `"%[1]s" phải có ít nhất %[2]s ký tự`,
+		RuleMaxLength:      `"%[1]s" chỉ được có tối đa %[2]s ký tự`,
+		RuleMinWord:        `"%[1]s" phải có ít nhất %[2]s từ`,
+		RuleMaxWord:        `"%[1]s" chỉ được có tối đa %[2]s từ`,
+		RulePattern:        `"%[1]s" không đúng định dạng`,
+		RuleAllowedValues:  `"%[1]s" không hợp lệ. Chỉ chấp nhận: %[2]s`,
+		RuleProto:          `"%[1]s" không hợp lệ: %[2]s`,
+		RuleURL:            `"%[1]s" không phải là URL hợp lệ`,
+		RuleScheme:         `"%[1]s" chỉ chấp nhận giao thức: %[2]s`,
+		RuleHostDenied:     `"%[1]s" không được phép truy cập %[2]s`,
+		RuleHostNotAllowed: `"%[1]s": %[2]s không nằm trong danh sách được phép`,
+		RulePrivateAddress: `"%[1]s" không được trỏ tới địa chỉ nội bộ %[2]s`,
+		RuleUnresolvedHost: `"%[1]s": không phân giải được %[2]s`,
+		RuleCurl:           `"%[1]s" phải là lệnh curl có --url`,
+		RuleCurlFlag:       `"%[1]s" không được dùng %[2]s`,
+		RuleCronExp:        `"%[1]s" không đúng cú pháp cron: %[2]s`,
+		RuleMinInterval:    `"%[1]s" phải để hai lần chạy cách nhau ít nhất %[2]s`,
+		entity.OP_EQ:       `"%[1]s" phải bằng %[2]s`,
+		entity.OP_NE:       `"%[1]s" phải khác %[2]s`,
+		entity.OP_GT:       `"%[1]s" phải > %[2]s`,
+		entity.OP_GTE:      `"%[1]s" phải >= %[2]s`,
+		entity.OP_LT:       `"%[1]s" phải < %[2]s`,
+		entity.OP_LTE:      `"%[1]s" phải <= %[2]s`,
+	},
+	"en": {
+		RuleRequired:       `"%[1]s" is required`,
+		RuleNumber:         `"%[1]s" must be a number`,
+		RuleMinValue:       `"%[1]s" must be >= %[2]s`,
+		RuleMaxValue:       `"%[1]s" must be <= %[2]s`,
+		RuleMinLength:      `"%[1]s" must have at least %[2]s characters`,
+		RuleMaxLength:      `"%[1]s" must have at most %[2]s characters`,
+		RuleMinWord:        `"%[1]s" must have at least %[2]s words`,
+		RuleMaxWord:        `"%[1]s" must have at most %[2]s words`,
+		RulePattern:        `"%[1]s" has an invalid format`,
+		RuleAllowedValues:  `"%[1]s" is invalid, allowed values: %[2]s`,
+		RuleProto:          `"%[1]s" is invalid: %[2]s`,
+		RuleURL:            `"%[1]s" is not a valid URL`,
+		RuleScheme:         `"%[1]s" only accepts the schemes: %[2]s`,
+		RuleHostDenied:     `"%[1]s" must not target %[2]s`,
+		RuleHostNotAllowed: `"%[1]s": %[2]s is not an allowed host`,
+		RulePrivateAddress: `"%[1]s" must not target the private address %[2]s`,
+		RuleUnresolvedHost: `"%[1]s": %[2]s cannot be resolved`,
+		RuleCurl:           `"%[1]s" must be a curl command with --url`,
+		RuleCurlFlag:       `"%[1]s" must not use %[2]s`,
+		RuleCronExp:        `"%[1]s" is not a valid cron expression: %[2]s`,
+		RuleMinInterval:    `"%[1]s" must leave at least %[2]s between two runs`,
+		entity.OP_EQ:       `"%[1]s" must be equal to %[2]s`,
+		entity.OP_NE:       `"%[1]s" must not be equal to %[2]s`,
+		entity.OP_GT:       `"%[1]s" must be > %[2]s`,
+		entity.OP_GTE:      `"%[1]s" must be >= %[2]s`,
+		entity.OP_LT:       `"%[1]s" must be < %[2]s`,
+		entity.OP_LTE:      `"%[1]s" must be <= %[2]s`,
+	},
+}
+
+// the first tag is the default of the matcher
+var languageMatcher = language.NewMatcher([]language.Tag{language.Vietnamese, language.English})
+
+// languageOf picks the language of the catalogue from Accept-Language, the gateway
+// forwards the header with its "grpcgateway-" prefix
+func languageOf(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("accept-language")
+	if len(values) == 0 {
+		values = md.Get("grpcgateway-accept-language")
+	}
+	if len(values) == 0 {
+		return DefaultLanguage
+	}
+	tags, _, err := language.ParseAcceptLanguage(strings.Join(values, ","))
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+	tag, _, _ := languageMatcher.Match(tags...)
+	base, _ := tag.Base()
+	return base.String()
+}
+
+func translate(lang, rule string, args ...string) string {
+	template, ok := messages[lang][rule]
+	if !ok {
+		template, ok = messages[DefaultLanguage][rule]
+	}
+	if !ok {
+		return rule
+	}
+	values := make([]any, 0, len(args))
+	for _, arg := range args {
+		values = append(values, arg)
+	}
+	return fmt.Sprintf(template, values...)
+}
+
+// label is the name of the field in the language, the label of the value rule or the field itself.
+// The fields of nested messages are named by their last part.
+func label(lang string, rules *entity.ValidationRules, path string) string {
+	field := path[strings.LastIndex(path, ".")+1:]
+	if name := rules.Labels[lang][field]; name != "" {
+		return name
+	}
+	if lang == DefaultLanguage {
+		if name := rules.Values[field].Label; name != "" {
+			return name
+		}
+	}
+	return path
+}
@@ -0,0 +1,250 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository/rulestore"
+	"github.com/namnv2496/scheduler/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// reloadDelay lets an editor finish writing the file before it is read
+const reloadDelay = 200 * time.Millisecond
+
+var operators = map[string]bool{
+	entity.OP_EQ:  true,
+	entity.OP_NE:  true,
+	entity.OP_GT:  true,
+	entity.OP_GTE: true,
+	entity.OP_LT:  true,
+	entity.OP_LTE: true,
+}
+
+func (_self *Validate) Rules() *entity.ValidationRules {
+	return _self.ruleSet().rules
+}
+
+func (_self *Validate) Reload(ctx context.Context) (*entity.ValidationRules, error) {
+	_self.mutex.Lock()
+	defer _self.mutex.Unlock()
+	return _self.reload(ctx)
+}
+
+// reload shares the rules file when its version is newer than the shared rules, then loads the shared rules
+func (_self *Validate) reload(ctx context.Context) (*entity.ValidationRules, error) {
+	data, err := os.ReadFile(_self.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validation rules: %w", err)
+	}
+	var rules entity.ValidationRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse validation rules %s: %w", _self.path, err)
+	}
+	if _, err := compileRules(&rules); err != nil {
+		return nil, fmt.Errorf("invalid validation rules %s: %w", _self.path, err)
+	}
+	seeded, err := _self.store.Seed(ctx, data, rules.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to share validation rules: %w", err)
+	}
+	if !seeded {
+		logging.Infof(ctx, "validation rules version %d of %s are not newer than the shared rules", rules.Version, _self.path)
+	}
+	return _self.load(ctx)
+}
+
+// load reads the shared rules and uses them
+func (_self *Validate) load(ctx context.Context) (*entity.ValidationRules, error) {
+	data, version, err := _self.store.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read shared validation rules: %w", err)
+	}
+	return _self.apply(ctx, data, version)
+}
+
+func (_self *Validate) apply(ctx context.Context, data []byte, version int64) (*entity.ValidationRules, error) {
+	var rules entity.ValidationRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse shared validation rules: %w", err)
+	}
+	// the version of the store wins over the one written in the content
+	rules.Version = version
+	set, err := compileRules(&rules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shared validation rules: %w", err)
+	}
+	_self.lock.Lock()
+	_self.current = set
+	_self.lock.Unlock()
+	logging.Infof(ctx, "validation rules version %d are loaded", rules.Version)
+	return &rules, nil
+}
+
+// refresh loads the shared rules when they are not the version in use
+func (_self *Validate) refresh(ctx context.Context) error {
+	_self.mutex.Lock()
+	defer _self.mutex.Unlock()
+	data, version, err := _self.store.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read shared validation rules: %w", err)
+	}
+	if version == _self.Rules().Version {
+		return nil
+	}
+	_, err = _self.apply(ctx, data, version)
+	return err
+}
+
+func (_self *Validate) SaveRules(ctx context.Context, rules *entity.ValidationRules, version int64) (*entity.ValidationRules, error) {
+	_self.mutex.Lock()
+	defer _self.mutex.Unlock()
+	saved := *rules
+	if _, err := compileRules(&saved); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validation rules: %v", err)
+	}
+	// the store gives the version
+	saved.Version = 0
+	data, err := yaml.Marshal(&saved)
+	if err != nil {
+		return nil, err
+	}
+	next, err := _self.store.Save(ctx, data, version)
+	if err != nil {
+		var conflict *rulestore.VersionConflictError
+		if errors.As(err, &conflict) {
+			return nil, status.Errorf(codes.Aborted, "validation rules are at version %d, not %d", conflict.Current, version)
+		}
+		return nil, err
+	}
+	return _self.apply(ctx, data, next)
+}
+
+func (_self *Validate) Watch(ctx context.Context) error {
+	ctx = logging.AppendPrefix(ctx, "Watch")
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// the directory is watched, editors replace the file instead of writing it
+	if err := watcher.Add(filepath.Dir(_self.path)); err != nil {
+		watcher.Close()
+		return err
+	}
+	name := filepath.Clean(_self.path)
+	changes := _self.store.Changes(ctx)
+	go func() {
+		defer watcher.Close()
+		ticker := time.NewTicker(_self.refreshEvery)
+		defer ticker.Stop()
+		var timer <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) == name && event.Has(fsnotify.Write|fsnotify.Create) {
+					timer = time.After(reloadDelay)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logging.Errorf(ctx, "Failed to watch validation rules: %v", err)
+			case <-timer:
+				timer = nil
+				if _, err := _self.Reload(ctx); err != nil {
+					logging.Errorf(ctx, "Keep validation rules version %d: %v", _self.Rules().Version, err)
+				}
+			case version, ok := <-changes:
+				if !ok {
+					// the subscription ends with ctx, the ticker keeps the rules fresh meanwhile
+					changes = nil
+					continue
+				}
+				if version == _self.Rules().Version {
+					continue
+				}
+				if err := _self.refresh(ctx); err != nil {
+					logging.Errorf(ctx, "Keep validation rules version %d: %v", _self.Rules().Version, err)
+				}
+			case <-ticker.C:
+				if err := _self.refresh(ctx); err != nil {
+					logging.Errorf(ctx, "Keep validation rules version %d: %v", _self.Rules().Version, err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// compileRules checks the rules and prepares them for the validation
+func compileRules(rules *entity.ValidationRules) (*ruleSet, error) {
+	set := &ruleSet{
+		rules:             rules,
+		validateByActions: rules.Values,
+		requireByActions:  make(map[string]map[string]entity.FieldRequireCondition),
+		customValidators:  rules.Custom,
+	}
+	for action, fields := range rules.Requires {
+		conditions := make(map[string]entity.FieldRequireCondition, len(fields))
+		for paramName, require := range fields {
+			conditionRules := make([]string, 0, len(require))
+			for dependParam, dependValue := range require {
+				conditionRules = append(conditionRules, dependParam+":"+dependValue)
+			}
+			conditions[paramName] = entity.FieldRequireCondition{
+				Param:      paramName,
+				Name:       paramName, // update displayedName later
+				Conditions: conditionRules,
+			}
+		}
+		set.requireByActions[action] = conditions
+	}
+	for paramName, value := range rules.Values {
+		if value.MaxLength > 0 && value.MinLength > value.MaxLength {
+			return nil, fmt.Errorf("%s: min_length is greater than max_length", paramName)
+		}
+		if value.MaxValue > 0 && value.MinValue > value.MaxValue {
+			return nil, fmt.Errorf("%s: min_value is greater than max_value", paramName)
+		}
+		if value.MaxWord > 0 && value.MinWord > value.MaxWord {
+			return nil, fmt.Errorf("%s: min_word is greater than max_word", paramName)
+		}
+	}
+	if rules.Targets.MinInterval < 0 {
+		return nil, fmt.Errorf("targets: min_interval is negative")
+	}
+	for _, host := range append(slices.Clone(rules.Targets.AllowedHosts), rules.Targets.DeniedHosts...) {
+		if host == "" || strings.Contains(strings.TrimPrefix(host, "*."), "*") {
+			return nil, fmt.Errorf("targets: invalid host %q", host)
+		}
+	}
+	for paramName, customRules := range rules.Custom {
+		for _, rule := range customRules {
+			if rule.Pattern != "" {
+				if _, err := regexp.Compile(rule.Pattern); err != nil {
+					return nil, fmt.Errorf("%s: invalid pattern: %w", paramName, err)
+				}
+			}
+			if rule.Operator != "" && !operators[rule.Operator] {
+				return nil, fmt.Errorf("%s: unknown operator %q", paramName, rule.Operator)
+			}
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,224 @@
+package validator
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/robfig/cron/v3"
+)
+
+// MethodCurl is the method of the crawler whose url is a curl command, the url of the others is a URL
+const MethodCurl = "CURL"
+
+// resolveTimeout bounds the lookup of a host, a slow DNS fails the check instead of the request
+const resolveTimeout = 2 * time.Second
+
+// cronRuns is the number of runs of a cron expression compared with the minimum interval
+const cronRuns = 32
+
+var defaultSchemes = []string{"http", "https"}
+
+// validateTarget checks the crawl target of the event: its URL or curl command, the host of the URL
+// against the lists and the private networks, the cron expression and the interval between the runs
+func validateTarget(ctx context.Context, rules entity.TargetRules, eventMap map[string]string) []Violation {
+	violations := make([]Violation, 0)
+	if rawURL := eventMap["url"]; rawURL != "" {
+		violations = append(violations, validateURLField(ctx, rules, eventMap["method"], rawURL)...)
+	}
+	if cronExp := eventMap["cron_exp"]; cronExp != "" {
+		if violation := validateCronExp(rules, cronExp); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+	if interval := eventMap["next_run_time"]; interval != "" && interval != "0" && rules.MinInterval > 0 {
+		value, err := strconv.ParseInt(interval, 10, 64)
+		if err == nil && value < rules.MinInterval {
+			violations = append(violations, Violation{Field: "next_run_time", Rule: RuleMinInterval, Args: []string{minInterval(rules).String()}})
+		}
+	}
+	return violations
+}
+
+func validateURLField(ctx context.Context, rules entity.TargetRules, method, rawURL string) []Violation {
+	// a patch of the url alone has no method, the curl commands are told by their program
+	if method == MethodCurl || (method == "" && strings.HasPrefix(strings.TrimSpace(rawURL), "curl ")) {
+		targetURLs, violation := parseCurl(rawURL)
+		if violation != nil {
+			return []Violation{*violation}
+		}
+		// curl requests every --url, each of them is checked
+		for _, targetURL := range targetURLs {
+			if violations := validateTargetURL(ctx, rules, targetURL); len(violations) > 0 {
+				return violations
+			}
+		}
+		return nil
+	}
+	return validateTargetURL(ctx, rules, rawURL)
+}
+
+// validateTargetURL checks the scheme of the URL and its host against the lists and the private networks
+func validateTargetURL(ctx context.Context, rules entity.TargetRules, rawURL string) []Violation {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return []Violation{{Field: "url", Rule: RuleURL}}
+	}
+	schemes := rules.Schemes
+	if len(schemes) == 0 {
+		schemes = defaultSchemes
+	}
+	if !slices.Contains(schemes, strings.ToLower(parsed.Scheme)) {
+		return []Violation{{Field: "url", Rule: RuleScheme, Args: []string{strings.Join(schemes, ", ")}}}
+	}
+	if parsed.Host == "" {
+		return []Violation{{Field: "url", Rule: RuleURL}}
+	}
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if matchHost(rules.DeniedHosts, host) {
+		return []Violation{{Field: "url", Rule: RuleHostDenied, Args: []string{host}}}
+	}
+	if len(rules.AllowedHosts) > 0 && !matchHost(rules.AllowedHosts, host) {
+		return []Violation{{Field: "url", Rule: RuleHostNotAllowed, Args: []string{host}}}
+	}
+	if rules.AllowPrivateIPs {
+		return nil
+	}
+	if violation := validateAddresses(ctx, rules, host); violation != nil {
+		return []Violation{*violation}
+	}
+	return nil
+}
+
+// validateAddresses rejects the hosts of the private networks. The crawler resolves the host again
+// when it runs and refuses the private addresses as well, a host changing its records is caught there.
+func validateAddresses(ctx context.Context, rules entity.TargetRules, host string) *Violation {
+	if addr, ok := literalAddr(host); ok {
+		if isPrivate(addr) {
+			return &Violation{Field: "url", Rule: RulePrivateAddress, Args: []string{host}}
+		}
+		return nil
+	}
+	if !rules.ResolveHosts {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(addrs) == 0 {
+		return &Violation{Field: "url", Rule: RuleUnresolvedHost, Args: []string{host}}
+	}
+	for _, addr := range addrs {
+		if isPrivate(addr) {
+			return &Violation{Field: "url", Rule: RulePrivateAddress, Args: []string{host}}
+		}
+	}
+	return nil
+}
+
+// literalAddr reads a host which is an address: an IPv6 or dotted IPv4 address, or a numeric IPv4 form.
+// literalAddr, parseNumericIPv4 and isPrivate are twins of crawler-service/internal/service/target_guard.go,
+// the two services do not share a module: change both together.
+func literalAddr(host string) (netip.Addr, bool) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, true
+	}
+	return parseNumericIPv4(host)
+}
+
+// parseNumericIPv4 reads the other forms of IPv4 which inet_aton, curl and the browsers accept: 1 to 4
+// parts in decimal, octal (0 prefix) or hex (0x prefix), the last part fills the remaining bytes,
+// e.g. 2130706433, 0x7f000001, 0177.0.0.1 and 127.1 are 127.0.0.1
+func parseNumericIPv4(host string) (netip.Addr, bool) {
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+	values := make([]uint64, len(parts))
+	for i, part := range parts {
+		base := 10
+		if rest, ok := strings.CutPrefix(strings.ToLower(part), "0x"); ok {
+			part, base = rest, 16
+		} else if len(part) > 1 && part[0] == '0' {
+			part, base = part[1:], 8
+		}
+		value, err := strconv.ParseUint(part, base, 32)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		values[i] = value
+	}
+	last := len(values) - 1
+	if values[last] >= 1<<(8*(4-last)) {
+		return netip.Addr{}, false
+	}
+	number := uint32(values[last])
+	for i, value := range values[:last] {
+		if value > 255 {
+			return netip.Addr{}, false
+		}
+		number |= uint32(value) << (24 - 8*i)
+	}
+	return netip.AddrFrom4([4]byte{byte(number >> 24), byte(number >> 16), byte(number >> 8), byte(number)}), true
+}
+
+// isPrivate is true for the addresses of the machine, of the local networks and of the cloud metadata
+func isPrivate(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() ||
+		// carrier-grade NAT is private to the provider
+		netip.MustParsePrefix("100.64.0.0/10").Contains(addr)
+}
+
+// matchHost matches the host with the exact hosts of the list and with the subdomains of "*." entries
+func matchHost(hosts []string, host string) bool {
+	for _, pattern := range hosts {
+		pattern = strings.ToLower(pattern)
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCronExp parses the expression with the 5 fields of cron or a descriptor like @hourly,
+// the interval between its next runs must not be below the minimum
+func validateCronExp(rules entity.TargetRules, cronExp string) *Violation {
+	schedule, err := cron.ParseStandard(cronExp)
+	if err != nil {
+		return &Violation{Field: "cron_exp", Rule: RuleCronExp, Args: []string{err.Error()}}
+	}
+	if rules.MinInterval <= 0 {
+		return nil
+	}
+	next := schedule.Next(time.Now())
+	if next.IsZero() {
+		return &Violation{Field: "cron_exp", Rule: RuleCronExp, Args: []string{"no next run"}}
+	}
+	for range cronRuns {
+		after := schedule.Next(next)
+		if after.IsZero() {
+			break
+		}
+		if after.Sub(next) < minInterval(rules) {
+			return &Violation{Field: "cron_exp", Rule: RuleMinInterval, Args: []string{minInterval(rules).String()}}
+		}
+		next = after
+	}
+	return nil
+}
+
+func minInterval(rules entity.TargetRules) time.Duration {
+	return time.Duration(rules.MinInterval) * time.Millisecond
+}
@@ -2,122 +2,193 @@ package validator
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"maps"
-	"os"
 	"regexp"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/namnv2496/scheduler/internal/configs"
 	"github.com/namnv2496/scheduler/internal/entity"
+	"github.com/namnv2496/scheduler/internal/repository/rulestore"
+	schedulerv1 "github.com/namnv2496/scheduler/pkg/generated/pkg/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type IValidate interface {
-	ValidateRequire(ctx context.Context, action string, eventMap map[string]string) error
-	ValidateValue(ctx context.Context, eventMap map[string]string) error
-	ValidateCustomeRules(eventMap map[string]string) error
+	// Validate checks the fields of the event for the action, insert or edit. Every violation is
+	// returned in one InvalidArgument error with BadRequest details in the language of the caller.
+	Validate(ctx context.Context, action string, eventMap map[string]string) error
+	// ValidateRequest is the validation of every RPC: the constraints of the proto of the request, then the
+	// rules file for its event, limited to the fields of its update mask when it has one
+	ValidateRequest(ctx context.Context, fullMethod string, req any) error
+	// ValidateEvent checks an event of a bulk request like the event of a single request
+	ValidateEvent(ctx context.Context, action string, event *schedulerv1.SchedulerEvent) error
+	// Rules returns the rules in use
+	Rules() *entity.ValidationRules
+	// Reload shares the rules file when its version is newer than the shared rules and loads the shared
+	// rules, the rules in use are kept when they are invalid
+	Reload(ctx context.Context) (*entity.ValidationRules, error)
+	// SaveRules validates and shares the rules with the next version, version 0 skips the version check
+	SaveRules(ctx context.Context, rules *entity.ValidationRules, version int64) (*entity.ValidationRules, error)
+	// Watch reloads the rules when the file or the shared rules change until ctx is done
+	Watch(ctx context.Context) error
 }
 
-type Validate struct {
+// ruleSet is the loaded rules, it is replaced as a whole on reload
+type ruleSet struct {
+	rules             *entity.ValidationRules
 	validateByActions map[string]entity.FieldValidation
 	requireByActions  map[string]map[string]entity.FieldRequireCondition
 	customValidators  map[string][]entity.CrossFieldRule
 }
 
-func NewValidate() *Validate {
-	return &Validate{
-		validateByActions: make(map[string]entity.FieldValidation),
-		requireByActions:  make(map[string]map[string]entity.FieldRequireCondition),
-		customValidators:  registerCustomeRules(),
-	}
+// Validate checks the events with the rules shared by the replicas in the rule store, the rules file
+// only seeds them
+type Validate struct {
+	path         string
+	store        rulestore.IRuleStore
+	refreshEvery time.Duration
+	// mutex serializes the loads and saves of the rules, the readers use the current rule set
+	mutex   sync.Mutex
+	current *ruleSet
+	lock    sync.RWMutex
 }
 
-func (_self *Validate) ValidateRequire(ctx context.Context, action string, eventMap map[string]string) error {
-	var requireByAction map[string]entity.FieldRequireCondition
-	var exist bool
-	requireByAction, exist = _self.requireByActions[action]
-	if !exist {
-		requires, err := getRequireConditions()
-		if err != nil {
-			return err
-		}
-		_self.requireByActions = requires
-		requireByAction = _self.requireByActions[action]
+func NewValidate(
+	conf *configs.Config,
+	store rulestore.IRuleStore,
+) (*Validate, error) {
+	validate := &Validate{
+		path:         conf.Validator.RulesFile,
+		store:        store,
+		refreshEvery: conf.Validator.RefreshEvery,
 	}
-	if err := validateRequireEventInfo(eventMap, requireByAction); err != nil {
-		return err
+	if _, err := validate.Reload(context.Background()); err != nil {
+		return nil, err
 	}
-	return nil
+	return validate, nil
 }
 
-func (_self *Validate) ValidateValue(ctx context.Context, eventMap map[string]string) error {
-	if len(_self.validateByActions) == 0 {
-		requires, err := getRules()
-		if err != nil {
-			return err
-		}
-		_self.validateByActions = requires
+func (_self *Validate) ruleSet() *ruleSet {
+	_self.lock.RLock()
+	defer _self.lock.RUnlock()
+	return _self.current
+}
+
+func (_self *Validate) Validate(ctx context.Context, action string, eventMap map[string]string) error {
+	violations := _self.violations(ctx, action, eventMap, nil)
+	return violationError(languageOf(ctx), _self.Rules(), violations)
+}
+
+// violations of the rules file, only the rules of fields are checked when fields is not empty
+func (_self *Validate) violations(ctx context.Context, action string, eventMap map[string]string, fields []string) []Violation {
+	set := _self.ruleSet()
+	violations := validateRequireEventInfo(eventMap, set.requireByActions[action])
+	violations = append(violations, validateValueEventInfo(eventMap, set.validateByActions)...)
+	violations = append(violations, validateCustomeRules(set.customValidators, eventMap)...)
+	violations = append(violations, validateTarget(ctx, set.rules.Targets, eventMap)...)
+	if len(fields) == 0 {
+		return violations
 	}
-	if err := validateValueEventInfo(eventMap, _self.validateByActions); err != nil {
-		return err
+	filtered := make([]Violation, 0, len(violations))
+	for _, violation := range violations {
+		if slices.Contains(fields, violation.Field) {
+			filtered = append(filtered, violation)
+		}
 	}
-	return nil
+	return filtered
+}
+
+// Violation is a failed rule of a field, Args are the values of the rule shown in the message
+type Violation struct {
+	Field string
+	Rule  string
+	Args  []string
+	// Messages of the custom rule by language, they replace the messages of the catalogue
+	Messages map[string]string
 }
 
-func (_self *Validate) ValidateCustomeRules(eventMap map[string]string) error {
-	for paramName, value := range eventMap {
-		if err := _self.validateCustomeRules(paramName, value, eventMap); err != nil {
-			return err
+// violationError is InvalidArgument with a BadRequest field violation per failed rule, nil without violations,
+// the message of the status joins them for the clients which do not read the details
+func violationError(lang string, rules *entity.ValidationRules, violations []Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Field < violations[j].Field
+	})
+	badRequest := &errdetails.BadRequest{}
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		message := violation.Messages[lang]
+		if message == "" {
+			message = translate(lang, violation.Rule, append([]string{label(lang, rules, violation.Field)}, violation.Args...)...)
 		}
+		messages = append(messages, violation.Field+": "+message)
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: message,
+			Reason:      violation.Rule,
+			LocalizedMessage: &errdetails.LocalizedMessage{
+				Locale:  lang,
+				Message: message,
+			},
+		})
 	}
-	return nil
+	st := status.New(codes.InvalidArgument, strings.Join(messages, "; "))
+	detailed, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
 
-func (_self *Validate) validateCustomeRules(paramName, value string, eventFields map[string]string) error {
-	rules, exist := _self.customValidators[paramName]
-	if !exist {
-		return nil
+// customMessages are the error_msgs of the rule, error_msg is the message in the default language
+func customMessages(rule entity.CrossFieldRule) map[string]string {
+	messages := make(map[string]string, len(rule.ErrorMsgs)+1)
+	if rule.ErrorMsg != "" {
+		messages[DefaultLanguage] = rule.ErrorMsg
 	}
+	maps.Copy(messages, rule.ErrorMsgs)
+	return messages
+}
 
-	for _, rule := range rules {
-		if err := validateFieldCustomeRule(paramName, value, rule, eventFields); err != nil {
-			return err
+func validateCustomeRules(customValidators map[string][]entity.CrossFieldRule, eventFields map[string]string) []Violation {
+	violations := make([]Violation, 0)
+	for paramName, rules := range customValidators {
+		value, exist := eventFields[paramName]
+		if !exist {
+			continue
+		}
+		for _, rule := range rules {
+			if violation := validateFieldCustomeRule(paramName, value, rule, eventFields); violation != nil {
+				violations = append(violations, *violation)
+			}
 		}
 	}
-	return nil
+	return violations
 }
 
-func validateFieldCustomeRule(paramName, value string, rule entity.CrossFieldRule, eventFields map[string]string) error {
-	var valid bool
-	var err error
-
+func validateFieldCustomeRule(paramName, value string, rule entity.CrossFieldRule, eventFields map[string]string) *Violation {
+	if value == "" {
+		return nil
+	}
 	if rule.Pattern != "" {
-		if value != "" {
-			matched, err := regexp.MatchString(rule.Pattern, value)
-			if err != nil {
-				return fmt.Errorf("%s: Lỗi pattern không hợp lệ", paramName)
-			}
-			if !matched {
-				if rule.ErrorMsg != "" {
-					return fmt.Errorf("%s: %s", paramName, rule.ErrorMsg)
-				}
-				return fmt.Errorf("%s: Giá trị không đúng định dạng", paramName)
-			}
+		// the patterns are compiled when the rules are loaded
+		if matched, _ := regexp.MatchString(rule.Pattern, value); !matched {
+			return &Violation{Field: paramName, Rule: RulePattern, Messages: customMessages(rule)}
 		}
 	}
 
 	// AllowedValues (Enum) validation
-	if len(rule.AllowedValues) > 0 {
-		if value != "" {
-			found := slices.Contains(rule.AllowedValues, value)
-			if !found {
-				return fmt.Errorf("%s: Giá trị không hợp lệ. Chỉ chấp nhận: %s", paramName, strings.Join(rule.AllowedValues, ", "))
-			}
-		}
+	if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, value) {
+		return &Violation{Field: paramName, Rule: RuleAllowedValues, Args: []string{strings.Join(rule.AllowedValues, ", ")}, Messages: customMessages(rule)}
 	}
 	if len(rule.Operator) > 0 {
 		compareValue := eventFields[rule.Field]
@@ -125,234 +196,109 @@ func validateFieldCustomeRule(paramName, value string, rule entity.CrossFieldRul
 			compareValue = rule.Value
 		}
 
+		var valid bool
 		switch rule.Operator {
 		case entity.OP_EQ: // equal
 			valid = value == compareValue
 		case entity.OP_NE: // not equal
 			valid = value != compareValue
-		case entity.OP_GT: // greater than
-			valid, err = compareNumeric(value, compareValue, func(a, b float64) bool { return a > b })
-		case entity.OP_GTE: // greater than or equal
-			valid, err = compareNumeric(value, compareValue, func(a, b float64) bool { return a >= b })
-		case entity.OP_LT: // less than
-			valid, err = compareNumeric(value, compareValue, func(a, b float64) bool { return a < b })
-		case entity.OP_LTE: // less than or equal
-			valid, err = compareNumeric(value, compareValue, func(a, b float64) bool { return a <= b })
 		default:
-			return fmt.Errorf("%s: Toán tử không hợp lệ '%s'", paramName, rule.Operator)
-		}
-
-		if err != nil {
-			return fmt.Errorf("%s: %v", paramName, err)
+			a, errA := strconv.ParseFloat(value, 64)
+			b, errB := strconv.ParseFloat(compareValue, 64)
+			if errA != nil || errB != nil {
+				return &Violation{Field: paramName, Rule: RuleNumber}
+			}
+			valid = compareNumeric(rule.Operator, a, b)
 		}
 		if !valid {
-			if rule.ErrorMsg != "" {
-				return fmt.Errorf("%s: %s", paramName, rule.ErrorMsg)
-			}
+			return &Violation{Field: paramName, Rule: rule.Operator, Args: []string{compareValue}, Messages: customMessages(rule)}
 		}
 	}
-
 	return nil
 }
 
-// compareNumeric compares two string values as numbers using the provided comparison function
-func compareNumeric(a, b string, compare func(float64, float64) bool) (bool, error) {
-	if a == "" || b == "" {
-		return false, fmt.Errorf("giá trị rỗng không thể so sánh")
-	}
-
-	aNum, err := strconv.ParseFloat(a, 64)
-	if err != nil {
-		return false, fmt.Errorf("giá trị '%s' không phải là số", a)
-	}
-
-	bNum, err := strconv.ParseFloat(b, 64)
-	if err != nil {
-		return false, fmt.Errorf("giá trị '%s' không phải là số", b)
-	}
-
-	return compare(aNum, bNum), nil
-}
-
-func getRequireConditions() (map[string]map[string]entity.FieldRequireCondition, error) {
-	data, err := os.ReadFile("./internal/validator/requires.yaml")
-	if err != nil {
-		return nil, err
+// compareNumeric compares two numbers with the operator
+func compareNumeric(operator string, a, b float64) bool {
+	switch operator {
+	case entity.OP_GT: // greater than
+		return a > b
+	case entity.OP_GTE: // greater than or equal
+		return a >= b
+	case entity.OP_LT: // less than
+		return a < b
+	case entity.OP_LTE: // less than or equal
+		return a <= b
 	}
-	var requirements map[string]map[string]entity.FieldRequire
-	err = json.Unmarshal(data, &requirements)
-	if err != nil {
-		return nil, err
-	}
-	resp := make(map[string]map[string]entity.FieldRequireCondition)
-
-	for action, rules := range requirements {
-		updateRules := make(map[string]entity.FieldRequireCondition, 0)
-		for paramName, conditions := range rules {
-			conditionRules := make([]string, 0)
-			for dependParam, dependValue := range conditions {
-				conditionRules = append(conditionRules, dependParam+":"+dependValue)
-			}
-			updateRules[paramName] = entity.FieldRequireCondition{
-				Param:      paramName,
-				Name:       paramName, // update displayedName later
-				Conditions: conditionRules,
-			}
-		}
-		resp[action] = updateRules
-	}
-	return resp, nil
+	return false
 }
 
-func getRules() (map[string]entity.FieldValidation, error) {
-	data, err := os.ReadFile("./internal/validator/rules.yaml")
-	if err != nil {
-		return nil, err
-	}
-	var requirements map[string]entity.FieldValidation
-	err = json.Unmarshal(data, &requirements)
-	if err != nil {
-		return nil, err
-	}
-	return requirements, nil
-}
-
-func validateRequireEventInfo(eventMap map[string]string, rules map[string]entity.FieldRequireCondition) error {
+func validateRequireEventInfo(eventMap map[string]string, rules map[string]entity.FieldRequireCondition) []Violation {
+	violations := make([]Violation, 0)
 	for paramName, requireCondition := range rules {
-		if err := validateRequireField(eventMap, paramName, requireCondition); err != nil {
-			return err
+		if !isRequired(eventMap, requireCondition) {
+			continue
+		}
+		if eventMap[paramName] == "" {
+			violations = append(violations, Violation{Field: paramName, Rule: RuleRequired})
 		}
 	}
-	return nil
+	return violations
 }
 
-func validateRequireField(eventMap map[string]string, paramName string, requireCondition entity.FieldRequireCondition) error {
-	inputValue := eventMap[paramName]
+func isRequired(eventMap map[string]string, requireCondition entity.FieldRequireCondition) bool {
 	for _, condition := range requireCondition.Conditions {
-		parts := strings.Split(condition, ":")
+		parts := strings.SplitN(condition, ":", 2)
 		// check require = 1
-		if parts[0] == "require" && parts[1] == "1" && inputValue == "" {
-			return status.Errorf(codes.InvalidArgument, "Vui lòng nhập thông tin \"%s\"", requireCondition.Name)
-		} else if parts[0] != "require" {
-			// check depend field first
-			if eventMap[parts[0]] == parts[1] {
-				// check current field
-				if inputValue == "" {
-					return status.Errorf(codes.InvalidArgument, "Vui lòng nhập thông tin \"%s\"", requireCondition.Name)
-				}
+		if parts[0] == "require" {
+			if parts[1] == "1" {
+				return true
 			}
+		} else if eventMap[parts[0]] == parts[1] {
+			// the depend field has the value
+			return true
 		}
 	}
-	return nil
+	return false
 }
 
-func validateValueEventInfo(eventMap map[string]string, valueCondition map[string]entity.FieldValidation) error {
+func validateValueEventInfo(eventMap map[string]string, valueCondition map[string]entity.FieldValidation) []Violation {
+	violations := make([]Violation, 0)
 	for paramName, condition := range valueCondition {
-		if err := validateFieldValue(eventMap, paramName, condition); err != nil {
-			return err
-		}
+		violations = append(violations, validateFieldValue(eventMap[paramName], paramName, condition)...)
 	}
-	return nil
+	return violations
 }
 
-func validateFieldValue(eventMap map[string]string, paramName string, valueCondition entity.FieldValidation) error {
-	inputValue := eventMap[paramName]
-	if valueCondition.MinValue > 0 {
-		if inputValue != "" {
-			value, err := strconv.Atoi(inputValue)
-			if err != nil {
-				return err
-			}
-			if value < valueCondition.MinValue {
-				return fmt.Errorf("\"%s\" is required >= %d", valueCondition.Label, valueCondition.MinValue)
-			}
-		}
+func validateFieldValue(inputValue, paramName string, valueCondition entity.FieldValidation) []Violation {
+	if inputValue == "" {
+		return nil
 	}
-	if valueCondition.MaxValue > 0 {
-		if inputValue != "" {
-			value, err := strconv.Atoi(inputValue)
-			if err != nil {
-				return err
-			}
-			if value > valueCondition.MaxValue {
-				return fmt.Errorf("\"%s\" is required <= %d", valueCondition.Label, valueCondition.MaxValue)
-			}
-		}
+	violations := make([]Violation, 0)
+	add := func(rule string, limit int) {
+		violations = append(violations, Violation{Field: paramName, Rule: rule, Args: []string{strconv.Itoa(limit)}})
 	}
-	if valueCondition.MinLength > 0 {
-		if inputValue != "" {
-			if len(inputValue) < valueCondition.MinLength {
-				return fmt.Errorf("\"%s\" is required >= %d characters", valueCondition.Label, valueCondition.MinLength)
-			}
+	if valueCondition.MinValue > 0 || valueCondition.MaxValue > 0 {
+		value, err := strconv.Atoi(inputValue)
+		if err != nil {
+			violations = append(violations, Violation{Field: paramName, Rule: RuleNumber})
+		} else if valueCondition.MinValue > 0 && value < valueCondition.MinValue {
+			add(RuleMinValue, valueCondition.MinValue)
+		} else if valueCondition.MaxValue > 0 && value > valueCondition.MaxValue {
+			add(RuleMaxValue, valueCondition.MaxValue)
 		}
 	}
-	if valueCondition.MaxLength > 0 {
-		if inputValue != "" {
-			if len(inputValue) > valueCondition.MaxLength {
-				return fmt.Errorf("\"%s\" is required <= %d characters", valueCondition.Label, valueCondition.MaxLength)
-			}
-		}
+	if valueCondition.MinLength > 0 && len(inputValue) < valueCondition.MinLength {
+		add(RuleMinLength, valueCondition.MinLength)
 	}
-	if valueCondition.MinWord > 0 {
-		if inputValue != "" {
-			words := strings.Fields(inputValue)
-			if int(len(words)) < valueCondition.MinWord {
-				return fmt.Errorf("\"%s\" is required >= %d words", valueCondition.Label, valueCondition.MinWord)
-			}
-
-		}
+	if valueCondition.MaxLength > 0 && len(inputValue) > valueCondition.MaxLength {
+		add(RuleMaxLength, valueCondition.MaxLength)
 	}
-	if valueCondition.MaxWord > 0 {
-		if inputValue != "" {
-			words := strings.Fields(inputValue)
-			if int(len(words)) > valueCondition.MaxWord {
-				return fmt.Errorf("%s is required <= %d words", paramName, valueCondition.MaxWord)
-			}
-
-		}
+	words := len(strings.Fields(inputValue))
+	if valueCondition.MinWord > 0 && words < valueCondition.MinWord {
+		add(RuleMinWord, valueCondition.MinWord)
 	}
-	return nil
-}
-
-func registerCustomeRules() map[string][]entity.CrossFieldRule {
-	customValidators := make(map[string][]entity.CrossFieldRule)
-	rules := map[string][]entity.CrossFieldRule{
-		"method": {
-			{
-				AllowedValues: []string{"GET", "POST"},
-			},
-		},
-		"repeat_times": {
-			{
-				Operator: entity.OP_LTE,
-				Value:    "1",
-				ErrorMsg: "Số lần lặp tối thiểu >= 1",
-			},
-			{
-				Operator: entity.OP_GT,
-				Value:    "1000",
-				ErrorMsg: "Số lần lặp tối thiểu  < 1000",
-			},
-		},
-		"description": {
-			{
-				Value:    "2",
-				Operator: entity.OP_GTE,
-				ErrorMsg: "Độ dài text phải >= 2",
-			},
-		},
-		"scheduler_at": {
-			{
-				Field:    "next_run_time",
-				Operator: entity.OP_EQ,
-				ErrorMsg: "Thời gian trigger lần đầu phải trùng với next_run_time",
-			},
-			{
-				Pattern:  `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`,
-				ErrorMsg: "Thời gian trigger không đúng format",
-			},
-		},
+	if valueCondition.MaxWord > 0 && words > valueCondition.MaxWord {
+		add(RuleMaxWord, valueCondition.MaxWord)
 	}
-	maps.Copy(customValidators, rules)
-	return customValidators
+	return violations
 }
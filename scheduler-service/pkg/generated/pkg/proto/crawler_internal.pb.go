@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pkg/proto/crawler_internal.proto
+
+package schedulerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CrawlPreviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL of the event, the curl command for CURL
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// GET, POST, CURL or ROBOTS
+	Method        string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Queue         string `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Domain        string `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrawlPreviewRequest) Reset() {
+	*x = CrawlPreviewRequest{}
+	mi := &file_pkg_proto_crawler_internal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlPreviewRequest) ProtoMessage() {}
+
+func (x *CrawlPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_crawler_internal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlPreviewRequest.ProtoReflect.Descriptor instead.
+func (*CrawlPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_crawler_internal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CrawlPreviewRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CrawlPreviewRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CrawlPreviewRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *CrawlPreviewRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// CrawlPreview is one run of the fetch and the extraction, nothing is stored, notified or retried
+type CrawlPreview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status code of the response, 0 when no response was received
+	StatusCode int32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	FetchMs    int64 `protobuf:"varint,2,opt,name=fetch_ms,json=fetchMs,proto3" json:"fetch_ms,omitempty"`
+	ExtractMs  int64 `protobuf:"varint,3,opt,name=extract_ms,json=extractMs,proto3" json:"extract_ms,omitempty"`
+	DurationMs int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// fields extracted from the response, for CURL the result which would be stored
+	Record map[string]string `protobuf:"bytes,5,rep,name=record,proto3" json:"record,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// message which would be sent to Telegram, empty when nothing is sent
+	Notification string `protobuf:"bytes,6,opt,name=notification,proto3" json:"notification,omitempty"`
+	// robots.txt and scope rules broken by the event, the crawl would skip or fail on them
+	Violations []string `protobuf:"bytes,7,rep,name=violations,proto3" json:"violations,omitempty"`
+	// error of the fetch or of the extraction
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrawlPreview) Reset() {
+	*x = CrawlPreview{}
+	mi := &file_pkg_proto_crawler_internal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlPreview) ProtoMessage() {}
+
+func (x *CrawlPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_crawler_internal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlPreview.ProtoReflect.Descriptor instead.
+func (*CrawlPreview) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_crawler_internal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CrawlPreview) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CrawlPreview) GetFetchMs() int64 {
+	if x != nil {
+		return x.FetchMs
+	}
+	return 0
+}
+
+func (x *CrawlPreview) GetExtractMs() int64 {
+	if x != nil {
+		return x.ExtractMs
+	}
+	return 0
+}
+
+func (x *CrawlPreview) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *CrawlPreview) GetRecord() map[string]string {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *CrawlPreview) GetNotification() string {
+	if x != nil {
+		return x.Notification
+	}
+	return ""
+}
+
+func (x *CrawlPreview) GetViolations() []string {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *CrawlPreview) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_pkg_proto_crawler_internal_proto protoreflect.FileDescriptor
+
+const file_pkg_proto_crawler_internal_proto_rawDesc = "" +
+	"\n" +
+	" pkg/proto/crawler_internal.proto\x12\fscheduler.v1\"m\n" +
+	"\x13CrawlPreviewRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x14\n" +
+	"\x05queue\x18\x03 \x01(\tR\x05queue\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\"\xdf\x02\n" +
+	"\fCrawlPreview\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x19\n" +
+	"\bfetch_ms\x18\x02 \x01(\x03R\afetchMs\x12\x1d\n" +
+	"\n" +
+	"extract_ms\x18\x03 \x01(\x03R\textractMs\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\x12>\n" +
+	"\x06record\x18\x05 \x03(\v2&.scheduler.v1.CrawlPreview.RecordEntryR\x06record\x12\"\n" +
+	"\fnotification\x18\x06 \x01(\tR\fnotification\x12\x1e\n" +
+	"\n" +
+	"violations\x18\a \x03(\tR\n" +
+	"violations\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x1a9\n" +
+	"\vRecordEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012g\n" +
+	"\x16CrawlerInternalService\x12M\n" +
+	"\fPreviewCrawl\x12!.scheduler.v1.CrawlPreviewRequest\x1a\x1a.scheduler.v1.CrawlPreviewB\xa0\x01\n" +
+	"\x10com.scheduler.v1B\x14CrawlerInternalProtoP\x01Z%crawler-service/pkg/proto;schedulerv1\xa2\x02\x03SXX\xaa\x02\fScheduler.V1\xca\x02\fScheduler\\V1\xe2\x02\x18Scheduler\\V1\\GPBMetadata\xea\x02\rScheduler::V1b\x06proto3"
+
+var (
+	file_pkg_proto_crawler_internal_proto_rawDescOnce sync.Once
+	file_pkg_proto_crawler_internal_proto_rawDescData []byte
+)
+
+func file_pkg_proto_crawler_internal_proto_rawDescGZIP() []byte {
+	file_pkg_proto_crawler_internal_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_crawler_internal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_crawler_internal_proto_rawDesc), len(file_pkg_proto_crawler_internal_proto_rawDesc)))
+	})
+	return file_pkg_proto_crawler_internal_proto_rawDescData
+}
+
+var file_pkg_proto_crawler_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_proto_crawler_internal_proto_goTypes = []any{
+	(*CrawlPreviewRequest)(nil), // 0: scheduler.v1.CrawlPreviewRequest
+	(*CrawlPreview)(nil),        // 1: scheduler.v1.CrawlPreview
+	nil,                         // 2: scheduler.v1.CrawlPreview.RecordEntry
+}
+var file_pkg_proto_crawler_internal_proto_depIdxs = []int32{
+	2, // 0: scheduler.v1.CrawlPreview.record:type_name -> scheduler.v1.CrawlPreview.RecordEntry
+	0, // 1: scheduler.v1.CrawlerInternalService.PreviewCrawl:input_type -> scheduler.v1.CrawlPreviewRequest
+	1, // 2: scheduler.v1.CrawlerInternalService.PreviewCrawl:output_type -> scheduler.v1.CrawlPreview
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_crawler_internal_proto_init() }
+func file_pkg_proto_crawler_internal_proto_init() {
+	if File_pkg_proto_crawler_internal_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_crawler_internal_proto_rawDesc), len(file_pkg_proto_crawler_internal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_crawler_internal_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_crawler_internal_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_crawler_internal_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_crawler_internal_proto = out.File
+	file_pkg_proto_crawler_internal_proto_goTypes = nil
+	file_pkg_proto_crawler_internal_proto_depIdxs = nil
+}
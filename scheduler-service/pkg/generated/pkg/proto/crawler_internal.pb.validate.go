@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/proto/crawler_internal.proto
+
+package schedulerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CrawlPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CrawlPreviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CrawlPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CrawlPreviewRequestMultiError, or nil if none found.
+func (m *CrawlPreviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CrawlPreviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Method
+
+	// no validation rules for Queue
+
+	// no validation rules for Domain
+
+	if len(errors) > 0 {
+		return CrawlPreviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CrawlPreviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CrawlPreviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CrawlPreviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CrawlPreviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CrawlPreviewRequestMultiError) AllErrors() []error { return m }
+
+// CrawlPreviewRequestValidationError is the validation error returned by
+// CrawlPreviewRequest.Validate if the designated constraints aren't met.
+type CrawlPreviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CrawlPreviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CrawlPreviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CrawlPreviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CrawlPreviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CrawlPreviewRequestValidationError) ErrorName() string {
+	return "CrawlPreviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CrawlPreviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCrawlPreviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CrawlPreviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CrawlPreviewRequestValidationError{}
+
+// Validate checks the field values on CrawlPreview with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CrawlPreview) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CrawlPreview with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CrawlPreviewMultiError, or
+// nil if none found.
+func (m *CrawlPreview) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CrawlPreview) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for FetchMs
+
+	// no validation rules for ExtractMs
+
+	// no validation rules for DurationMs
+
+	// no validation rules for Record
+
+	// no validation rules for Notification
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return CrawlPreviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// CrawlPreviewMultiError is an error wrapping multiple validation errors
+// returned by CrawlPreview.ValidateAll() if the designated constraints aren't met.
+type CrawlPreviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CrawlPreviewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CrawlPreviewMultiError) AllErrors() []error { return m }
+
+// CrawlPreviewValidationError is the validation error returned by
+// CrawlPreview.Validate if the designated constraints aren't met.
+type CrawlPreviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CrawlPreviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CrawlPreviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CrawlPreviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CrawlPreviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CrawlPreviewValidationError) ErrorName() string { return "CrawlPreviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CrawlPreviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCrawlPreview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CrawlPreviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CrawlPreviewValidationError{}
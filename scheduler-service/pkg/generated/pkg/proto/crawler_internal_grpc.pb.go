@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: pkg/proto/crawler_internal.proto
+
+package schedulerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CrawlerInternalService_PreviewCrawl_FullMethodName = "/scheduler.v1.CrawlerInternalService/PreviewCrawl"
+)
+
+// CrawlerInternalServiceClient is the client API for CrawlerInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CrawlerInternalServiceClient interface {
+	// PreviewCrawl runs the crawl of the event once and returns what it yields
+	PreviewCrawl(ctx context.Context, in *CrawlPreviewRequest, opts ...grpc.CallOption) (*CrawlPreview, error)
+}
+
+type crawlerInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCrawlerInternalServiceClient(cc grpc.ClientConnInterface) CrawlerInternalServiceClient {
+	return &crawlerInternalServiceClient{cc}
+}
+
+func (c *crawlerInternalServiceClient) PreviewCrawl(ctx context.Context, in *CrawlPreviewRequest, opts ...grpc.CallOption) (*CrawlPreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CrawlPreview)
+	err := c.cc.Invoke(ctx, CrawlerInternalService_PreviewCrawl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CrawlerInternalServiceServer is the server API for CrawlerInternalService service.
+// All implementations must embed UnimplementedCrawlerInternalServiceServer
+// for forward compatibility.
+type CrawlerInternalServiceServer interface {
+	// PreviewCrawl runs the crawl of the event once and returns what it yields
+	PreviewCrawl(context.Context, *CrawlPreviewRequest) (*CrawlPreview, error)
+	mustEmbedUnimplementedCrawlerInternalServiceServer()
+}
+
+// UnimplementedCrawlerInternalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCrawlerInternalServiceServer struct{}
+
+func (UnimplementedCrawlerInternalServiceServer) PreviewCrawl(context.Context, *CrawlPreviewRequest) (*CrawlPreview, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewCrawl not implemented")
+}
+func (UnimplementedCrawlerInternalServiceServer) mustEmbedUnimplementedCrawlerInternalServiceServer() {
+}
+func (UnimplementedCrawlerInternalServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCrawlerInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CrawlerInternalServiceServer will
+// result in compilation errors.
+type UnsafeCrawlerInternalServiceServer interface {
+	mustEmbedUnimplementedCrawlerInternalServiceServer()
+}
+
+func RegisterCrawlerInternalServiceServer(s grpc.ServiceRegistrar, srv CrawlerInternalServiceServer) {
+	// If the following call panics, it indicates UnimplementedCrawlerInternalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CrawlerInternalService_ServiceDesc, srv)
+}
+
+func _CrawlerInternalService_PreviewCrawl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrawlPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerInternalServiceServer).PreviewCrawl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrawlerInternalService_PreviewCrawl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerInternalServiceServer).PreviewCrawl(ctx, req.(*CrawlPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CrawlerInternalService_ServiceDesc is the grpc.ServiceDesc for CrawlerInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CrawlerInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.v1.CrawlerInternalService",
+	HandlerType: (*CrawlerInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewCrawl",
+			Handler:    _CrawlerInternalService_PreviewCrawl_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/crawler_internal.proto",
+}
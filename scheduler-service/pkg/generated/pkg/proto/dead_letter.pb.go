@@ -0,0 +1,967 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pkg/proto/dead_letter.proto
+
+package schedulerv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeadLetter is a message given up by the crawler workers: an event still failing after its retries
+// or a message the workers cannot read. It is pending until it is replayed or discarded.
+type DeadLetter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	// event of the payload, 0 when the payload is not an event
+	EventId int64 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// run of the payload
+	RunId string `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// position of the message in the topic of its queue, empty for the events which failed their retries
+	Topic     string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	Partition int32  `protobuf:"varint,6,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset    int64  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key       string `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+	// message of the topic, base64 encoded when payload_base64 is set: a message which is not text
+	Payload string `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"`
+	// reason of the failure
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// crawls of the message before it was given up
+	Attempts int64 `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// pending, replayed or discarded
+	Status        string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	Replays       int32  `protobuf:"varint,13,opt,name=replays,proto3" json:"replays,omitempty"`
+	FailedAt      string `protobuf:"bytes,14,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	ReplayedAt    string `protobuf:"bytes,15,opt,name=replayed_at,json=replayedAt,proto3" json:"replayed_at,omitempty"`
+	DiscardedAt   string `protobuf:"bytes,16,opt,name=discarded_at,json=discardedAt,proto3" json:"discarded_at,omitempty"`
+	CreatedAt     string `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PayloadBase64 bool   `protobuf:"varint,19,opt,name=payload_base64,json=payloadBase64,proto3" json:"payload_base64,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadLetter) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetReplays() int32 {
+	if x != nil {
+		return x.Replays
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetFailedAt() string {
+	if x != nil {
+		return x.FailedAt
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetReplayedAt() string {
+	if x != nil {
+		return x.ReplayedAt
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetDiscardedAt() string {
+	if x != nil {
+		return x.DiscardedAt
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayloadBase64() bool {
+	if x != nil {
+		return x.PayloadBase64
+	}
+	return false
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	EventId       int64                  `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters   []*DeadLetter          `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetterRequest) Reset() {
+	*x = GetDeadLetterRequest{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterRequest) ProtoMessage() {}
+
+func (x *GetDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDeadLetterRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetter    *DeadLetter            `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetterResponse) Reset() {
+	*x = GetDeadLetterResponse{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterResponse) ProtoMessage() {}
+
+func (x *GetDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDeadLetterResponse) GetDeadLetter() *DeadLetter {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+type ReplayDeadLetterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// force replays a dead letter which is already replayed
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetterRequest) Reset() {
+	*x = ReplayDeadLetterRequest{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplayDeadLetterRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplayDeadLetterRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ReplayDeadLetterResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetter *DeadLetter            `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	// run of the replay, its result is in the run history of the event
+	RunId         string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetterResponse) Reset() {
+	*x = ReplayDeadLetterResponse{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReplayDeadLetterResponse) GetDeadLetter() *DeadLetter {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+func (x *ReplayDeadLetterResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// BulkReplayDeadLettersRequest replays the given dead letters, or the newest pending ones of the queue when ids is empty
+type BulkReplayDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Queue string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	// dead letters of the queue to replay, the server caps it
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// force replays the given dead letters which are already replayed
+	Force         bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkReplayDeadLettersRequest) Reset() {
+	*x = BulkReplayDeadLettersRequest{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *BulkReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*BulkReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BulkReplayDeadLettersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BulkReplayDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *BulkReplayDeadLettersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *BulkReplayDeadLettersRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeadLetterReplay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// run of the replay, empty when the dead letter is not replayed
+	RunId string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// reason the dead letter is not replayed
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterReplay) Reset() {
+	*x = DeadLetterReplay{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterReplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterReplay) ProtoMessage() {}
+
+func (x *DeadLetterReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterReplay.ProtoReflect.Descriptor instead.
+func (*DeadLetterReplay) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeadLetterReplay) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetterReplay) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DeadLetterReplay) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replays       []*DeadLetterReplay    `protobuf:"bytes,1,rep,name=replays,proto3" json:"replays,omitempty"`
+	Replayed      int32                  `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkReplayDeadLettersResponse) Reset() {
+	*x = BulkReplayDeadLettersResponse{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *BulkReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*BulkReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BulkReplayDeadLettersResponse) GetReplays() []*DeadLetterReplay {
+	if x != nil {
+		return x.Replays
+	}
+	return nil
+}
+
+func (x *BulkReplayDeadLettersResponse) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+type DiscardDeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDeadLetterRequest) Reset() {
+	*x = DiscardDeadLetterRequest{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDeadLetterRequest) ProtoMessage() {}
+
+func (x *DiscardDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiscardDeadLetterRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DiscardDeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetter    *DeadLetter            `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDeadLetterResponse) Reset() {
+	*x = DiscardDeadLetterResponse{}
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDeadLetterResponse) ProtoMessage() {}
+
+func (x *DiscardDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_dead_letter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_dead_letter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiscardDeadLetterResponse) GetDeadLetter() *DeadLetter {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+var File_pkg_proto_dead_letter_proto protoreflect.FileDescriptor
+
+const file_pkg_proto_dead_letter_proto_rawDesc = "" +
+	"\n" +
+	"\x1bpkg/proto/dead_letter.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\x86\x04\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x03R\aeventId\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05topic\x18\x05 \x01(\tR\x05topic\x12\x1c\n" +
+	"\tpartition\x18\x06 \x01(\x05R\tpartition\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x03R\x06offset\x12\x10\n" +
+	"\x03key\x18\b \x01(\tR\x03key\x12\x18\n" +
+	"\apayload\x18\t \x01(\tR\apayload\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\v \x01(\x03R\battempts\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x18\n" +
+	"\areplays\x18\r \x01(\x05R\areplays\x12\x1b\n" +
+	"\tfailed_at\x18\x0e \x01(\tR\bfailedAt\x12\x1f\n" +
+	"\vreplayed_at\x18\x0f \x01(\tR\n" +
+	"replayedAt\x12!\n" +
+	"\fdiscarded_at\x18\x10 \x01(\tR\vdiscardedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\tR\tupdatedAt\x12%\n" +
+	"\x0epayload_base64\x18\x13 \x01(\bR\rpayloadBase64\"\xd9\x01\n" +
+	"\x16ListDeadLettersRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12>\n" +
+	"\x06status\x18\x02 \x01(\tB&\xfaB#r!R\apendingR\breplayedR\tdiscarded\xd0\x01\x01R\x06status\x12\"\n" +
+	"\bevent_id\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aeventId\x12&\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"~\n" +
+	"\x17ListDeadLettersResponse\x12;\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x18.scheduler.v1.DeadLetterR\vdeadLetters\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
+	"\x14GetDeadLetterRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"R\n" +
+	"\x15GetDeadLetterResponse\x129\n" +
+	"\vdead_letter\x18\x01 \x01(\v2\x18.scheduler.v1.DeadLetterR\n" +
+	"deadLetter\"H\n" +
+	"\x17ReplayDeadLetterRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"l\n" +
+	"\x18ReplayDeadLetterResponse\x129\n" +
+	"\vdead_letter\x18\x01 \x01(\v2\x18.scheduler.v1.DeadLetterR\n" +
+	"deadLetter\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\x8c\x01\n" +
+	"\x1cBulkReplayDeadLettersRequest\x12!\n" +
+	"\x03ids\x18\x01 \x03(\x03B\x0f\xfaB\f\x92\x01\t\x10\xe8\a\"\x04\"\x02 \x00R\x03ids\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\x05limit\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05limit\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\"O\n" +
+	"\x10DeadLetterReplay\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"u\n" +
+	"\x1dBulkReplayDeadLettersResponse\x128\n" +
+	"\areplays\x18\x01 \x03(\v2\x1e.scheduler.v1.DeadLetterReplayR\areplays\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\x05R\breplayed\"3\n" +
+	"\x18DiscardDeadLetterRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"V\n" +
+	"\x19DiscardDeadLetterResponse\x129\n" +
+	"\vdead_letter\x18\x01 \x01(\v2\x18.scheduler.v1.DeadLetterR\n" +
+	"deadLetter2\xc9\x05\n" +
+	"\x11DeadLetterService\x12|\n" +
+	"\x0fListDeadLetters\x12$.scheduler.v1.ListDeadLettersRequest\x1a%.scheduler.v1.ListDeadLettersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/dead_letters\x12{\n" +
+	"\rGetDeadLetter\x12\".scheduler.v1.GetDeadLetterRequest\x1a#.scheduler.v1.GetDeadLetterResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/dead_letters/{id}\x12\x8b\x01\n" +
+	"\x10ReplayDeadLetter\x12%.scheduler.v1.ReplayDeadLetterRequest\x1a&.scheduler.v1.ReplayDeadLetterResponse\"(\x82\xd3\xe4\x93\x02\"\" /api/v1/dead_letters/{id}/replay\x12\x98\x01\n" +
+	"\x15BulkReplayDeadLetters\x12*.scheduler.v1.BulkReplayDeadLettersRequest\x1a+.scheduler.v1.BulkReplayDeadLettersResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/dead_letters:replay\x12\x8f\x01\n" +
+	"\x11DiscardDeadLetter\x12&.scheduler.v1.DiscardDeadLetterRequest\x1a'.scheduler.v1.DiscardDeadLetterResponse\")\x82\xd3\xe4\x93\x02#\"!/api/v1/dead_letters/{id}/discardB\x9b\x01\n" +
+	"\x10com.scheduler.v1B\x0fDeadLetterProtoP\x01Z%crawler-service/pkg/proto;schedulerv1\xa2\x02\x03SXX\xaa\x02\fScheduler.V1\xca\x02\fScheduler\\V1\xe2\x02\x18Scheduler\\V1\\GPBMetadata\xea\x02\rScheduler::V1b\x06proto3"
+
+var (
+	file_pkg_proto_dead_letter_proto_rawDescOnce sync.Once
+	file_pkg_proto_dead_letter_proto_rawDescData []byte
+)
+
+func file_pkg_proto_dead_letter_proto_rawDescGZIP() []byte {
+	file_pkg_proto_dead_letter_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_dead_letter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_dead_letter_proto_rawDesc), len(file_pkg_proto_dead_letter_proto_rawDesc)))
+	})
+	return file_pkg_proto_dead_letter_proto_rawDescData
+}
+
+var file_pkg_proto_dead_letter_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_pkg_proto_dead_letter_proto_goTypes = []any{
+	(*DeadLetter)(nil),                    // 0: scheduler.v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),        // 1: scheduler.v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),       // 2: scheduler.v1.ListDeadLettersResponse
+	(*GetDeadLetterRequest)(nil),          // 3: scheduler.v1.GetDeadLetterRequest
+	(*GetDeadLetterResponse)(nil),         // 4: scheduler.v1.GetDeadLetterResponse
+	(*ReplayDeadLetterRequest)(nil),       // 5: scheduler.v1.ReplayDeadLetterRequest
+	(*ReplayDeadLetterResponse)(nil),      // 6: scheduler.v1.ReplayDeadLetterResponse
+	(*BulkReplayDeadLettersRequest)(nil),  // 7: scheduler.v1.BulkReplayDeadLettersRequest
+	(*DeadLetterReplay)(nil),              // 8: scheduler.v1.DeadLetterReplay
+	(*BulkReplayDeadLettersResponse)(nil), // 9: scheduler.v1.BulkReplayDeadLettersResponse
+	(*DiscardDeadLetterRequest)(nil),      // 10: scheduler.v1.DiscardDeadLetterRequest
+	(*DiscardDeadLetterResponse)(nil),     // 11: scheduler.v1.DiscardDeadLetterResponse
+}
+var file_pkg_proto_dead_letter_proto_depIdxs = []int32{
+	0,  // 0: scheduler.v1.ListDeadLettersResponse.dead_letters:type_name -> scheduler.v1.DeadLetter
+	0,  // 1: scheduler.v1.GetDeadLetterResponse.dead_letter:type_name -> scheduler.v1.DeadLetter
+	0,  // 2: scheduler.v1.ReplayDeadLetterResponse.dead_letter:type_name -> scheduler.v1.DeadLetter
+	8,  // 3: scheduler.v1.BulkReplayDeadLettersResponse.replays:type_name -> scheduler.v1.DeadLetterReplay
+	0,  // 4: scheduler.v1.DiscardDeadLetterResponse.dead_letter:type_name -> scheduler.v1.DeadLetter
+	1,  // 5: scheduler.v1.DeadLetterService.ListDeadLetters:input_type -> scheduler.v1.ListDeadLettersRequest
+	3,  // 6: scheduler.v1.DeadLetterService.GetDeadLetter:input_type -> scheduler.v1.GetDeadLetterRequest
+	5,  // 7: scheduler.v1.DeadLetterService.ReplayDeadLetter:input_type -> scheduler.v1.ReplayDeadLetterRequest
+	7,  // 8: scheduler.v1.DeadLetterService.BulkReplayDeadLetters:input_type -> scheduler.v1.BulkReplayDeadLettersRequest
+	10, // 9: scheduler.v1.DeadLetterService.DiscardDeadLetter:input_type -> scheduler.v1.DiscardDeadLetterRequest
+	2,  // 10: scheduler.v1.DeadLetterService.ListDeadLetters:output_type -> scheduler.v1.ListDeadLettersResponse
+	4,  // 11: scheduler.v1.DeadLetterService.GetDeadLetter:output_type -> scheduler.v1.GetDeadLetterResponse
+	6,  // 12: scheduler.v1.DeadLetterService.ReplayDeadLetter:output_type -> scheduler.v1.ReplayDeadLetterResponse
+	9,  // 13: scheduler.v1.DeadLetterService.BulkReplayDeadLetters:output_type -> scheduler.v1.BulkReplayDeadLettersResponse
+	11, // 14: scheduler.v1.DeadLetterService.DiscardDeadLetter:output_type -> scheduler.v1.DiscardDeadLetterResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_dead_letter_proto_init() }
+func file_pkg_proto_dead_letter_proto_init() {
+	if File_pkg_proto_dead_letter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_dead_letter_proto_rawDesc), len(file_pkg_proto_dead_letter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_dead_letter_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_dead_letter_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_dead_letter_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_dead_letter_proto = out.File
+	file_pkg_proto_dead_letter_proto_goTypes = nil
+	file_pkg_proto_dead_letter_proto_depIdxs = nil
+}
@@ -0,0 +1,1586 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/proto/dead_letter.proto
+
+package schedulerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeadLetter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeadLetterMultiError, or
+// nil if none found.
+func (m *DeadLetter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Queue
+
+	// no validation rules for EventId
+
+	// no validation rules for RunId
+
+	// no validation rules for Topic
+
+	// no validation rules for Partition
+
+	// no validation rules for Offset
+
+	// no validation rules for Key
+
+	// no validation rules for Payload
+
+	// no validation rules for Error
+
+	// no validation rules for Attempts
+
+	// no validation rules for Status
+
+	// no validation rules for Replays
+
+	// no validation rules for FailedAt
+
+	// no validation rules for ReplayedAt
+
+	// no validation rules for DiscardedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for PayloadBase64
+
+	if len(errors) > 0 {
+		return DeadLetterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterMultiError is an error wrapping multiple validation errors
+// returned by DeadLetter.ValidateAll() if the designated constraints aren't met.
+type DeadLetterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterMultiError) AllErrors() []error { return m }
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
+// Validate checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersRequestMultiError, or nil if none found.
+func (m *ListDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queue
+
+	if m.GetStatus() != "" {
+
+		if _, ok := _ListDeadLettersRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := ListDeadLettersRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [pending replayed discarded]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEventId() < 0 {
+		err := ListDeadLettersRequestValidationError{
+			field:  "EventId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListDeadLettersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersRequestValidationError is the validation error returned by
+// ListDeadLettersRequest.Validate if the designated constraints aren't met.
+type ListDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersRequestValidationError) ErrorName() string {
+	return "ListDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersRequestValidationError{}
+
+var _ListDeadLettersRequest_Status_InLookup = map[string]struct{}{
+	"pending":   {},
+	"replayed":  {},
+	"discarded": {},
+}
+
+// Validate checks the field values on ListDeadLettersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersResponseMultiError, or nil if none found.
+func (m *ListDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeadLetters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeadLettersResponseValidationError{
+					field:  fmt.Sprintf("DeadLetters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersResponseValidationError is the validation error returned by
+// ListDeadLettersResponse.Validate if the designated constraints aren't met.
+type ListDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersResponseValidationError) ErrorName() string {
+	return "ListDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersResponseValidationError{}
+
+// Validate checks the field values on GetDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeadLetterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeadLetterRequestMultiError, or nil if none found.
+func (m *GetDeadLetterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeadLetterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetDeadLetterRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDeadLetterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeadLetterRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeadLetterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeadLetterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeadLetterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeadLetterRequestMultiError) AllErrors() []error { return m }
+
+// GetDeadLetterRequestValidationError is the validation error returned by
+// GetDeadLetterRequest.Validate if the designated constraints aren't met.
+type GetDeadLetterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadLetterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadLetterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadLetterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadLetterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadLetterRequestValidationError) ErrorName() string {
+	return "GetDeadLetterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadLetterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadLetterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadLetterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadLetterRequestValidationError{}
+
+// Validate checks the field values on GetDeadLetterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeadLetterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeadLetterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeadLetterResponseMultiError, or nil if none found.
+func (m *GetDeadLetterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeadLetterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeadLetter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadLetter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeadLetterResponseValidationError{
+				field:  "DeadLetter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDeadLetterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeadLetterResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeadLetterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeadLetterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeadLetterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeadLetterResponseMultiError) AllErrors() []error { return m }
+
+// GetDeadLetterResponseValidationError is the validation error returned by
+// GetDeadLetterResponse.Validate if the designated constraints aren't met.
+type GetDeadLetterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadLetterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadLetterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadLetterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadLetterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadLetterResponseValidationError) ErrorName() string {
+	return "GetDeadLetterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadLetterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadLetterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadLetterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadLetterResponseValidationError{}
+
+// Validate checks the field values on ReplayDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLetterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLetterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLetterRequestMultiError, or nil if none found.
+func (m *ReplayDeadLetterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLetterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ReplayDeadLetterRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return ReplayDeadLetterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLetterRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLetterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLetterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLetterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLetterRequestMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLetterRequestValidationError is the validation error returned by
+// ReplayDeadLetterRequest.Validate if the designated constraints aren't met.
+type ReplayDeadLetterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLetterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLetterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLetterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLetterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLetterRequestValidationError) ErrorName() string {
+	return "ReplayDeadLetterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLetterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLetterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLetterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLetterRequestValidationError{}
+
+// Validate checks the field values on ReplayDeadLetterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLetterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLetterResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLetterResponseMultiError, or nil if none found.
+func (m *ReplayDeadLetterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLetterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeadLetter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplayDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplayDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadLetter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplayDeadLetterResponseValidationError{
+				field:  "DeadLetter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RunId
+
+	if len(errors) > 0 {
+		return ReplayDeadLetterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLetterResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLetterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLetterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLetterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLetterResponseMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLetterResponseValidationError is the validation error returned by
+// ReplayDeadLetterResponse.Validate if the designated constraints aren't met.
+type ReplayDeadLetterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLetterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLetterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLetterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLetterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLetterResponseValidationError) ErrorName() string {
+	return "ReplayDeadLetterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLetterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLetterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLetterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLetterResponseValidationError{}
+
+// Validate checks the field values on BulkReplayDeadLettersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkReplayDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkReplayDeadLettersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkReplayDeadLettersRequestMultiError, or nil if none found.
+func (m *BulkReplayDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkReplayDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) > 1000 {
+		err := BulkReplayDeadLettersRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := BulkReplayDeadLettersRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Queue
+
+	if m.GetLimit() < 0 {
+		err := BulkReplayDeadLettersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return BulkReplayDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkReplayDeadLettersRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkReplayDeadLettersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BulkReplayDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkReplayDeadLettersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkReplayDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// BulkReplayDeadLettersRequestValidationError is the validation error returned
+// by BulkReplayDeadLettersRequest.Validate if the designated constraints
+// aren't met.
+type BulkReplayDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkReplayDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkReplayDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkReplayDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkReplayDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkReplayDeadLettersRequestValidationError) ErrorName() string {
+	return "BulkReplayDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkReplayDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkReplayDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkReplayDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkReplayDeadLettersRequestValidationError{}
+
+// Validate checks the field values on DeadLetterReplay with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeadLetterReplay) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetterReplay with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeadLetterReplayMultiError, or nil if none found.
+func (m *DeadLetterReplay) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetterReplay) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RunId
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return DeadLetterReplayMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterReplayMultiError is an error wrapping multiple validation errors
+// returned by DeadLetterReplay.ValidateAll() if the designated constraints
+// aren't met.
+type DeadLetterReplayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterReplayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterReplayMultiError) AllErrors() []error { return m }
+
+// DeadLetterReplayValidationError is the validation error returned by
+// DeadLetterReplay.Validate if the designated constraints aren't met.
+type DeadLetterReplayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterReplayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterReplayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterReplayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterReplayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterReplayValidationError) ErrorName() string { return "DeadLetterReplayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterReplayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetterReplay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterReplayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterReplayValidationError{}
+
+// Validate checks the field values on BulkReplayDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkReplayDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkReplayDeadLettersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkReplayDeadLettersResponseMultiError, or nil if none found.
+func (m *BulkReplayDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkReplayDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReplays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkReplayDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("Replays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkReplayDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("Replays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkReplayDeadLettersResponseValidationError{
+					field:  fmt.Sprintf("Replays[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Replayed
+
+	if len(errors) > 0 {
+		return BulkReplayDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkReplayDeadLettersResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkReplayDeadLettersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BulkReplayDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkReplayDeadLettersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkReplayDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// BulkReplayDeadLettersResponseValidationError is the validation error
+// returned by BulkReplayDeadLettersResponse.Validate if the designated
+// constraints aren't met.
+type BulkReplayDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkReplayDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkReplayDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkReplayDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkReplayDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkReplayDeadLettersResponseValidationError) ErrorName() string {
+	return "BulkReplayDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkReplayDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkReplayDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkReplayDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkReplayDeadLettersResponseValidationError{}
+
+// Validate checks the field values on DiscardDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscardDeadLetterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscardDeadLetterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscardDeadLetterRequestMultiError, or nil if none found.
+func (m *DiscardDeadLetterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscardDeadLetterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DiscardDeadLetterRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiscardDeadLetterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscardDeadLetterRequestMultiError is an error wrapping multiple validation
+// errors returned by DiscardDeadLetterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiscardDeadLetterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscardDeadLetterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscardDeadLetterRequestMultiError) AllErrors() []error { return m }
+
+// DiscardDeadLetterRequestValidationError is the validation error returned by
+// DiscardDeadLetterRequest.Validate if the designated constraints aren't met.
+type DiscardDeadLetterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscardDeadLetterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscardDeadLetterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscardDeadLetterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscardDeadLetterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscardDeadLetterRequestValidationError) ErrorName() string {
+	return "DiscardDeadLetterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscardDeadLetterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscardDeadLetterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscardDeadLetterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscardDeadLetterRequestValidationError{}
+
+// Validate checks the field values on DiscardDeadLetterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscardDeadLetterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscardDeadLetterResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscardDeadLetterResponseMultiError, or nil if none found.
+func (m *DiscardDeadLetterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscardDeadLetterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeadLetter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscardDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscardDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadLetter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscardDeadLetterResponseValidationError{
+				field:  "DeadLetter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscardDeadLetterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscardDeadLetterResponseMultiError is an error wrapping multiple validation
+// errors returned by DiscardDeadLetterResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DiscardDeadLetterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscardDeadLetterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscardDeadLetterResponseMultiError) AllErrors() []error { return m }
+
+// DiscardDeadLetterResponseValidationError is the validation error returned by
+// DiscardDeadLetterResponse.Validate if the designated constraints aren't met.
+type DiscardDeadLetterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscardDeadLetterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscardDeadLetterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscardDeadLetterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscardDeadLetterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscardDeadLetterResponseValidationError) ErrorName() string {
+	return "DiscardDeadLetterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscardDeadLetterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscardDeadLetterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscardDeadLetterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscardDeadLetterResponseValidationError{}
@@ -0,0 +1,685 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pkg/proto/queue.proto
+
+package schedulerv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Queue is a queue of the crawler workers, the workers reload the queues without a redeploy.
+// The settings left at 0 take the defaults: weight 1, rate limit 10 and max concurrency 1.
+type Queue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the queue in the events, it is set by the path on update
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kafka topic of the queue, the name when empty. It cannot change once the queue is created.
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// share of the crawler workers against the other queues, above 1 is a priority queue for the tenant quotas
+	Weight int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// messages read from the topic per second by a worker
+	RateLimit int32 `protobuf:"varint,4,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// crawls of the queue running at once on a worker
+	MaxConcurrency int32  `protobuf:"varint,5,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	CreatedAt      string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Queue) Reset() {
+	*x = Queue{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Queue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queue) ProtoMessage() {}
+
+func (x *Queue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queue.ProtoReflect.Descriptor instead.
+func (*Queue) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Queue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Queue) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Queue) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Queue) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *Queue) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *Queue) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Queue) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *Queue                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQueueRequest) Reset() {
+	*x = CreateQueueRequest{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueueRequest) ProtoMessage() {}
+
+func (x *CreateQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueueRequest.ProtoReflect.Descriptor instead.
+func (*CreateQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateQueueRequest) GetQueue() *Queue {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type CreateQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *Queue                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQueueResponse) Reset() {
+	*x = CreateQueueResponse{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueueResponse) ProtoMessage() {}
+
+func (x *CreateQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueueResponse.ProtoReflect.Descriptor instead.
+func (*CreateQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateQueueResponse) GetQueue() *Queue {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type GetQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueRequest) Reset() {
+	*x = GetQueueRequest{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueRequest) ProtoMessage() {}
+
+func (x *GetQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetQueueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *Queue                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueResponse) Reset() {
+	*x = GetQueueResponse{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueResponse) ProtoMessage() {}
+
+func (x *GetQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetQueueResponse) GetQueue() *Queue {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type ListQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesRequest) ProtoMessage() {}
+
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{5}
+}
+
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*Queue               `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse) ProtoMessage() {}
+
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListQueuesResponse) GetQueues() []*Queue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type UpdateQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Queue         *Queue                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQueueRequest) Reset() {
+	*x = UpdateQueueRequest{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQueueRequest) ProtoMessage() {}
+
+func (x *UpdateQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQueueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateQueueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateQueueRequest) GetQueue() *Queue {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type UpdateQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *Queue                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQueueResponse) Reset() {
+	*x = UpdateQueueResponse{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQueueResponse) ProtoMessage() {}
+
+func (x *UpdateQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQueueResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateQueueResponse) GetQueue() *Queue {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type DeleteQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQueueRequest) Reset() {
+	*x = DeleteQueueRequest{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQueueRequest) ProtoMessage() {}
+
+func (x *DeleteQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQueueRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteQueueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQueueResponse) Reset() {
+	*x = DeleteQueueResponse{}
+	mi := &file_pkg_proto_queue_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQueueResponse) ProtoMessage() {}
+
+func (x *DeleteQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_queue_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQueueResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_queue_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteQueueResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_pkg_proto_queue_proto protoreflect.FileDescriptor
+
+const file_pkg_proto_queue_proto_rawDesc = "" +
+	"\n" +
+	"\x15pkg/proto/queue.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xbb\x02\n" +
+	"\x05Queue\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xfaB!r\x1f2\x1a^[a-z0-9][a-z0-9_-]{0,63}$\xd0\x01\x01R\x04name\x127\n" +
+	"\x05topic\x18\x02 \x01(\tB!\xfaB\x1er\x1c2\x17^[a-zA-Z0-9._-]{1,249}$\xd0\x01\x01R\x05topic\x12!\n" +
+	"\x06weight\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x06weight\x12)\n" +
+	"\n" +
+	"rate_limit\x18\x04 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x00R\trateLimit\x123\n" +
+	"\x0fmax_concurrency\x18\x05 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x0emaxConcurrency\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"I\n" +
+	"\x12CreateQueueRequest\x123\n" +
+	"\x05queue\x18\x01 \x01(\v2\x13.scheduler.v1.QueueB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05queue\"@\n" +
+	"\x13CreateQueueResponse\x12)\n" +
+	"\x05queue\x18\x01 \x01(\v2\x13.scheduler.v1.QueueR\x05queue\".\n" +
+	"\x0fGetQueueRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\"=\n" +
+	"\x10GetQueueResponse\x12)\n" +
+	"\x05queue\x18\x01 \x01(\v2\x13.scheduler.v1.QueueR\x05queue\"\x13\n" +
+	"\x11ListQueuesRequest\"A\n" +
+	"\x12ListQueuesResponse\x12+\n" +
+	"\x06queues\x18\x01 \x03(\v2\x13.scheduler.v1.QueueR\x06queues\"f\n" +
+	"\x12UpdateQueueRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x123\n" +
+	"\x05queue\x18\x02 \x01(\v2\x13.scheduler.v1.QueueB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05queue\"@\n" +
+	"\x13UpdateQueueResponse\x12)\n" +
+	"\x05queue\x18\x01 \x01(\v2\x13.scheduler.v1.QueueR\x05queue\"1\n" +
+	"\x12DeleteQueueRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\"-\n" +
+	"\x13DeleteQueueResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status2\xc1\x04\n" +
+	"\fQueueService\x12q\n" +
+	"\vCreateQueue\x12 .scheduler.v1.CreateQueueRequest\x1a!.scheduler.v1.CreateQueueResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x05queue\"\x0e/api/v1/queues\x12h\n" +
+	"\bGetQueue\x12\x1d.scheduler.v1.GetQueueRequest\x1a\x1e.scheduler.v1.GetQueueResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/queues/{name}\x12g\n" +
+	"\n" +
+	"ListQueues\x12\x1f.scheduler.v1.ListQueuesRequest\x1a .scheduler.v1.ListQueuesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/queues\x12x\n" +
+	"\vUpdateQueue\x12 .scheduler.v1.UpdateQueueRequest\x1a!.scheduler.v1.UpdateQueueResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x05queue\x1a\x15/api/v1/queues/{name}\x12q\n" +
+	"\vDeleteQueue\x12 .scheduler.v1.DeleteQueueRequest\x1a!.scheduler.v1.DeleteQueueResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/queues/{name}B\x96\x01\n" +
+	"\x10com.scheduler.v1B\n" +
+	"QueueProtoP\x01Z%crawler-service/pkg/proto;schedulerv1\xa2\x02\x03SXX\xaa\x02\fScheduler.V1\xca\x02\fScheduler\\V1\xe2\x02\x18Scheduler\\V1\\GPBMetadata\xea\x02\rScheduler::V1b\x06proto3"
+
+var (
+	file_pkg_proto_queue_proto_rawDescOnce sync.Once
+	file_pkg_proto_queue_proto_rawDescData []byte
+)
+
+func file_pkg_proto_queue_proto_rawDescGZIP() []byte {
+	file_pkg_proto_queue_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_queue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_queue_proto_rawDesc), len(file_pkg_proto_queue_proto_rawDesc)))
+	})
+	return file_pkg_proto_queue_proto_rawDescData
+}
+
+var file_pkg_proto_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_pkg_proto_queue_proto_goTypes = []any{
+	(*Queue)(nil),               // 0: scheduler.v1.Queue
+	(*CreateQueueRequest)(nil),  // 1: scheduler.v1.CreateQueueRequest
+	(*CreateQueueResponse)(nil), // 2: scheduler.v1.CreateQueueResponse
+	(*GetQueueRequest)(nil),     // 3: scheduler.v1.GetQueueRequest
+	(*GetQueueResponse)(nil),    // 4: scheduler.v1.GetQueueResponse
+	(*ListQueuesRequest)(nil),   // 5: scheduler.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),  // 6: scheduler.v1.ListQueuesResponse
+	(*UpdateQueueRequest)(nil),  // 7: scheduler.v1.UpdateQueueRequest
+	(*UpdateQueueResponse)(nil), // 8: scheduler.v1.UpdateQueueResponse
+	(*DeleteQueueRequest)(nil),  // 9: scheduler.v1.DeleteQueueRequest
+	(*DeleteQueueResponse)(nil), // 10: scheduler.v1.DeleteQueueResponse
+}
+var file_pkg_proto_queue_proto_depIdxs = []int32{
+	0,  // 0: scheduler.v1.CreateQueueRequest.queue:type_name -> scheduler.v1.Queue
+	0,  // 1: scheduler.v1.CreateQueueResponse.queue:type_name -> scheduler.v1.Queue
+	0,  // 2: scheduler.v1.GetQueueResponse.queue:type_name -> scheduler.v1.Queue
+	0,  // 3: scheduler.v1.ListQueuesResponse.queues:type_name -> scheduler.v1.Queue
+	0,  // 4: scheduler.v1.UpdateQueueRequest.queue:type_name -> scheduler.v1.Queue
+	0,  // 5: scheduler.v1.UpdateQueueResponse.queue:type_name -> scheduler.v1.Queue
+	1,  // 6: scheduler.v1.QueueService.CreateQueue:input_type -> scheduler.v1.CreateQueueRequest
+	3,  // 7: scheduler.v1.QueueService.GetQueue:input_type -> scheduler.v1.GetQueueRequest
+	5,  // 8: scheduler.v1.QueueService.ListQueues:input_type -> scheduler.v1.ListQueuesRequest
+	7,  // 9: scheduler.v1.QueueService.UpdateQueue:input_type -> scheduler.v1.UpdateQueueRequest
+	9,  // 10: scheduler.v1.QueueService.DeleteQueue:input_type -> scheduler.v1.DeleteQueueRequest
+	2,  // 11: scheduler.v1.QueueService.CreateQueue:output_type -> scheduler.v1.CreateQueueResponse
+	4,  // 12: scheduler.v1.QueueService.GetQueue:output_type -> scheduler.v1.GetQueueResponse
+	6,  // 13: scheduler.v1.QueueService.ListQueues:output_type -> scheduler.v1.ListQueuesResponse
+	8,  // 14: scheduler.v1.QueueService.UpdateQueue:output_type -> scheduler.v1.UpdateQueueResponse
+	10, // 15: scheduler.v1.QueueService.DeleteQueue:output_type -> scheduler.v1.DeleteQueueResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_queue_proto_init() }
+func file_pkg_proto_queue_proto_init() {
+	if File_pkg_proto_queue_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_queue_proto_rawDesc), len(file_pkg_proto_queue_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_queue_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_queue_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_queue_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_queue_proto = out.File
+	file_pkg_proto_queue_proto_goTypes = nil
+	file_pkg_proto_queue_proto_depIdxs = nil
+}
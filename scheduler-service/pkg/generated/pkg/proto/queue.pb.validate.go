@@ -0,0 +1,1457 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/proto/queue.proto
+
+package schedulerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Queue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Queue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Queue with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in QueueMultiError, or nil if none found.
+func (m *Queue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Queue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetName() != "" {
+
+		if !_Queue_Name_Pattern.MatchString(m.GetName()) {
+			err := QueueValidationError{
+				field:  "Name",
+				reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9_-]{0,63}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetTopic() != "" {
+
+		if !_Queue_Topic_Pattern.MatchString(m.GetTopic()) {
+			err := QueueValidationError{
+				field:  "Topic",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9._-]{1,249}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetWeight(); val < 0 || val > 100 {
+		err := QueueValidationError{
+			field:  "Weight",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRateLimit(); val < 0 || val > 10000 {
+		err := QueueValidationError{
+			field:  "RateLimit",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxConcurrency(); val < 0 || val > 1000 {
+		err := QueueValidationError{
+			field:  "MaxConcurrency",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return QueueMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueMultiError is an error wrapping multiple validation errors returned by
+// Queue.ValidateAll() if the designated constraints aren't met.
+type QueueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueMultiError) AllErrors() []error { return m }
+
+// QueueValidationError is the validation error returned by Queue.Validate if
+// the designated constraints aren't met.
+type QueueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueValidationError) ErrorName() string { return "QueueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueValidationError{}
+
+var _Queue_Name_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9_-]{0,63}$")
+
+var _Queue_Topic_Pattern = regexp.MustCompile("^[a-zA-Z0-9._-]{1,249}$")
+
+// Validate checks the field values on CreateQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateQueueRequestMultiError, or nil if none found.
+func (m *CreateQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetQueue() == nil {
+		err := CreateQueueRequestValidationError{
+			field:  "Queue",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetQueue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateQueueRequestValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateQueueRequestValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateQueueRequestValidationError{
+				field:  "Queue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateQueueRequestMultiError) AllErrors() []error { return m }
+
+// CreateQueueRequestValidationError is the validation error returned by
+// CreateQueueRequest.Validate if the designated constraints aren't met.
+type CreateQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateQueueRequestValidationError) ErrorName() string {
+	return "CreateQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateQueueRequestValidationError{}
+
+// Validate checks the field values on CreateQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateQueueResponseMultiError, or nil if none found.
+func (m *CreateQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQueue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateQueueResponseValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateQueueResponseValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateQueueResponseValidationError{
+				field:  "Queue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateQueueResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateQueueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateQueueResponseMultiError) AllErrors() []error { return m }
+
+// CreateQueueResponseValidationError is the validation error returned by
+// CreateQueueResponse.Validate if the designated constraints aren't met.
+type CreateQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateQueueResponseValidationError) ErrorName() string {
+	return "CreateQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateQueueResponseValidationError{}
+
+// Validate checks the field values on GetQueueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueueRequestMultiError, or nil if none found.
+func (m *GetQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetQueueRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueueRequestMultiError) AllErrors() []error { return m }
+
+// GetQueueRequestValidationError is the validation error returned by
+// GetQueueRequest.Validate if the designated constraints aren't met.
+type GetQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueueRequestValidationError) ErrorName() string { return "GetQueueRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueueRequestValidationError{}
+
+// Validate checks the field values on GetQueueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueueResponseMultiError, or nil if none found.
+func (m *GetQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQueue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetQueueResponseValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetQueueResponseValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetQueueResponseValidationError{
+				field:  "Queue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueueResponseMultiError is an error wrapping multiple validation errors
+// returned by GetQueueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueueResponseMultiError) AllErrors() []error { return m }
+
+// GetQueueResponseValidationError is the validation error returned by
+// GetQueueResponse.Validate if the designated constraints aren't met.
+type GetQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueueResponseValidationError) ErrorName() string { return "GetQueueResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueueResponseValidationError{}
+
+// Validate checks the field values on ListQueuesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListQueuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueuesRequestMultiError, or nil if none found.
+func (m *ListQueuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListQueuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueuesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListQueuesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListQueuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueuesRequestMultiError) AllErrors() []error { return m }
+
+// ListQueuesRequestValidationError is the validation error returned by
+// ListQueuesRequest.Validate if the designated constraints aren't met.
+type ListQueuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueuesRequestValidationError) ErrorName() string {
+	return "ListQueuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueuesRequestValidationError{}
+
+// Validate checks the field values on ListQueuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueuesResponseMultiError, or nil if none found.
+func (m *ListQueuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQueues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQueuesResponseValidationError{
+						field:  fmt.Sprintf("Queues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQueuesResponseValidationError{
+						field:  fmt.Sprintf("Queues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueuesResponseValidationError{
+					field:  fmt.Sprintf("Queues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQueuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueuesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListQueuesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListQueuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueuesResponseMultiError) AllErrors() []error { return m }
+
+// ListQueuesResponseValidationError is the validation error returned by
+// ListQueuesResponse.Validate if the designated constraints aren't met.
+type ListQueuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueuesResponseValidationError) ErrorName() string {
+	return "ListQueuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueuesResponseValidationError{}
+
+// Validate checks the field values on UpdateQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateQueueRequestMultiError, or nil if none found.
+func (m *UpdateQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateQueueRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQueue() == nil {
+		err := UpdateQueueRequestValidationError{
+			field:  "Queue",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetQueue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateQueueRequestValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateQueueRequestValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateQueueRequestValidationError{
+				field:  "Queue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateQueueRequestMultiError) AllErrors() []error { return m }
+
+// UpdateQueueRequestValidationError is the validation error returned by
+// UpdateQueueRequest.Validate if the designated constraints aren't met.
+type UpdateQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateQueueRequestValidationError) ErrorName() string {
+	return "UpdateQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateQueueRequestValidationError{}
+
+// Validate checks the field values on UpdateQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateQueueResponseMultiError, or nil if none found.
+func (m *UpdateQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQueue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateQueueResponseValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateQueueResponseValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateQueueResponseValidationError{
+				field:  "Queue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateQueueResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateQueueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateQueueResponseMultiError) AllErrors() []error { return m }
+
+// UpdateQueueResponseValidationError is the validation error returned by
+// UpdateQueueResponse.Validate if the designated constraints aren't met.
+type UpdateQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateQueueResponseValidationError) ErrorName() string {
+	return "UpdateQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateQueueResponseValidationError{}
+
+// Validate checks the field values on DeleteQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteQueueRequestMultiError, or nil if none found.
+func (m *DeleteQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteQueueRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteQueueRequestMultiError) AllErrors() []error { return m }
+
+// DeleteQueueRequestValidationError is the validation error returned by
+// DeleteQueueRequest.Validate if the designated constraints aren't met.
+type DeleteQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteQueueRequestValidationError) ErrorName() string {
+	return "DeleteQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteQueueRequestValidationError{}
+
+// Validate checks the field values on DeleteQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteQueueResponseMultiError, or nil if none found.
+func (m *DeleteQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteQueueResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteQueueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteQueueResponseMultiError) AllErrors() []error { return m }
+
+// DeleteQueueResponseValidationError is the validation error returned by
+// DeleteQueueResponse.Validate if the designated constraints aren't met.
+type DeleteQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteQueueResponseValidationError) ErrorName() string {
+	return "DeleteQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteQueueResponseValidationError{}
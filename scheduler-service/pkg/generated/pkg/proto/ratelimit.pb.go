@@ -0,0 +1,498 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pkg/proto/ratelimit.proto
+
+package schedulerv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RateLimitBlock is a caller blocked on an RPC after going over its rate limit
+type RateLimitBlock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RPC of the rate limit rule, * for the default rule
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// caller, principal:<subject>, key:<hash of the API key> or ip:<address>
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// blocks of the caller so far, every block lasts longer than the previous one
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// unix millis, the block is over after it
+	EndTime       int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Active        bool  `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitBlock) Reset() {
+	*x = RateLimitBlock{}
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitBlock) ProtoMessage() {}
+
+func (x *RateLimitBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitBlock.ProtoReflect.Descriptor instead.
+func (*RateLimitBlock) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_ratelimit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateLimitBlock) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RateLimitBlock) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RateLimitBlock) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RateLimitBlock) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *RateLimitBlock) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListRateLimitBlocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filters on the path and the key of the blocks, empty matches everything
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// include_expired also returns the blocks which are over but still count for the next block
+	IncludeExpired bool `protobuf:"varint,3,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRateLimitBlocksRequest) Reset() {
+	*x = ListRateLimitBlocksRequest{}
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateLimitBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateLimitBlocksRequest) ProtoMessage() {}
+
+func (x *ListRateLimitBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateLimitBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListRateLimitBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_ratelimit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRateLimitBlocksRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListRateLimitBlocksRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListRateLimitBlocksRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListRateLimitBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocks        []*RateLimitBlock      `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateLimitBlocksResponse) Reset() {
+	*x = ListRateLimitBlocksResponse{}
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateLimitBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateLimitBlocksResponse) ProtoMessage() {}
+
+func (x *ListRateLimitBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateLimitBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListRateLimitBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_ratelimit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRateLimitBlocksResponse) GetBlocks() []*RateLimitBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type GetRateLimitBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitBlockRequest) Reset() {
+	*x = GetRateLimitBlockRequest{}
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitBlockRequest) ProtoMessage() {}
+
+func (x *GetRateLimitBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetRateLimitBlockRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_ratelimit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRateLimitBlockRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetRateLimitBlockRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetRateLimitBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *RateLimitBlock        `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitBlockResponse) Reset() {
+	*x = GetRateLimitBlockResponse{}
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitBlockResponse) ProtoMessage() {}
+
+func (x *GetRateLimitBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetRateLimitBlockResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_ratelimit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRateLimitBlockResponse) GetBlock() *RateLimitBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type UnblockRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockRateLimitRequest) Reset() {
+	*x = UnblockRateLimitRequest{}
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockRateLimitRequest) ProtoMessage() {}
+
+func (x *UnblockRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*UnblockRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_ratelimit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnblockRateLimitRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UnblockRateLimitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UnblockRateLimitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// false when the caller was not blocked
+	Unblocked     bool `protobuf:"varint,1,opt,name=unblocked,proto3" json:"unblocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockRateLimitResponse) Reset() {
+	*x = UnblockRateLimitResponse{}
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockRateLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockRateLimitResponse) ProtoMessage() {}
+
+func (x *UnblockRateLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_ratelimit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockRateLimitResponse.ProtoReflect.Descriptor instead.
+func (*UnblockRateLimitResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_ratelimit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnblockRateLimitResponse) GetUnblocked() bool {
+	if x != nil {
+		return x.Unblocked
+	}
+	return false
+}
+
+var File_pkg_proto_ratelimit_proto protoreflect.FileDescriptor
+
+const file_pkg_proto_ratelimit_proto_rawDesc = "" +
+	"\n" +
+	"\x19pkg/proto/ratelimit.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\"\x7f\n" +
+	"\x0eRateLimitBlock\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\x03R\aendTime\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"k\n" +
+	"\x1aListRateLimitBlocksRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12'\n" +
+	"\x0finclude_expired\x18\x03 \x01(\bR\x0eincludeExpired\"S\n" +
+	"\x1bListRateLimitBlocksResponse\x124\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x1c.scheduler.v1.RateLimitBlockR\x06blocks\"@\n" +
+	"\x18GetRateLimitBlockRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"O\n" +
+	"\x19GetRateLimitBlockResponse\x122\n" +
+	"\x05block\x18\x01 \x01(\v2\x1c.scheduler.v1.RateLimitBlockR\x05block\"?\n" +
+	"\x17UnblockRateLimitRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"8\n" +
+	"\x18UnblockRateLimitResponse\x12\x1c\n" +
+	"\tunblocked\x18\x01 \x01(\bR\tunblocked2\xba\x03\n" +
+	"\x10RateLimitService\x12\x8c\x01\n" +
+	"\x13ListRateLimitBlocks\x12(.scheduler.v1.ListRateLimitBlocksRequest\x1a).scheduler.v1.ListRateLimitBlocksResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/ratelimit/blocks\x12\x85\x01\n" +
+	"\x11GetRateLimitBlock\x12&.scheduler.v1.GetRateLimitBlockRequest\x1a'.scheduler.v1.GetRateLimitBlockResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/ratelimit/block\x12\x8e\x01\n" +
+	"\x10UnblockRateLimit\x12%.scheduler.v1.UnblockRateLimitRequest\x1a&.scheduler.v1.UnblockRateLimitResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/ratelimit/blocks:unblockB\x9a\x01\n" +
+	"\x10com.scheduler.v1B\x0eRatelimitProtoP\x01Z%crawler-service/pkg/proto;schedulerv1\xa2\x02\x03SXX\xaa\x02\fScheduler.V1\xca\x02\fScheduler\\V1\xe2\x02\x18Scheduler\\V1\\GPBMetadata\xea\x02\rScheduler::V1b\x06proto3"
+
+var (
+	file_pkg_proto_ratelimit_proto_rawDescOnce sync.Once
+	file_pkg_proto_ratelimit_proto_rawDescData []byte
+)
+
+func file_pkg_proto_ratelimit_proto_rawDescGZIP() []byte {
+	file_pkg_proto_ratelimit_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_ratelimit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_ratelimit_proto_rawDesc), len(file_pkg_proto_ratelimit_proto_rawDesc)))
+	})
+	return file_pkg_proto_ratelimit_proto_rawDescData
+}
+
+var file_pkg_proto_ratelimit_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pkg_proto_ratelimit_proto_goTypes = []any{
+	(*RateLimitBlock)(nil),              // 0: scheduler.v1.RateLimitBlock
+	(*ListRateLimitBlocksRequest)(nil),  // 1: scheduler.v1.ListRateLimitBlocksRequest
+	(*ListRateLimitBlocksResponse)(nil), // 2: scheduler.v1.ListRateLimitBlocksResponse
+	(*GetRateLimitBlockRequest)(nil),    // 3: scheduler.v1.GetRateLimitBlockRequest
+	(*GetRateLimitBlockResponse)(nil),   // 4: scheduler.v1.GetRateLimitBlockResponse
+	(*UnblockRateLimitRequest)(nil),     // 5: scheduler.v1.UnblockRateLimitRequest
+	(*UnblockRateLimitResponse)(nil),    // 6: scheduler.v1.UnblockRateLimitResponse
+}
+var file_pkg_proto_ratelimit_proto_depIdxs = []int32{
+	0, // 0: scheduler.v1.ListRateLimitBlocksResponse.blocks:type_name -> scheduler.v1.RateLimitBlock
+	0, // 1: scheduler.v1.GetRateLimitBlockResponse.block:type_name -> scheduler.v1.RateLimitBlock
+	1, // 2: scheduler.v1.RateLimitService.ListRateLimitBlocks:input_type -> scheduler.v1.ListRateLimitBlocksRequest
+	3, // 3: scheduler.v1.RateLimitService.GetRateLimitBlock:input_type -> scheduler.v1.GetRateLimitBlockRequest
+	5, // 4: scheduler.v1.RateLimitService.UnblockRateLimit:input_type -> scheduler.v1.UnblockRateLimitRequest
+	2, // 5: scheduler.v1.RateLimitService.ListRateLimitBlocks:output_type -> scheduler.v1.ListRateLimitBlocksResponse
+	4, // 6: scheduler.v1.RateLimitService.GetRateLimitBlock:output_type -> scheduler.v1.GetRateLimitBlockResponse
+	6, // 7: scheduler.v1.RateLimitService.UnblockRateLimit:output_type -> scheduler.v1.UnblockRateLimitResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_ratelimit_proto_init() }
+func file_pkg_proto_ratelimit_proto_init() {
+	if File_pkg_proto_ratelimit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_ratelimit_proto_rawDesc), len(file_pkg_proto_ratelimit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_ratelimit_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_ratelimit_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_ratelimit_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_ratelimit_proto = out.File
+	file_pkg_proto_ratelimit_proto_goTypes = nil
+	file_pkg_proto_ratelimit_proto_depIdxs = nil
+}
@@ -0,0 +1,838 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/proto/ratelimit.proto
+
+package schedulerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RateLimitBlock with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RateLimitBlock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitBlock with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RateLimitBlockMultiError,
+// or nil if none found.
+func (m *RateLimitBlock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitBlock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Key
+
+	// no validation rules for Count
+
+	// no validation rules for EndTime
+
+	// no validation rules for Active
+
+	if len(errors) > 0 {
+		return RateLimitBlockMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitBlockMultiError is an error wrapping multiple validation errors
+// returned by RateLimitBlock.ValidateAll() if the designated constraints
+// aren't met.
+type RateLimitBlockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitBlockMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitBlockMultiError) AllErrors() []error { return m }
+
+// RateLimitBlockValidationError is the validation error returned by
+// RateLimitBlock.Validate if the designated constraints aren't met.
+type RateLimitBlockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitBlockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitBlockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitBlockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitBlockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitBlockValidationError) ErrorName() string { return "RateLimitBlockValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RateLimitBlockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitBlock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitBlockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitBlockValidationError{}
+
+// Validate checks the field values on ListRateLimitBlocksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRateLimitBlocksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRateLimitBlocksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRateLimitBlocksRequestMultiError, or nil if none found.
+func (m *ListRateLimitBlocksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRateLimitBlocksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Key
+
+	// no validation rules for IncludeExpired
+
+	if len(errors) > 0 {
+		return ListRateLimitBlocksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRateLimitBlocksRequestMultiError is an error wrapping multiple
+// validation errors returned by ListRateLimitBlocksRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListRateLimitBlocksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRateLimitBlocksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRateLimitBlocksRequestMultiError) AllErrors() []error { return m }
+
+// ListRateLimitBlocksRequestValidationError is the validation error returned
+// by ListRateLimitBlocksRequest.Validate if the designated constraints aren't met.
+type ListRateLimitBlocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRateLimitBlocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRateLimitBlocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRateLimitBlocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRateLimitBlocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRateLimitBlocksRequestValidationError) ErrorName() string {
+	return "ListRateLimitBlocksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRateLimitBlocksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRateLimitBlocksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRateLimitBlocksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRateLimitBlocksRequestValidationError{}
+
+// Validate checks the field values on ListRateLimitBlocksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRateLimitBlocksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRateLimitBlocksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRateLimitBlocksResponseMultiError, or nil if none found.
+func (m *ListRateLimitBlocksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRateLimitBlocksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBlocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRateLimitBlocksResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRateLimitBlocksResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRateLimitBlocksResponseValidationError{
+					field:  fmt.Sprintf("Blocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRateLimitBlocksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRateLimitBlocksResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRateLimitBlocksResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRateLimitBlocksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRateLimitBlocksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRateLimitBlocksResponseMultiError) AllErrors() []error { return m }
+
+// ListRateLimitBlocksResponseValidationError is the validation error returned
+// by ListRateLimitBlocksResponse.Validate if the designated constraints
+// aren't met.
+type ListRateLimitBlocksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRateLimitBlocksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRateLimitBlocksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRateLimitBlocksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRateLimitBlocksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRateLimitBlocksResponseValidationError) ErrorName() string {
+	return "ListRateLimitBlocksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRateLimitBlocksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRateLimitBlocksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRateLimitBlocksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRateLimitBlocksResponseValidationError{}
+
+// Validate checks the field values on GetRateLimitBlockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRateLimitBlockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRateLimitBlockRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRateLimitBlockRequestMultiError, or nil if none found.
+func (m *GetRateLimitBlockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRateLimitBlockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return GetRateLimitBlockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRateLimitBlockRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRateLimitBlockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRateLimitBlockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRateLimitBlockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRateLimitBlockRequestMultiError) AllErrors() []error { return m }
+
+// GetRateLimitBlockRequestValidationError is the validation error returned by
+// GetRateLimitBlockRequest.Validate if the designated constraints aren't met.
+type GetRateLimitBlockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRateLimitBlockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRateLimitBlockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRateLimitBlockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRateLimitBlockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRateLimitBlockRequestValidationError) ErrorName() string {
+	return "GetRateLimitBlockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRateLimitBlockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRateLimitBlockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRateLimitBlockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRateLimitBlockRequestValidationError{}
+
+// Validate checks the field values on GetRateLimitBlockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRateLimitBlockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRateLimitBlockResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRateLimitBlockResponseMultiError, or nil if none found.
+func (m *GetRateLimitBlockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRateLimitBlockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRateLimitBlockResponseValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRateLimitBlockResponseValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRateLimitBlockResponseValidationError{
+				field:  "Block",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRateLimitBlockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRateLimitBlockResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRateLimitBlockResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetRateLimitBlockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRateLimitBlockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRateLimitBlockResponseMultiError) AllErrors() []error { return m }
+
+// GetRateLimitBlockResponseValidationError is the validation error returned by
+// GetRateLimitBlockResponse.Validate if the designated constraints aren't met.
+type GetRateLimitBlockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRateLimitBlockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRateLimitBlockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRateLimitBlockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRateLimitBlockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRateLimitBlockResponseValidationError) ErrorName() string {
+	return "GetRateLimitBlockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRateLimitBlockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRateLimitBlockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRateLimitBlockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRateLimitBlockResponseValidationError{}
+
+// Validate checks the field values on UnblockRateLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockRateLimitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockRateLimitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockRateLimitRequestMultiError, or nil if none found.
+func (m *UnblockRateLimitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockRateLimitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return UnblockRateLimitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockRateLimitRequestMultiError is an error wrapping multiple validation
+// errors returned by UnblockRateLimitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnblockRateLimitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockRateLimitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockRateLimitRequestMultiError) AllErrors() []error { return m }
+
+// UnblockRateLimitRequestValidationError is the validation error returned by
+// UnblockRateLimitRequest.Validate if the designated constraints aren't met.
+type UnblockRateLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockRateLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockRateLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockRateLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockRateLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockRateLimitRequestValidationError) ErrorName() string {
+	return "UnblockRateLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockRateLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockRateLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockRateLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockRateLimitRequestValidationError{}
+
+// Validate checks the field values on UnblockRateLimitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockRateLimitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockRateLimitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockRateLimitResponseMultiError, or nil if none found.
+func (m *UnblockRateLimitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockRateLimitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Unblocked
+
+	if len(errors) > 0 {
+		return UnblockRateLimitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockRateLimitResponseMultiError is an error wrapping multiple validation
+// errors returned by UnblockRateLimitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnblockRateLimitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockRateLimitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockRateLimitResponseMultiError) AllErrors() []error { return m }
+
+// UnblockRateLimitResponseValidationError is the validation error returned by
+// UnblockRateLimitResponse.Validate if the designated constraints aren't met.
+type UnblockRateLimitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockRateLimitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockRateLimitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockRateLimitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockRateLimitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockRateLimitResponseValidationError) ErrorName() string {
+	return "UnblockRateLimitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockRateLimitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockRateLimitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockRateLimitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockRateLimitResponseValidationError{}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: pkg/proto/ratelimit.proto
+
+package schedulerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RateLimitService_ListRateLimitBlocks_FullMethodName = "/scheduler.v1.RateLimitService/ListRateLimitBlocks"
+	RateLimitService_GetRateLimitBlock_FullMethodName   = "/scheduler.v1.RateLimitService/GetRateLimitBlock"
+	RateLimitService_UnblockRateLimit_FullMethodName    = "/scheduler.v1.RateLimitService/UnblockRateLimit"
+)
+
+// RateLimitServiceClient is the client API for RateLimitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RateLimitService inspects and clears the blocks of the rate limiter, every RPC needs the admin role
+type RateLimitServiceClient interface {
+	ListRateLimitBlocks(ctx context.Context, in *ListRateLimitBlocksRequest, opts ...grpc.CallOption) (*ListRateLimitBlocksResponse, error)
+	// GetRateLimitBlock takes the path and the key as query parameters, keys contain ":"
+	GetRateLimitBlock(ctx context.Context, in *GetRateLimitBlockRequest, opts ...grpc.CallOption) (*GetRateLimitBlockResponse, error)
+	// UnblockRateLimit removes the block and resets the count and the rate limit of the caller
+	UnblockRateLimit(ctx context.Context, in *UnblockRateLimitRequest, opts ...grpc.CallOption) (*UnblockRateLimitResponse, error)
+}
+
+type rateLimitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRateLimitServiceClient(cc grpc.ClientConnInterface) RateLimitServiceClient {
+	return &rateLimitServiceClient{cc}
+}
+
+func (c *rateLimitServiceClient) ListRateLimitBlocks(ctx context.Context, in *ListRateLimitBlocksRequest, opts ...grpc.CallOption) (*ListRateLimitBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRateLimitBlocksResponse)
+	err := c.cc.Invoke(ctx, RateLimitService_ListRateLimitBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateLimitServiceClient) GetRateLimitBlock(ctx context.Context, in *GetRateLimitBlockRequest, opts ...grpc.CallOption) (*GetRateLimitBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateLimitBlockResponse)
+	err := c.cc.Invoke(ctx, RateLimitService_GetRateLimitBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateLimitServiceClient) UnblockRateLimit(ctx context.Context, in *UnblockRateLimitRequest, opts ...grpc.CallOption) (*UnblockRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockRateLimitResponse)
+	err := c.cc.Invoke(ctx, RateLimitService_UnblockRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RateLimitServiceServer is the server API for RateLimitService service.
+// All implementations must embed UnimplementedRateLimitServiceServer
+// for forward compatibility.
+//
+// RateLimitService inspects and clears the blocks of the rate limiter, every RPC needs the admin role
+type RateLimitServiceServer interface {
+	ListRateLimitBlocks(context.Context, *ListRateLimitBlocksRequest) (*ListRateLimitBlocksResponse, error)
+	// GetRateLimitBlock takes the path and the key as query parameters, keys contain ":"
+	GetRateLimitBlock(context.Context, *GetRateLimitBlockRequest) (*GetRateLimitBlockResponse, error)
+	// UnblockRateLimit removes the block and resets the count and the rate limit of the caller
+	UnblockRateLimit(context.Context, *UnblockRateLimitRequest) (*UnblockRateLimitResponse, error)
+	mustEmbedUnimplementedRateLimitServiceServer()
+}
+
+// UnimplementedRateLimitServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRateLimitServiceServer struct{}
+
+func (UnimplementedRateLimitServiceServer) ListRateLimitBlocks(context.Context, *ListRateLimitBlocksRequest) (*ListRateLimitBlocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRateLimitBlocks not implemented")
+}
+func (UnimplementedRateLimitServiceServer) GetRateLimitBlock(context.Context, *GetRateLimitBlockRequest) (*GetRateLimitBlockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateLimitBlock not implemented")
+}
+func (UnimplementedRateLimitServiceServer) UnblockRateLimit(context.Context, *UnblockRateLimitRequest) (*UnblockRateLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockRateLimit not implemented")
+}
+func (UnimplementedRateLimitServiceServer) mustEmbedUnimplementedRateLimitServiceServer() {}
+func (UnimplementedRateLimitServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeRateLimitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RateLimitServiceServer will
+// result in compilation errors.
+type UnsafeRateLimitServiceServer interface {
+	mustEmbedUnimplementedRateLimitServiceServer()
+}
+
+func RegisterRateLimitServiceServer(s grpc.ServiceRegistrar, srv RateLimitServiceServer) {
+	// If the following call panics, it indicates UnimplementedRateLimitServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RateLimitService_ServiceDesc, srv)
+}
+
+func _RateLimitService_ListRateLimitBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateLimitBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitServiceServer).ListRateLimitBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimitService_ListRateLimitBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitServiceServer).ListRateLimitBlocks(ctx, req.(*ListRateLimitBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateLimitService_GetRateLimitBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitServiceServer).GetRateLimitBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimitService_GetRateLimitBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitServiceServer).GetRateLimitBlock(ctx, req.(*GetRateLimitBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateLimitService_UnblockRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitServiceServer).UnblockRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimitService_UnblockRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitServiceServer).UnblockRateLimit(ctx, req.(*UnblockRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RateLimitService_ServiceDesc is the grpc.ServiceDesc for RateLimitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RateLimitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.v1.RateLimitService",
+	HandlerType: (*RateLimitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRateLimitBlocks",
+			Handler:    _RateLimitService_ListRateLimitBlocks_Handler,
+		},
+		{
+			MethodName: "GetRateLimitBlock",
+			Handler:    _RateLimitService_GetRateLimitBlock_Handler,
+		},
+		{
+			MethodName: "UnblockRateLimit",
+			Handler:    _RateLimitService_UnblockRateLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/ratelimit.proto",
+}
@@ -7,9 +7,12 @@
 package schedulerv1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,21 +26,29 @@ const (
 )
 
 type SchedulerEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Queue         string                 `protobuf:"bytes,5,opt,name=queue,proto3" json:"queue,omitempty"`
-	Domain        string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
-	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	NextRunTime   int64                  `protobuf:"varint,8,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
-	RepeatTimes   int64                  `protobuf:"varint,9,opt,name=repeat_times,json=repeatTimes,proto3" json:"repeat_times,omitempty"`
-	SchedulerAt   int64                  `protobuf:"varint,10,opt,name=scheduler_at,json=schedulerAt,proto3" json:"scheduler_at,omitempty"`
-	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	CronExp       string                 `protobuf:"bytes,12,opt,name=cron_exp,json=cronExp,proto3" json:"cron_exp,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url         string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Method      string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Queue       string                 `protobuf:"bytes,5,opt,name=queue,proto3" json:"queue,omitempty"`
+	Domain      string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+	IsActive    bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	NextRunTime int64                  `protobuf:"varint,8,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	RepeatTimes int64                  `protobuf:"varint,9,opt,name=repeat_times,json=repeatTimes,proto3" json:"repeat_times,omitempty"`
+	SchedulerAt int64                  `protobuf:"varint,10,opt,name=scheduler_at,json=schedulerAt,proto3" json:"scheduler_at,omitempty"`
+	Status      string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	CronExp     string                 `protobuf:"bytes,12,opt,name=cron_exp,json=cronExp,proto3" json:"cron_exp,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// stable key of the event in the manifests synced from git, unique when set
+	Name string `protobuf:"bytes,15,opt,name=name,proto3" json:"name,omitempty"`
+	// incremented by every write, it is also sent as the ETag header
+	Version int64 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	// team owning the event, it is the team of the caller who created it
+	Team string `protobuf:"bytes,17,opt,name=team,proto3" json:"team,omitempty"`
+	// retries of the failed crawls, the defaults of the crawler when it is not set
+	RetryPolicy   *RetryPolicy `protobuf:"bytes,18,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -170,6 +181,126 @@ func (x *SchedulerEvent) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *SchedulerEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchedulerEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SchedulerEvent) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *SchedulerEvent) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+// RetryPolicy of the failed crawls of an event, the fields left at 0 take the defaults of the crawler,
+// jitter takes them when it is not set. The n-th retry waits initial_delay_ms * multiplier^(n-1), moved
+// by up to jitter of itself and capped by max_delay_ms. Only the errors of retry_on are retried.
+type RetryPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// crawls of a run, the first one included, 1 never retries
+	MaxAttempts    int32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	InitialDelayMs int64 `protobuf:"varint,2,opt,name=initial_delay_ms,json=initialDelayMs,proto3" json:"initial_delay_ms,omitempty"`
+	// growth of the delay between two retries, 1 keeps it fixed
+	Multiplier float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	MaxDelayMs int64   `protobuf:"varint,4,opt,name=max_delay_ms,json=maxDelayMs,proto3" json:"max_delay_ms,omitempty"`
+	// share of the delay added or removed at random, between 0 and 1, 0 turns it off
+	Jitter *float64 `protobuf:"fixed64,5,opt,name=jitter,proto3,oneof" json:"jitter,omitempty"`
+	// error classes which are retried: timeout, connection, server_error (5xx), rate_limited (429),
+	// not_found (404), client_error (other 4xx), robots_disallowed, invalid (unreadable page) and unknown
+	RetryOn       []string `protobuf:"bytes,6,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialDelayMs() int64 {
+	if x != nil {
+		return x.InitialDelayMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxDelayMs() int64 {
+	if x != nil {
+		return x.MaxDelayMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil && x.Jitter != nil {
+		return *x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
 type CreateSchedulerEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Event         *SchedulerEvent        `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -179,7 +310,7 @@ type CreateSchedulerEventRequest struct {
 
 func (x *CreateSchedulerEventRequest) Reset() {
 	*x = CreateSchedulerEventRequest{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[1]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +322,7 @@ func (x *CreateSchedulerEventRequest) String() string {
 func (*CreateSchedulerEventRequest) ProtoMessage() {}
 
 func (x *CreateSchedulerEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[1]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +335,7 @@ func (x *CreateSchedulerEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSchedulerEventRequest.ProtoReflect.Descriptor instead.
 func (*CreateSchedulerEventRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateSchedulerEventRequest) GetEvent() *SchedulerEvent {
@@ -224,7 +355,7 @@ type CreateSchedulerEventResponse struct {
 
 func (x *CreateSchedulerEventResponse) Reset() {
 	*x = CreateSchedulerEventResponse{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[2]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -236,7 +367,7 @@ func (x *CreateSchedulerEventResponse) String() string {
 func (*CreateSchedulerEventResponse) ProtoMessage() {}
 
 func (x *CreateSchedulerEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[2]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +380,7 @@ func (x *CreateSchedulerEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSchedulerEventResponse.ProtoReflect.Descriptor instead.
 func (*CreateSchedulerEventResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateSchedulerEventResponse) GetId() string {
@@ -276,7 +407,7 @@ type GetSchedulerEventsRequest struct {
 
 func (x *GetSchedulerEventsRequest) Reset() {
 	*x = GetSchedulerEventsRequest{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[3]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +419,7 @@ func (x *GetSchedulerEventsRequest) String() string {
 func (*GetSchedulerEventsRequest) ProtoMessage() {}
 
 func (x *GetSchedulerEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[3]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +432,7 @@ func (x *GetSchedulerEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchedulerEventsRequest.ProtoReflect.Descriptor instead.
 func (*GetSchedulerEventsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetSchedulerEventsRequest) GetLimit() int32 {
@@ -327,7 +458,7 @@ type GetSchedulerEventsResponse struct {
 
 func (x *GetSchedulerEventsResponse) Reset() {
 	*x = GetSchedulerEventsResponse{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[4]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -339,7 +470,7 @@ func (x *GetSchedulerEventsResponse) String() string {
 func (*GetSchedulerEventsResponse) ProtoMessage() {}
 
 func (x *GetSchedulerEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[4]
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +483,7 @@ func (x *GetSchedulerEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchedulerEventsResponse.ProtoReflect.Descriptor instead.
 func (*GetSchedulerEventsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetSchedulerEventsResponse) GetEvents() []*SchedulerEvent {
@@ -362,29 +493,28 @@ func (x *GetSchedulerEventsResponse) GetEvents() []*SchedulerEvent {
 	return nil
 }
 
-type UpdateSchedulerEventRequest struct {
+type GetSchedulerEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Event         *SchedulerEvent        `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSchedulerEventRequest) Reset() {
-	*x = UpdateSchedulerEventRequest{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[5]
+func (x *GetSchedulerEventRequest) Reset() {
+	*x = GetSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSchedulerEventRequest) String() string {
+func (x *GetSchedulerEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSchedulerEventRequest) ProtoMessage() {}
+func (*GetSchedulerEventRequest) ProtoMessage() {}
 
-func (x *UpdateSchedulerEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[5]
+func (x *GetSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,48 +525,99 @@ func (x *UpdateSchedulerEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSchedulerEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSchedulerEventRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateSchedulerEventRequest) GetId() string {
+func (x *GetSchedulerEventRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateSchedulerEventRequest) GetEvent() *SchedulerEvent {
+type GetSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *SchedulerEvent        `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchedulerEventResponse) Reset() {
+	*x = GetSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerEventResponse) ProtoMessage() {}
+
+func (x *GetSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSchedulerEventResponse) GetEvent() *SchedulerEvent {
 	if x != nil {
 		return x.Event
 	}
 	return nil
 }
 
-type UpdateSchedulerEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+type ListSchedulerEventsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Domain   string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Queue    string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Status   string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Method   string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	IsActive *bool                  `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// created/updated range, unix milliseconds, 0 means unbounded
+	CreatedFrom int64 `protobuf:"varint,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   int64 `protobuf:"varint,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom int64 `protobuf:"varint,8,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo   int64 `protobuf:"varint,9,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	// case-insensitive search in the description
+	Query string `protobuf:"bytes,10,opt,name=query,proto3" json:"query,omitempty"`
+	// "<field> [asc|desc]", field is one of id, created_at, updated_at, scheduler_at, domain, queue
+	OrderBy       string `protobuf:"bytes,11,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageSize      int32  `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSchedulerEventResponse) Reset() {
-	*x = UpdateSchedulerEventResponse{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[6]
+func (x *ListSchedulerEventsRequest) Reset() {
+	*x = ListSchedulerEventsRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSchedulerEventResponse) String() string {
+func (x *ListSchedulerEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSchedulerEventResponse) ProtoMessage() {}
+func (*ListSchedulerEventsRequest) ProtoMessage() {}
 
-func (x *UpdateSchedulerEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[6]
+func (x *ListSchedulerEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,48 +628,126 @@ func (x *UpdateSchedulerEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSchedulerEventResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSchedulerEventResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListSchedulerEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulerEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateSchedulerEventResponse) GetId() string {
+func (x *ListSchedulerEventsRequest) GetDomain() string {
 	if x != nil {
-		return x.Id
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *UpdateSchedulerEventResponse) GetStatus() string {
+func (x *ListSchedulerEventsRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListSchedulerEventsRequest) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type UpdateEventStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+func (x *ListSchedulerEventsRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListSchedulerEventsRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListSchedulerEventsRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *ListSchedulerEventsRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *ListSchedulerEventsRequest) GetUpdatedFrom() int64 {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return 0
+}
+
+func (x *ListSchedulerEventsRequest) GetUpdatedTo() int64 {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return 0
+}
+
+func (x *ListSchedulerEventsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListSchedulerEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSchedulerEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSchedulerEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSchedulerEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*SchedulerEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventStatusRequest) Reset() {
-	*x = UpdateEventStatusRequest{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[7]
+func (x *ListSchedulerEventsResponse) Reset() {
+	*x = ListSchedulerEventsResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventStatusRequest) String() string {
+func (x *ListSchedulerEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventStatusRequest) ProtoMessage() {}
+func (*ListSchedulerEventsResponse) ProtoMessage() {}
 
-func (x *UpdateEventStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[7]
+func (x *ListSchedulerEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,47 +758,52 @@ func (x *UpdateEventStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListSchedulerEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulerEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateEventStatusRequest) GetId() int64 {
+func (x *ListSchedulerEventsResponse) GetEvents() []*SchedulerEvent {
 	if x != nil {
-		return x.Id
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateEventStatusRequest) GetStatus() string {
+func (x *ListSchedulerEventsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Status
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type UpdateEventStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+type BulkSchedulerEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events with an id are updated by BulkUpsert, the others are created.
+	// Every row is validated on its own and gets its errors in its result.
+	// A row keeps its is_active like the rows of the export, a row without it is saved paused.
+	Events []*SchedulerEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// validate every row without writing
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventStatusResponse) Reset() {
-	*x = UpdateEventStatusResponse{}
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[8]
+func (x *BulkSchedulerEventsRequest) Reset() {
+	*x = BulkSchedulerEventsRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventStatusResponse) String() string {
+func (x *BulkSchedulerEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventStatusResponse) ProtoMessage() {}
+func (*BulkSchedulerEventsRequest) ProtoMessage() {}
 
-func (x *UpdateEventStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[8]
+func (x *BulkSchedulerEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,67 +814,1637 @@ func (x *UpdateEventStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEventStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BulkSchedulerEventsRequest.ProtoReflect.Descriptor instead.
+func (*BulkSchedulerEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkSchedulerEventsRequest) GetEvents() []*SchedulerEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *BulkSchedulerEventsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BulkEventResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// position of the row in the request
+	Index int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// created, updated, valid (dry run) or error
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkEventResult) Reset() {
+	*x = BulkEventResult{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkEventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEventResult) ProtoMessage() {}
+
+func (x *BulkEventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEventResult.ProtoReflect.Descriptor instead.
+func (*BulkEventResult) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BulkEventResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkEventResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulkEventResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkEventResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkSchedulerEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkEventResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkSchedulerEventsResponse) Reset() {
+	*x = BulkSchedulerEventsResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkSchedulerEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkSchedulerEventsResponse) ProtoMessage() {}
+
+func (x *BulkSchedulerEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkSchedulerEventsResponse.ProtoReflect.Descriptor instead.
+func (*BulkSchedulerEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BulkSchedulerEventsResponse) GetResults() []*BulkEventResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkSchedulerEventsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkSchedulerEventsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ExportSchedulerEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// json (default), csv or yaml
+	Format        string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSchedulerEventsRequest) Reset() {
+	*x = ExportSchedulerEventsRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSchedulerEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSchedulerEventsRequest) ProtoMessage() {}
+
+func (x *ExportSchedulerEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSchedulerEventsRequest.ProtoReflect.Descriptor instead.
+func (*ExportSchedulerEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportSchedulerEventsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type UpdateSchedulerEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event *SchedulerEvent        `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// expected version of the event, the If-Match header is used when it is empty
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSchedulerEventRequest) Reset() {
+	*x = UpdateSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSchedulerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSchedulerEventRequest) ProtoMessage() {}
+
+func (x *UpdateSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateSchedulerEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSchedulerEventRequest) GetEvent() *SchedulerEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateSchedulerEventRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSchedulerEventResponse) Reset() {
+	*x = UpdateSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSchedulerEventResponse) ProtoMessage() {}
+
+func (x *UpdateSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateSchedulerEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSchedulerEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateSchedulerEventResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PatchSchedulerEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event *SchedulerEvent        `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// fields of event to write, the gateway fills it with the fields of the body
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// expected version of the event, the If-Match header is used when it is empty
+	Version       int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchSchedulerEventRequest) Reset() {
+	*x = PatchSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchSchedulerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSchedulerEventRequest) ProtoMessage() {}
+
+func (x *PatchSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*PatchSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PatchSchedulerEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchSchedulerEventRequest) GetEvent() *SchedulerEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *PatchSchedulerEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PatchSchedulerEventRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PatchSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *SchedulerEvent        `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchSchedulerEventResponse) Reset() {
+	*x = PatchSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSchedulerEventResponse) ProtoMessage() {}
+
+func (x *PatchSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*PatchSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PatchSchedulerEventResponse) GetEvent() *SchedulerEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type UpdateEventStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// run_id and error are refused, the crawler reports the results of the runs with ReportRunResult
+	RunId         string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventStatusRequest) Reset() {
+	*x = UpdateEventStatusRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventStatusRequest) ProtoMessage() {}
+
+func (x *UpdateEventStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateEventStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEventStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateEventStatusRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *UpdateEventStatusRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateEventStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventStatusResponse) Reset() {
+	*x = UpdateEventStatusResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventStatusResponse) ProtoMessage() {}
+
+func (x *UpdateEventStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateEventStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteSchedulerEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSchedulerEventRequest) Reset() {
+	*x = DeleteSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSchedulerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSchedulerEventRequest) ProtoMessage() {}
+
+func (x *DeleteSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteSchedulerEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSchedulerEventResponse) Reset() {
+	*x = DeleteSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSchedulerEventResponse) ProtoMessage() {}
+
+func (x *DeleteSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteSchedulerEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSchedulerEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RestoreSchedulerEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSchedulerEventRequest) Reset() {
+	*x = RestoreSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSchedulerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSchedulerEventRequest) ProtoMessage() {}
+
+func (x *RestoreSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreSchedulerEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSchedulerEventResponse) Reset() {
+	*x = RestoreSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSchedulerEventResponse) ProtoMessage() {}
+
+func (x *RestoreSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreSchedulerEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreSchedulerEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PurgeSchedulerEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeSchedulerEventRequest) Reset() {
+	*x = PurgeSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeSchedulerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeSchedulerEventRequest) ProtoMessage() {}
+
+func (x *PurgeSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*PurgeSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PurgeSchedulerEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeSchedulerEventResponse) Reset() {
+	*x = PurgeSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeSchedulerEventResponse) ProtoMessage() {}
+
+func (x *PurgeSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*PurgeSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PurgeSchedulerEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgeSchedulerEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RunNowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunNowRequest) Reset() {
+	*x = RunNowRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNowRequest) ProtoMessage() {}
+
+func (x *RunNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNowRequest.ProtoReflect.Descriptor instead.
+func (*RunNowRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RunNowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RunNowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunNowResponse) Reset() {
+	*x = RunNowResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNowResponse) ProtoMessage() {}
+
+func (x *RunNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNowResponse.ProtoReflect.Descriptor instead.
+func (*RunNowResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RunNowResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RunNowResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type PauseSchedulerEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSchedulerEventRequest) Reset() {
+	*x = PauseSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSchedulerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSchedulerEventRequest) ProtoMessage() {}
+
+func (x *PauseSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*PauseSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PauseSchedulerEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PauseSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSchedulerEventResponse) Reset() {
+	*x = PauseSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSchedulerEventResponse) ProtoMessage() {}
+
+func (x *PauseSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*PauseSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PauseSchedulerEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PauseSchedulerEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ResumeSchedulerEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSchedulerEventRequest) Reset() {
+	*x = ResumeSchedulerEventRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSchedulerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSchedulerEventRequest) ProtoMessage() {}
+
+func (x *ResumeSchedulerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSchedulerEventRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSchedulerEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResumeSchedulerEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResumeSchedulerEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventStatusResponse) GetStatus() string {
+func (x *ResumeSchedulerEventResponse) Reset() {
+	*x = ResumeSchedulerEventResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSchedulerEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSchedulerEventResponse) ProtoMessage() {}
+
+func (x *ResumeSchedulerEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSchedulerEventResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSchedulerEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResumeSchedulerEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResumeSchedulerEventResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
+type SkipNextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipNextRequest) Reset() {
+	*x = SkipNextRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipNextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipNextRequest) ProtoMessage() {}
+
+func (x *SkipNextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipNextRequest.ProtoReflect.Descriptor instead.
+func (*SkipNextRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SkipNextRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SkipNextResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// skipped slot, unix milliseconds
+	SkippedAt int64 `protobuf:"varint,2,opt,name=skipped_at,json=skippedAt,proto3" json:"skipped_at,omitempty"`
+	// next slot, unix milliseconds
+	SchedulerAt   int64 `protobuf:"varint,3,opt,name=scheduler_at,json=schedulerAt,proto3" json:"scheduler_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipNextResponse) Reset() {
+	*x = SkipNextResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipNextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipNextResponse) ProtoMessage() {}
+
+func (x *SkipNextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipNextResponse.ProtoReflect.Descriptor instead.
+func (*SkipNextResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SkipNextResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SkipNextResponse) GetSkippedAt() int64 {
+	if x != nil {
+		return x.SkippedAt
+	}
+	return 0
+}
+
+func (x *SkipNextResponse) GetSchedulerAt() int64 {
+	if x != nil {
+		return x.SchedulerAt
+	}
+	return 0
+}
+
+type BackfillRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// slots in [from, to] are enqueued, unix milliseconds
+	From          int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillRequest) Reset() {
+	*x = BackfillRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillRequest) ProtoMessage() {}
+
+func (x *BackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillRequest.ProtoReflect.Descriptor instead.
+func (*BackfillRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BackfillRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BackfillRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *BackfillRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type BackfillResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunIds []string               `protobuf:"bytes,2,rep,name=run_ids,json=runIds,proto3" json:"run_ids,omitempty"`
+	// slots which already have a run
+	Skipped       int32 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillResponse) Reset() {
+	*x = BackfillResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillResponse) ProtoMessage() {}
+
+func (x *BackfillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillResponse.ProtoReflect.Descriptor instead.
+func (*BackfillResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BackfillResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BackfillResponse) GetRunIds() []string {
+	if x != nil {
+		return x.RunIds
+	}
+	return nil
+}
+
+func (x *BackfillResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type PreviewCrawlRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the event is checked like the event of a create, it is not saved
+	Event         *SchedulerEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCrawlRequest) Reset() {
+	*x = PreviewCrawlRequest{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCrawlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCrawlRequest) ProtoMessage() {}
+
+func (x *PreviewCrawlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCrawlRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCrawlRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PreviewCrawlRequest) GetEvent() *SchedulerEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type PreviewCrawlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preview       *CrawlPreview          `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCrawlResponse) Reset() {
+	*x = PreviewCrawlResponse{}
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCrawlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCrawlResponse) ProtoMessage() {}
+
+func (x *PreviewCrawlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_event_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCrawlResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCrawlResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_event_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PreviewCrawlResponse) GetPreview() *CrawlPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
 var File_pkg_proto_scheduler_event_proto protoreflect.FileDescriptor
 
 const file_pkg_proto_scheduler_event_proto_rawDesc = "" +
 	"\n" +
-	"\x1fpkg/proto/scheduler_event.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\"\x92\x03\n" +
-	"\x0eSchedulerEvent\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
-	"\x06method\x18\x03 \x01(\tR\x06method\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05queue\x18\x05 \x01(\tR\x05queue\x12\x16\n" +
-	"\x06domain\x18\x06 \x01(\tR\x06domain\x12\x1b\n" +
-	"\tis_active\x18\a \x01(\bR\bisActive\x12\"\n" +
-	"\rnext_run_time\x18\b \x01(\x03R\vnextRunTime\x12!\n" +
-	"\frepeat_times\x18\t \x01(\x03R\vrepeatTimes\x12!\n" +
+	"\x1fpkg/proto/scheduler_event.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\x1a pkg/proto/crawler_internal.proto\"\x98\x05\n" +
+	"\x0eSchedulerEvent\x12\"\n" +
+	"\x02id\x18\x01 \x01(\tB\x12\xfaB\x0fr\r2\b^[0-9]+$\xd0\x01\x01R\x02id\x12\x1b\n" +
+	"\x03url\x18\x02 \x01(\tB\t\xfaB\x06r\x04(\x80\x80\x04R\x03url\x12\x1f\n" +
+	"\x06method\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18\x10R\x06method\x12*\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80 R\vdescription\x12\x1d\n" +
+	"\x05queue\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\x05queue\x12 \n" +
+	"\x06domain\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06domain\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12+\n" +
+	"\rnext_run_time\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vnextRunTime\x12*\n" +
+	"\frepeat_times\x18\t \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vrepeatTimes\x12*\n" +
 	"\fscheduler_at\x18\n" +
-	" \x01(\x03R\vschedulerAt\x12\x16\n" +
-	"\x06status\x18\v \x01(\tR\x06status\x12\x19\n" +
-	"\bcron_exp\x18\f \x01(\tR\acronExp\x12\x1d\n" +
+	" \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vschedulerAt\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12#\n" +
+	"\bcron_exp\x18\f \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\acronExp\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"Q\n" +
-	"\x1bCreateSchedulerEventRequest\x122\n" +
-	"\x05event\x18\x01 \x01(\v2\x1c.scheduler.v1.SchedulerEventR\x05event\"F\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12\x1c\n" +
+	"\x04name\x18\x0f \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04name\x12!\n" +
+	"\aversion\x18\x10 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aversion\x12\x1b\n" +
+	"\x04team\x18\x11 \x01(\tB\a\xfaB\x04r\x02\x18@R\x04team\x12<\n" +
+	"\fretry_policy\x18\x12 \x01(\v2\x19.scheduler.v1.RetryPolicyR\vretryPolicy\"\xb8\x03\n" +
+	"\vRetryPolicy\x12,\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x14(\x00R\vmaxAttempts\x126\n" +
+	"\x10initial_delay_ms\x18\x02 \x01(\x03B\f\xfaB\t\"\a\x18\x80\xb8\x99)(\x00R\x0einitialDelayMs\x129\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00$@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\n" +
+	"multiplier\x12.\n" +
+	"\fmax_delay_ms\x18\x04 \x01(\x03B\f\xfaB\t\"\a\x18\x80\xb8\x99)(\x00R\n" +
+	"maxDelayMs\x124\n" +
+	"\x06jitter\x18\x05 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x06jitter\x88\x01\x01\x12\x96\x01\n" +
+	"\bretry_on\x18\x06 \x03(\tB{\xfaBx\x92\x01u\x18\x01\"qroR\atimeoutR\n" +
+	"connectionR\fserver_errorR\frate_limitedR\tnot_foundR\fclient_errorR\x11robots_disallowedR\ainvalidR\aunknownR\aretryOnB\t\n" +
+	"\a_jitter\"[\n" +
+	"\x1bCreateSchedulerEventRequest\x12<\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.scheduler.v1.SchedulerEventB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05event\"F\n" +
 	"\x1cCreateSchedulerEventResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"I\n" +
-	"\x19GetSchedulerEventsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"R\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"^\n" +
+	"\x19GetSchedulerEventsRequest\x12 \n" +
+	"\x05limit\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06offset\"R\n" +
 	"\x1aGetSchedulerEventsResponse\x124\n" +
-	"\x06events\x18\x01 \x03(\v2\x1c.scheduler.v1.SchedulerEventR\x06events\"a\n" +
-	"\x1bUpdateSchedulerEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
-	"\x05event\x18\x02 \x01(\v2\x1c.scheduler.v1.SchedulerEventR\x05event\"F\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.scheduler.v1.SchedulerEventR\x06events\";\n" +
+	"\x18GetSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"O\n" +
+	"\x19GetSchedulerEventResponse\x122\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.scheduler.v1.SchedulerEventR\x05event\"\xa7\x03\n" +
+	"\x1aListSchedulerEventsRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12 \n" +
+	"\tis_active\x18\x05 \x01(\bH\x00R\bisActive\x88\x01\x01\x12!\n" +
+	"\fcreated_from\x18\x06 \x01(\x03R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\a \x01(\x03R\tcreatedTo\x12!\n" +
+	"\fupdated_from\x18\b \x01(\x03R\vupdatedFrom\x12\x1d\n" +
+	"\n" +
+	"updated_to\x18\t \x01(\x03R\tupdatedTo\x12\x14\n" +
+	"\x05query\x18\n" +
+	" \x01(\tR\x05query\x12\x19\n" +
+	"\border_by\x18\v \x01(\tR\aorderBy\x12'\n" +
+	"\tpage_size\x18\f \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tR\tpageTokenB\f\n" +
+	"\n" +
+	"_is_active\"{\n" +
+	"\x1bListSchedulerEventsResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.scheduler.v1.SchedulerEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"z\n" +
+	"\x1aBulkSchedulerEventsRequest\x12C\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.scheduler.v1.SchedulerEventB\r\xfaB\n" +
+	"\x92\x01\a\"\x05\x8a\x01\x02\b\x01R\x06events\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"e\n" +
+	"\x0fBulkEventResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x8c\x01\n" +
+	"\x1bBulkSchedulerEventsResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.scheduler.v1.BulkEventResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"6\n" +
+	"\x1cExportSchedulerEventsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"\x9f\x01\n" +
+	"\x1bUpdateSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\x12<\n" +
+	"\x05event\x18\x02 \x01(\v2\x1c.scheduler.v1.SchedulerEventB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05event\x12!\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aversion\"`\n" +
 	"\x1cUpdateSchedulerEventResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"B\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"\xdb\x01\n" +
+	"\x1aPatchSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\x12<\n" +
+	"\x05event\x18\x02 \x01(\v2\x1c.scheduler.v1.SchedulerEventB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05event\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12!\n" +
+	"\aversion\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aversion\"Q\n" +
+	"\x1bPatchSchedulerEventResponse\x122\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.scheduler.v1.SchedulerEventR\x05event\"o\n" +
 	"\x18UpdateEventStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"3\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"3\n" +
 	"\x19UpdateEventStatusResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2\xbb\x04\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\">\n" +
+	"\x1bDeleteSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"F\n" +
+	"\x1cDeleteSchedulerEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"?\n" +
+	"\x1cRestoreSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"G\n" +
+	"\x1dRestoreSchedulerEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"=\n" +
+	"\x1aPurgeSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"E\n" +
+	"\x1bPurgeSchedulerEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"0\n" +
+	"\rRunNowRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"7\n" +
+	"\x0eRunNowResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"=\n" +
+	"\x1aPauseSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"E\n" +
+	"\x1bPauseSchedulerEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\">\n" +
+	"\x1bResumeSchedulerEventRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"F\n" +
+	"\x1cResumeSchedulerEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"2\n" +
+	"\x0fSkipNextRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\"d\n" +
+	"\x10SkipNextResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"skipped_at\x18\x02 \x01(\x03R\tskippedAt\x12!\n" +
+	"\fscheduler_at\x18\x03 \x01(\x03R\vschedulerAt\"h\n" +
+	"\x0fBackfillRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[0-9]+$R\x02id\x12\x1b\n" +
+	"\x04from\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x04from\x12\x17\n" +
+	"\x02to\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02to\"U\n" +
+	"\x10BackfillResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arun_ids\x18\x02 \x03(\tR\x06runIds\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x05R\askipped\"S\n" +
+	"\x13PreviewCrawlRequest\x12<\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.scheduler.v1.SchedulerEventB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05event\"L\n" +
+	"\x14PreviewCrawlResponse\x124\n" +
+	"\apreview\x18\x01 \x01(\v2\x1a.scheduler.v1.CrawlPreviewR\apreview2\xb6\x14\n" +
 	"\x15SchedulerEventService\x12\x87\x01\n" +
 	"\x14CreateSchedulerEvent\x12).scheduler.v1.CreateSchedulerEventRequest\x1a*.scheduler.v1.CreateSchedulerEventResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/event\x12\x7f\n" +
-	"\x12GetSchedulerEvents\x12'.scheduler.v1.GetSchedulerEventsRequest\x1a(.scheduler.v1.GetSchedulerEventsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/events\x12\x8d\x01\n" +
-	"\x14UpdateSchedulerEvent\x12).scheduler.v1.UpdateSchedulerEventRequest\x1a*.scheduler.v1.UpdateSchedulerEventResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/events/{id}\x12\x86\x01\n" +
-	"\x11UpdateEventStatus\x12&.scheduler.v1.UpdateEventStatusRequest\x1a'.scheduler.v1.UpdateEventStatusResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/events/statusB\x9f\x01\n" +
+	"\x12GetSchedulerEvents\x12'.scheduler.v1.GetSchedulerEventsRequest\x1a(.scheduler.v1.GetSchedulerEventsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/events\x12\x81\x01\n" +
+	"\x11GetSchedulerEvent\x12&.scheduler.v1.GetSchedulerEventRequest\x1a'.scheduler.v1.GetSchedulerEventResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/events/{id}\x12\x87\x01\n" +
+	"\x13ListSchedulerEvents\x12(.scheduler.v1.ListSchedulerEventsRequest\x1a).scheduler.v1.ListSchedulerEventsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/events:list\x12\x96\x01\n" +
+	"\x19BulkCreateSchedulerEvents\x12(.scheduler.v1.BulkSchedulerEventsRequest\x1a).scheduler.v1.BulkSchedulerEventsResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/events:bulkCreate\x12\x96\x01\n" +
+	"\x19BulkUpsertSchedulerEvents\x12(.scheduler.v1.BulkSchedulerEventsRequest\x1a).scheduler.v1.BulkSchedulerEventsResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/events:bulkUpsert\x12z\n" +
+	"\x15ExportSchedulerEvents\x12*.scheduler.v1.ExportSchedulerEventsRequest\x1a\x14.google.api.HttpBody\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/events:export0\x01\x12\x8d\x01\n" +
+	"\x14UpdateSchedulerEvent\x12).scheduler.v1.UpdateSchedulerEventRequest\x1a*.scheduler.v1.UpdateSchedulerEventResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/events/{id}\x12\x8e\x01\n" +
+	"\x13PatchSchedulerEvent\x12(.scheduler.v1.PatchSchedulerEventRequest\x1a).scheduler.v1.PatchSchedulerEventResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x05event2\x13/api/v1/events/{id}\x12\x86\x01\n" +
+	"\x11UpdateEventStatus\x12&.scheduler.v1.UpdateEventStatusRequest\x1a'.scheduler.v1.UpdateEventStatusResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/events/status\x12\x8a\x01\n" +
+	"\x14DeleteSchedulerEvent\x12).scheduler.v1.DeleteSchedulerEventRequest\x1a*.scheduler.v1.DeleteSchedulerEventResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/events/{id}\x12\x98\x01\n" +
+	"\x15RestoreSchedulerEvent\x12*.scheduler.v1.RestoreSchedulerEventRequest\x1a+.scheduler.v1.RestoreSchedulerEventResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/events/{id}/restore\x12\x8d\x01\n" +
+	"\x13PurgeSchedulerEvent\x12(.scheduler.v1.PurgeSchedulerEventRequest\x1a).scheduler.v1.PurgeSchedulerEventResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/events/{id}/purge\x12g\n" +
+	"\x06RunNow\x12\x1b.scheduler.v1.RunNowRequest\x1a\x1c.scheduler.v1.RunNowResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/events/{id}/run\x12\x90\x01\n" +
+	"\x13PauseSchedulerEvent\x12(.scheduler.v1.PauseSchedulerEventRequest\x1a).scheduler.v1.PauseSchedulerEventResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/events/{id}/pause\x12\x94\x01\n" +
+	"\x14ResumeSchedulerEvent\x12).scheduler.v1.ResumeSchedulerEventRequest\x1a*.scheduler.v1.ResumeSchedulerEventResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/events/{id}/resume\x12s\n" +
+	"\bSkipNext\x12\x1d.scheduler.v1.SkipNextRequest\x1a\x1e.scheduler.v1.SkipNextResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/events/{id}/skip_next\x12r\n" +
+	"\bBackfill\x12\x1d.scheduler.v1.BackfillRequest\x1a\x1e.scheduler.v1.BackfillResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/events/{id}/backfill\x12x\n" +
+	"\fPreviewCrawl\x12!.scheduler.v1.PreviewCrawlRequest\x1a\".scheduler.v1.PreviewCrawlResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/events:previewB\x9f\x01\n" +
 	"\x10com.scheduler.v1B\x13SchedulerEventProtoP\x01Z%crawler-service/pkg/proto;schedulerv1\xa2\x02\x03SXX\xaa\x02\fScheduler.V1\xca\x02\fScheduler\\V1\xe2\x02\x18Scheduler\\V1\\GPBMetadata\xea\x02\rScheduler::V1b\x06proto3"
 
 var (
@@ -625,35 +2459,107 @@ func file_pkg_proto_scheduler_event_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_scheduler_event_proto_rawDescData
 }
 
-var file_pkg_proto_scheduler_event_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_pkg_proto_scheduler_event_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_pkg_proto_scheduler_event_proto_goTypes = []any{
-	(*SchedulerEvent)(nil),               // 0: scheduler.v1.SchedulerEvent
-	(*CreateSchedulerEventRequest)(nil),  // 1: scheduler.v1.CreateSchedulerEventRequest
-	(*CreateSchedulerEventResponse)(nil), // 2: scheduler.v1.CreateSchedulerEventResponse
-	(*GetSchedulerEventsRequest)(nil),    // 3: scheduler.v1.GetSchedulerEventsRequest
-	(*GetSchedulerEventsResponse)(nil),   // 4: scheduler.v1.GetSchedulerEventsResponse
-	(*UpdateSchedulerEventRequest)(nil),  // 5: scheduler.v1.UpdateSchedulerEventRequest
-	(*UpdateSchedulerEventResponse)(nil), // 6: scheduler.v1.UpdateSchedulerEventResponse
-	(*UpdateEventStatusRequest)(nil),     // 7: scheduler.v1.UpdateEventStatusRequest
-	(*UpdateEventStatusResponse)(nil),    // 8: scheduler.v1.UpdateEventStatusResponse
+	(*SchedulerEvent)(nil),                // 0: scheduler.v1.SchedulerEvent
+	(*RetryPolicy)(nil),                   // 1: scheduler.v1.RetryPolicy
+	(*CreateSchedulerEventRequest)(nil),   // 2: scheduler.v1.CreateSchedulerEventRequest
+	(*CreateSchedulerEventResponse)(nil),  // 3: scheduler.v1.CreateSchedulerEventResponse
+	(*GetSchedulerEventsRequest)(nil),     // 4: scheduler.v1.GetSchedulerEventsRequest
+	(*GetSchedulerEventsResponse)(nil),    // 5: scheduler.v1.GetSchedulerEventsResponse
+	(*GetSchedulerEventRequest)(nil),      // 6: scheduler.v1.GetSchedulerEventRequest
+	(*GetSchedulerEventResponse)(nil),     // 7: scheduler.v1.GetSchedulerEventResponse
+	(*ListSchedulerEventsRequest)(nil),    // 8: scheduler.v1.ListSchedulerEventsRequest
+	(*ListSchedulerEventsResponse)(nil),   // 9: scheduler.v1.ListSchedulerEventsResponse
+	(*BulkSchedulerEventsRequest)(nil),    // 10: scheduler.v1.BulkSchedulerEventsRequest
+	(*BulkEventResult)(nil),               // 11: scheduler.v1.BulkEventResult
+	(*BulkSchedulerEventsResponse)(nil),   // 12: scheduler.v1.BulkSchedulerEventsResponse
+	(*ExportSchedulerEventsRequest)(nil),  // 13: scheduler.v1.ExportSchedulerEventsRequest
+	(*UpdateSchedulerEventRequest)(nil),   // 14: scheduler.v1.UpdateSchedulerEventRequest
+	(*UpdateSchedulerEventResponse)(nil),  // 15: scheduler.v1.UpdateSchedulerEventResponse
+	(*PatchSchedulerEventRequest)(nil),    // 16: scheduler.v1.PatchSchedulerEventRequest
+	(*PatchSchedulerEventResponse)(nil),   // 17: scheduler.v1.PatchSchedulerEventResponse
+	(*UpdateEventStatusRequest)(nil),      // 18: scheduler.v1.UpdateEventStatusRequest
+	(*UpdateEventStatusResponse)(nil),     // 19: scheduler.v1.UpdateEventStatusResponse
+	(*DeleteSchedulerEventRequest)(nil),   // 20: scheduler.v1.DeleteSchedulerEventRequest
+	(*DeleteSchedulerEventResponse)(nil),  // 21: scheduler.v1.DeleteSchedulerEventResponse
+	(*RestoreSchedulerEventRequest)(nil),  // 22: scheduler.v1.RestoreSchedulerEventRequest
+	(*RestoreSchedulerEventResponse)(nil), // 23: scheduler.v1.RestoreSchedulerEventResponse
+	(*PurgeSchedulerEventRequest)(nil),    // 24: scheduler.v1.PurgeSchedulerEventRequest
+	(*PurgeSchedulerEventResponse)(nil),   // 25: scheduler.v1.PurgeSchedulerEventResponse
+	(*RunNowRequest)(nil),                 // 26: scheduler.v1.RunNowRequest
+	(*RunNowResponse)(nil),                // 27: scheduler.v1.RunNowResponse
+	(*PauseSchedulerEventRequest)(nil),    // 28: scheduler.v1.PauseSchedulerEventRequest
+	(*PauseSchedulerEventResponse)(nil),   // 29: scheduler.v1.PauseSchedulerEventResponse
+	(*ResumeSchedulerEventRequest)(nil),   // 30: scheduler.v1.ResumeSchedulerEventRequest
+	(*ResumeSchedulerEventResponse)(nil),  // 31: scheduler.v1.ResumeSchedulerEventResponse
+	(*SkipNextRequest)(nil),               // 32: scheduler.v1.SkipNextRequest
+	(*SkipNextResponse)(nil),              // 33: scheduler.v1.SkipNextResponse
+	(*BackfillRequest)(nil),               // 34: scheduler.v1.BackfillRequest
+	(*BackfillResponse)(nil),              // 35: scheduler.v1.BackfillResponse
+	(*PreviewCrawlRequest)(nil),           // 36: scheduler.v1.PreviewCrawlRequest
+	(*PreviewCrawlResponse)(nil),          // 37: scheduler.v1.PreviewCrawlResponse
+	(*fieldmaskpb.FieldMask)(nil),         // 38: google.protobuf.FieldMask
+	(*CrawlPreview)(nil),                  // 39: scheduler.v1.CrawlPreview
+	(*httpbody.HttpBody)(nil),             // 40: google.api.HttpBody
 }
 var file_pkg_proto_scheduler_event_proto_depIdxs = []int32{
-	0, // 0: scheduler.v1.CreateSchedulerEventRequest.event:type_name -> scheduler.v1.SchedulerEvent
-	0, // 1: scheduler.v1.GetSchedulerEventsResponse.events:type_name -> scheduler.v1.SchedulerEvent
-	0, // 2: scheduler.v1.UpdateSchedulerEventRequest.event:type_name -> scheduler.v1.SchedulerEvent
-	1, // 3: scheduler.v1.SchedulerEventService.CreateSchedulerEvent:input_type -> scheduler.v1.CreateSchedulerEventRequest
-	3, // 4: scheduler.v1.SchedulerEventService.GetSchedulerEvents:input_type -> scheduler.v1.GetSchedulerEventsRequest
-	5, // 5: scheduler.v1.SchedulerEventService.UpdateSchedulerEvent:input_type -> scheduler.v1.UpdateSchedulerEventRequest
-	7, // 6: scheduler.v1.SchedulerEventService.UpdateEventStatus:input_type -> scheduler.v1.UpdateEventStatusRequest
-	2, // 7: scheduler.v1.SchedulerEventService.CreateSchedulerEvent:output_type -> scheduler.v1.CreateSchedulerEventResponse
-	4, // 8: scheduler.v1.SchedulerEventService.GetSchedulerEvents:output_type -> scheduler.v1.GetSchedulerEventsResponse
-	6, // 9: scheduler.v1.SchedulerEventService.UpdateSchedulerEvent:output_type -> scheduler.v1.UpdateSchedulerEventResponse
-	8, // 10: scheduler.v1.SchedulerEventService.UpdateEventStatus:output_type -> scheduler.v1.UpdateEventStatusResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: scheduler.v1.SchedulerEvent.retry_policy:type_name -> scheduler.v1.RetryPolicy
+	0,  // 1: scheduler.v1.CreateSchedulerEventRequest.event:type_name -> scheduler.v1.SchedulerEvent
+	0,  // 2: scheduler.v1.GetSchedulerEventsResponse.events:type_name -> scheduler.v1.SchedulerEvent
+	0,  // 3: scheduler.v1.GetSchedulerEventResponse.event:type_name -> scheduler.v1.SchedulerEvent
+	0,  // 4: scheduler.v1.ListSchedulerEventsResponse.events:type_name -> scheduler.v1.SchedulerEvent
+	0,  // 5: scheduler.v1.BulkSchedulerEventsRequest.events:type_name -> scheduler.v1.SchedulerEvent
+	11, // 6: scheduler.v1.BulkSchedulerEventsResponse.results:type_name -> scheduler.v1.BulkEventResult
+	0,  // 7: scheduler.v1.UpdateSchedulerEventRequest.event:type_name -> scheduler.v1.SchedulerEvent
+	0,  // 8: scheduler.v1.PatchSchedulerEventRequest.event:type_name -> scheduler.v1.SchedulerEvent
+	38, // 9: scheduler.v1.PatchSchedulerEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 10: scheduler.v1.PatchSchedulerEventResponse.event:type_name -> scheduler.v1.SchedulerEvent
+	0,  // 11: scheduler.v1.PreviewCrawlRequest.event:type_name -> scheduler.v1.SchedulerEvent
+	39, // 12: scheduler.v1.PreviewCrawlResponse.preview:type_name -> scheduler.v1.CrawlPreview
+	2,  // 13: scheduler.v1.SchedulerEventService.CreateSchedulerEvent:input_type -> scheduler.v1.CreateSchedulerEventRequest
+	4,  // 14: scheduler.v1.SchedulerEventService.GetSchedulerEvents:input_type -> scheduler.v1.GetSchedulerEventsRequest
+	6,  // 15: scheduler.v1.SchedulerEventService.GetSchedulerEvent:input_type -> scheduler.v1.GetSchedulerEventRequest
+	8,  // 16: scheduler.v1.SchedulerEventService.ListSchedulerEvents:input_type -> scheduler.v1.ListSchedulerEventsRequest
+	10, // 17: scheduler.v1.SchedulerEventService.BulkCreateSchedulerEvents:input_type -> scheduler.v1.BulkSchedulerEventsRequest
+	10, // 18: scheduler.v1.SchedulerEventService.BulkUpsertSchedulerEvents:input_type -> scheduler.v1.BulkSchedulerEventsRequest
+	13, // 19: scheduler.v1.SchedulerEventService.ExportSchedulerEvents:input_type -> scheduler.v1.ExportSchedulerEventsRequest
+	14, // 20: scheduler.v1.SchedulerEventService.UpdateSchedulerEvent:input_type -> scheduler.v1.UpdateSchedulerEventRequest
+	16, // 21: scheduler.v1.SchedulerEventService.PatchSchedulerEvent:input_type -> scheduler.v1.PatchSchedulerEventRequest
+	18, // 22: scheduler.v1.SchedulerEventService.UpdateEventStatus:input_type -> scheduler.v1.UpdateEventStatusRequest
+	20, // 23: scheduler.v1.SchedulerEventService.DeleteSchedulerEvent:input_type -> scheduler.v1.DeleteSchedulerEventRequest
+	22, // 24: scheduler.v1.SchedulerEventService.RestoreSchedulerEvent:input_type -> scheduler.v1.RestoreSchedulerEventRequest
+	24, // 25: scheduler.v1.SchedulerEventService.PurgeSchedulerEvent:input_type -> scheduler.v1.PurgeSchedulerEventRequest
+	26, // 26: scheduler.v1.SchedulerEventService.RunNow:input_type -> scheduler.v1.RunNowRequest
+	28, // 27: scheduler.v1.SchedulerEventService.PauseSchedulerEvent:input_type -> scheduler.v1.PauseSchedulerEventRequest
+	30, // 28: scheduler.v1.SchedulerEventService.ResumeSchedulerEvent:input_type -> scheduler.v1.ResumeSchedulerEventRequest
+	32, // 29: scheduler.v1.SchedulerEventService.SkipNext:input_type -> scheduler.v1.SkipNextRequest
+	34, // 30: scheduler.v1.SchedulerEventService.Backfill:input_type -> scheduler.v1.BackfillRequest
+	36, // 31: scheduler.v1.SchedulerEventService.PreviewCrawl:input_type -> scheduler.v1.PreviewCrawlRequest
+	3,  // 32: scheduler.v1.SchedulerEventService.CreateSchedulerEvent:output_type -> scheduler.v1.CreateSchedulerEventResponse
+	5,  // 33: scheduler.v1.SchedulerEventService.GetSchedulerEvents:output_type -> scheduler.v1.GetSchedulerEventsResponse
+	7,  // 34: scheduler.v1.SchedulerEventService.GetSchedulerEvent:output_type -> scheduler.v1.GetSchedulerEventResponse
+	9,  // 35: scheduler.v1.SchedulerEventService.ListSchedulerEvents:output_type -> scheduler.v1.ListSchedulerEventsResponse
+	12, // 36: scheduler.v1.SchedulerEventService.BulkCreateSchedulerEvents:output_type -> scheduler.v1.BulkSchedulerEventsResponse
+	12, // 37: scheduler.v1.SchedulerEventService.BulkUpsertSchedulerEvents:output_type -> scheduler.v1.BulkSchedulerEventsResponse
+	40, // 38: scheduler.v1.SchedulerEventService.ExportSchedulerEvents:output_type -> google.api.HttpBody
+	15, // 39: scheduler.v1.SchedulerEventService.UpdateSchedulerEvent:output_type -> scheduler.v1.UpdateSchedulerEventResponse
+	17, // 40: scheduler.v1.SchedulerEventService.PatchSchedulerEvent:output_type -> scheduler.v1.PatchSchedulerEventResponse
+	19, // 41: scheduler.v1.SchedulerEventService.UpdateEventStatus:output_type -> scheduler.v1.UpdateEventStatusResponse
+	21, // 42: scheduler.v1.SchedulerEventService.DeleteSchedulerEvent:output_type -> scheduler.v1.DeleteSchedulerEventResponse
+	23, // 43: scheduler.v1.SchedulerEventService.RestoreSchedulerEvent:output_type -> scheduler.v1.RestoreSchedulerEventResponse
+	25, // 44: scheduler.v1.SchedulerEventService.PurgeSchedulerEvent:output_type -> scheduler.v1.PurgeSchedulerEventResponse
+	27, // 45: scheduler.v1.SchedulerEventService.RunNow:output_type -> scheduler.v1.RunNowResponse
+	29, // 46: scheduler.v1.SchedulerEventService.PauseSchedulerEvent:output_type -> scheduler.v1.PauseSchedulerEventResponse
+	31, // 47: scheduler.v1.SchedulerEventService.ResumeSchedulerEvent:output_type -> scheduler.v1.ResumeSchedulerEventResponse
+	33, // 48: scheduler.v1.SchedulerEventService.SkipNext:output_type -> scheduler.v1.SkipNextResponse
+	35, // 49: scheduler.v1.SchedulerEventService.Backfill:output_type -> scheduler.v1.BackfillResponse
+	37, // 50: scheduler.v1.SchedulerEventService.PreviewCrawl:output_type -> scheduler.v1.PreviewCrawlResponse
+	32, // [32:51] is the sub-list for method output_type
+	13, // [13:32] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_scheduler_event_proto_init() }
@@ -661,13 +2567,16 @@ func file_pkg_proto_scheduler_event_proto_init() {
 	if File_pkg_proto_scheduler_event_proto != nil {
 		return
 	}
+	file_pkg_proto_crawler_internal_proto_init()
+	file_pkg_proto_scheduler_event_proto_msgTypes[1].OneofWrappers = []any{}
+	file_pkg_proto_scheduler_event_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_scheduler_event_proto_rawDesc), len(file_pkg_proto_scheduler_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
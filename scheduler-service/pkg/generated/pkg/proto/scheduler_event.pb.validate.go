@@ -57,34 +57,190 @@ func (m *SchedulerEvent) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetId() != "" {
 
-	// no validation rules for Url
+		if !_SchedulerEvent_Id_Pattern.MatchString(m.GetId()) {
+			err := SchedulerEventValidationError{
+				field:  "Id",
+				reason: "value does not match regex pattern \"^[0-9]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for Method
+	}
 
-	// no validation rules for Description
+	if len(m.GetUrl()) > 65536 {
+		err := SchedulerEventValidationError{
+			field:  "Url",
+			reason: "value length must be at most 65536 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Queue
+	if utf8.RuneCountInString(m.GetMethod()) > 16 {
+		err := SchedulerEventValidationError{
+			field:  "Method",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Domain
+	if utf8.RuneCountInString(m.GetDescription()) > 4096 {
+		err := SchedulerEventValidationError{
+			field:  "Description",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQueue()) > 64 {
+		err := SchedulerEventValidationError{
+			field:  "Queue",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := SchedulerEventValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for IsActive
 
-	// no validation rules for NextRunTime
+	if m.GetNextRunTime() < 0 {
+		err := SchedulerEventValidationError{
+			field:  "NextRunTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for RepeatTimes
+	if m.GetRepeatTimes() < 0 {
+		err := SchedulerEventValidationError{
+			field:  "RepeatTimes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for SchedulerAt
+	if m.GetSchedulerAt() < 0 {
+		err := SchedulerEventValidationError{
+			field:  "SchedulerAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Status
 
-	// no validation rules for CronExp
+	if utf8.RuneCountInString(m.GetCronExp()) > 255 {
+		err := SchedulerEventValidationError{
+			field:  "CronExp",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for CreatedAt
 
 	// no validation rules for UpdatedAt
 
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := SchedulerEventValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := SchedulerEventValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTeam()) > 64 {
+		err := SchedulerEventValidationError{
+			field:  "Team",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchedulerEventValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchedulerEventValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchedulerEventValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return SchedulerEventMultiError(errors)
 	}
@@ -163,6 +319,213 @@ var _ interface {
 	ErrorName() string
 } = SchedulerEventValidationError{}
 
+var _SchedulerEvent_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetMaxAttempts(); val < 0 || val > 20 {
+		err := RetryPolicyValidationError{
+			field:  "MaxAttempts",
+			reason: "value must be inside range [0, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetInitialDelayMs(); val < 0 || val > 86400000 {
+		err := RetryPolicyValidationError{
+			field:  "InitialDelayMs",
+			reason: "value must be inside range [0, 86400000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMultiplier() != 0 {
+
+		if val := m.GetMultiplier(); val < 1 || val > 10 {
+			err := RetryPolicyValidationError{
+				field:  "Multiplier",
+				reason: "value must be inside range [1, 10]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetMaxDelayMs(); val < 0 || val > 86400000 {
+		err := RetryPolicyValidationError{
+			field:  "MaxDelayMs",
+			reason: "value must be inside range [0, 86400000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RetryPolicy_RetryOn_Unique := make(map[string]struct{}, len(m.GetRetryOn()))
+
+	for idx, item := range m.GetRetryOn() {
+		_, _ = idx, item
+
+		if _, exists := _RetryPolicy_RetryOn_Unique[item]; exists {
+			err := RetryPolicyValidationError{
+				field:  fmt.Sprintf("RetryOn[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RetryPolicy_RetryOn_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _RetryPolicy_RetryOn_InLookup[item]; !ok {
+			err := RetryPolicyValidationError{
+				field:  fmt.Sprintf("RetryOn[%v]", idx),
+				reason: "value must be in list [timeout connection server_error rate_limited not_found client_error robots_disallowed invalid unknown]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Jitter != nil {
+
+		if val := m.GetJitter(); val < 0 || val > 1 {
+			err := RetryPolicyValidationError{
+				field:  "Jitter",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RetryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
+
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryPolicyValidationError{}
+
+var _RetryPolicy_RetryOn_InLookup = map[string]struct{}{
+	"timeout":           {},
+	"connection":        {},
+	"server_error":      {},
+	"rate_limited":      {},
+	"not_found":         {},
+	"client_error":      {},
+	"robots_disallowed": {},
+	"invalid":           {},
+	"unknown":           {},
+}
+
 // Validate checks the field values on CreateSchedulerEventRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -185,6 +548,17 @@ func (m *CreateSchedulerEventRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetEvent() == nil {
+		err := CreateSchedulerEventRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetEvent()).(type) {
 		case interface{ ValidateAll() error }:
@@ -424,9 +798,27 @@ func (m *GetSchedulerEventsRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Limit
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := GetSchedulerEventsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Offset
+	if m.GetOffset() < 0 {
+		err := GetSchedulerEventsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return GetSchedulerEventsRequestMultiError(errors)
@@ -644,73 +1036,53 @@ var _ interface {
 	ErrorName() string
 } = GetSchedulerEventsResponseValidationError{}
 
-// Validate checks the field values on UpdateSchedulerEventRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetSchedulerEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSchedulerEventRequest) Validate() error {
+func (m *GetSchedulerEventRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSchedulerEventRequest with the
+// ValidateAll checks the field values on GetSchedulerEventRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateSchedulerEventRequestMultiError, or nil if none found.
-func (m *UpdateSchedulerEventRequest) ValidateAll() error {
+// GetSchedulerEventRequestMultiError, or nil if none found.
+func (m *GetSchedulerEventRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSchedulerEventRequest) validate(all bool) error {
+func (m *GetSchedulerEventRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateSchedulerEventRequestValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateSchedulerEventRequestValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if !_GetSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := GetSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
 		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateSchedulerEventRequestValidationError{
-				field:  "Event",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateSchedulerEventRequestMultiError(errors)
+		return GetSchedulerEventRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSchedulerEventRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateSchedulerEventRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateSchedulerEventRequestMultiError []error
+// GetSchedulerEventRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSchedulerEventRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSchedulerEventRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSchedulerEventRequestMultiError) Error() string {
+func (m GetSchedulerEventRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -719,12 +1091,11 @@ func (m UpdateSchedulerEventRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSchedulerEventRequestMultiError) AllErrors() []error { return m }
+func (m GetSchedulerEventRequestMultiError) AllErrors() []error { return m }
 
-// UpdateSchedulerEventRequestValidationError is the validation error returned
-// by UpdateSchedulerEventRequest.Validate if the designated constraints
-// aren't met.
-type UpdateSchedulerEventRequestValidationError struct {
+// GetSchedulerEventRequestValidationError is the validation error returned by
+// GetSchedulerEventRequest.Validate if the designated constraints aren't met.
+type GetSchedulerEventRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -732,24 +1103,24 @@ type UpdateSchedulerEventRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSchedulerEventRequestValidationError) Field() string { return e.field }
+func (e GetSchedulerEventRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSchedulerEventRequestValidationError) Reason() string { return e.reason }
+func (e GetSchedulerEventRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSchedulerEventRequestValidationError) Cause() error { return e.cause }
+func (e GetSchedulerEventRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSchedulerEventRequestValidationError) Key() bool { return e.key }
+func (e GetSchedulerEventRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSchedulerEventRequestValidationError) ErrorName() string {
-	return "UpdateSchedulerEventRequestValidationError"
+func (e GetSchedulerEventRequestValidationError) ErrorName() string {
+	return "GetSchedulerEventRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateSchedulerEventRequestValidationError) Error() string {
+func (e GetSchedulerEventRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -761,14 +1132,14 @@ func (e UpdateSchedulerEventRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSchedulerEventRequest.%s: %s%s",
+		"invalid %sGetSchedulerEventRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSchedulerEventRequestValidationError{}
+var _ error = GetSchedulerEventRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -776,48 +1147,214 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSchedulerEventRequestValidationError{}
+} = GetSchedulerEventRequestValidationError{}
 
-// Validate checks the field values on UpdateSchedulerEventResponse with the
-// rules defined in the proto definition for this message. If any rules are
+var _GetSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on GetSchedulerEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSchedulerEventResponse) Validate() error {
+func (m *GetSchedulerEventResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSchedulerEventResponse with the
+// ValidateAll checks the field values on GetSchedulerEventResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateSchedulerEventResponseMultiError, or nil if none found.
-func (m *UpdateSchedulerEventResponse) ValidateAll() error {
+// GetSchedulerEventResponseMultiError, or nil if none found.
+func (m *GetSchedulerEventResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSchedulerEventResponse) validate(all bool) error {
+func (m *GetSchedulerEventResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSchedulerEventResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSchedulerEventResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSchedulerEventResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSchedulerEventResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSchedulerEventResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// GetSchedulerEventResponseValidationError is the validation error returned by
+// GetSchedulerEventResponse.Validate if the designated constraints aren't met.
+type GetSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchedulerEventResponseValidationError) ErrorName() string {
+	return "GetSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on ListSchedulerEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchedulerEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchedulerEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchedulerEventsRequestMultiError, or nil if none found.
+func (m *ListSchedulerEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchedulerEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Domain
+
+	// no validation rules for Queue
 
 	// no validation rules for Status
 
+	// no validation rules for Method
+
+	// no validation rules for CreatedFrom
+
+	// no validation rules for CreatedTo
+
+	// no validation rules for UpdatedFrom
+
+	// no validation rules for UpdatedTo
+
+	// no validation rules for Query
+
+	// no validation rules for OrderBy
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListSchedulerEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.IsActive != nil {
+		// no validation rules for IsActive
+	}
+
 	if len(errors) > 0 {
-		return UpdateSchedulerEventResponseMultiError(errors)
+		return ListSchedulerEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSchedulerEventResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateSchedulerEventResponse.ValidateAll() if
+// ListSchedulerEventsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListSchedulerEventsRequest.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateSchedulerEventResponseMultiError []error
+type ListSchedulerEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSchedulerEventResponseMultiError) Error() string {
+func (m ListSchedulerEventsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -826,12 +1363,11 @@ func (m UpdateSchedulerEventResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSchedulerEventResponseMultiError) AllErrors() []error { return m }
+func (m ListSchedulerEventsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateSchedulerEventResponseValidationError is the validation error returned
-// by UpdateSchedulerEventResponse.Validate if the designated constraints
-// aren't met.
-type UpdateSchedulerEventResponseValidationError struct {
+// ListSchedulerEventsRequestValidationError is the validation error returned
+// by ListSchedulerEventsRequest.Validate if the designated constraints aren't met.
+type ListSchedulerEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -839,24 +1375,24 @@ type UpdateSchedulerEventResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSchedulerEventResponseValidationError) Field() string { return e.field }
+func (e ListSchedulerEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSchedulerEventResponseValidationError) Reason() string { return e.reason }
+func (e ListSchedulerEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSchedulerEventResponseValidationError) Cause() error { return e.cause }
+func (e ListSchedulerEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSchedulerEventResponseValidationError) Key() bool { return e.key }
+func (e ListSchedulerEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSchedulerEventResponseValidationError) ErrorName() string {
-	return "UpdateSchedulerEventResponseValidationError"
+func (e ListSchedulerEventsRequestValidationError) ErrorName() string {
+	return "ListSchedulerEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateSchedulerEventResponseValidationError) Error() string {
+func (e ListSchedulerEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -868,14 +1404,14 @@ func (e UpdateSchedulerEventResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSchedulerEventResponse.%s: %s%s",
+		"invalid %sListSchedulerEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSchedulerEventResponseValidationError{}
+var _ error = ListSchedulerEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -883,48 +1419,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSchedulerEventResponseValidationError{}
+} = ListSchedulerEventsRequestValidationError{}
 
-// Validate checks the field values on UpdateEventStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListSchedulerEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateEventStatusRequest) Validate() error {
+func (m *ListSchedulerEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateEventStatusRequest with the
+// ValidateAll checks the field values on ListSchedulerEventsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateEventStatusRequestMultiError, or nil if none found.
-func (m *UpdateEventStatusRequest) ValidateAll() error {
+// ListSchedulerEventsResponseMultiError, or nil if none found.
+func (m *ListSchedulerEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateEventStatusRequest) validate(all bool) error {
+func (m *ListSchedulerEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
 
-	// no validation rules for Status
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSchedulerEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSchedulerEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSchedulerEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return UpdateEventStatusRequestMultiError(errors)
+		return ListSchedulerEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateEventStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateEventStatusRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateEventStatusRequestMultiError []error
+// ListSchedulerEventsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSchedulerEventsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListSchedulerEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateEventStatusRequestMultiError) Error() string {
+func (m ListSchedulerEventsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -933,11 +1501,12 @@ func (m UpdateEventStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateEventStatusRequestMultiError) AllErrors() []error { return m }
+func (m ListSchedulerEventsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateEventStatusRequestValidationError is the validation error returned by
-// UpdateEventStatusRequest.Validate if the designated constraints aren't met.
-type UpdateEventStatusRequestValidationError struct {
+// ListSchedulerEventsResponseValidationError is the validation error returned
+// by ListSchedulerEventsResponse.Validate if the designated constraints
+// aren't met.
+type ListSchedulerEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -945,24 +1514,24 @@ type UpdateEventStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateEventStatusRequestValidationError) Field() string { return e.field }
+func (e ListSchedulerEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateEventStatusRequestValidationError) Reason() string { return e.reason }
+func (e ListSchedulerEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateEventStatusRequestValidationError) Cause() error { return e.cause }
+func (e ListSchedulerEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateEventStatusRequestValidationError) Key() bool { return e.key }
+func (e ListSchedulerEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateEventStatusRequestValidationError) ErrorName() string {
-	return "UpdateEventStatusRequestValidationError"
+func (e ListSchedulerEventsResponseValidationError) ErrorName() string {
+	return "ListSchedulerEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateEventStatusRequestValidationError) Error() string {
+func (e ListSchedulerEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -974,14 +1543,14 @@ func (e UpdateEventStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateEventStatusRequest.%s: %s%s",
+		"invalid %sListSchedulerEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateEventStatusRequestValidationError{}
+var _ error = ListSchedulerEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -989,46 +1558,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateEventStatusRequestValidationError{}
+} = ListSchedulerEventsResponseValidationError{}
 
-// Validate checks the field values on UpdateEventStatusResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BulkSchedulerEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateEventStatusResponse) Validate() error {
+func (m *BulkSchedulerEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateEventStatusResponse with the
+// ValidateAll checks the field values on BulkSchedulerEventsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateEventStatusResponseMultiError, or nil if none found.
-func (m *UpdateEventStatusResponse) ValidateAll() error {
+// BulkSchedulerEventsRequestMultiError, or nil if none found.
+func (m *BulkSchedulerEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateEventStatusResponse) validate(all bool) error {
+func (m *BulkSchedulerEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		// skipping validation for events
+
+	}
+
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return UpdateEventStatusResponseMultiError(errors)
+		return BulkSchedulerEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateEventStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateEventStatusResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateEventStatusResponseMultiError []error
+// BulkSchedulerEventsRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkSchedulerEventsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BulkSchedulerEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateEventStatusResponseMultiError) Error() string {
+func (m BulkSchedulerEventsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1037,11 +1613,11 @@ func (m UpdateEventStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateEventStatusResponseMultiError) AllErrors() []error { return m }
+func (m BulkSchedulerEventsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateEventStatusResponseValidationError is the validation error returned by
-// UpdateEventStatusResponse.Validate if the designated constraints aren't met.
-type UpdateEventStatusResponseValidationError struct {
+// BulkSchedulerEventsRequestValidationError is the validation error returned
+// by BulkSchedulerEventsRequest.Validate if the designated constraints aren't met.
+type BulkSchedulerEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1049,24 +1625,24 @@ type UpdateEventStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateEventStatusResponseValidationError) Field() string { return e.field }
+func (e BulkSchedulerEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateEventStatusResponseValidationError) Reason() string { return e.reason }
+func (e BulkSchedulerEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateEventStatusResponseValidationError) Cause() error { return e.cause }
+func (e BulkSchedulerEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateEventStatusResponseValidationError) Key() bool { return e.key }
+func (e BulkSchedulerEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateEventStatusResponseValidationError) ErrorName() string {
-	return "UpdateEventStatusResponseValidationError"
+func (e BulkSchedulerEventsRequestValidationError) ErrorName() string {
+	return "BulkSchedulerEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateEventStatusResponseValidationError) Error() string {
+func (e BulkSchedulerEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1078,14 +1654,14 @@ func (e UpdateEventStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateEventStatusResponse.%s: %s%s",
+		"invalid %sBulkSchedulerEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateEventStatusResponseValidationError{}
+var _ error = BulkSchedulerEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1093,4 +1669,3236 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateEventStatusResponseValidationError{}
+} = BulkSchedulerEventsRequestValidationError{}
+
+// Validate checks the field values on BulkEventResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BulkEventResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkEventResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkEventResultMultiError, or nil if none found.
+func (m *BulkEventResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkEventResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return BulkEventResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkEventResultMultiError is an error wrapping multiple validation errors
+// returned by BulkEventResult.ValidateAll() if the designated constraints
+// aren't met.
+type BulkEventResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkEventResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkEventResultMultiError) AllErrors() []error { return m }
+
+// BulkEventResultValidationError is the validation error returned by
+// BulkEventResult.Validate if the designated constraints aren't met.
+type BulkEventResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkEventResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkEventResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkEventResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkEventResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkEventResultValidationError) ErrorName() string { return "BulkEventResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BulkEventResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkEventResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkEventResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkEventResultValidationError{}
+
+// Validate checks the field values on BulkSchedulerEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkSchedulerEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkSchedulerEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkSchedulerEventsResponseMultiError, or nil if none found.
+func (m *BulkSchedulerEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkSchedulerEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkSchedulerEventsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkSchedulerEventsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkSchedulerEventsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return BulkSchedulerEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkSchedulerEventsResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkSchedulerEventsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BulkSchedulerEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkSchedulerEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkSchedulerEventsResponseMultiError) AllErrors() []error { return m }
+
+// BulkSchedulerEventsResponseValidationError is the validation error returned
+// by BulkSchedulerEventsResponse.Validate if the designated constraints
+// aren't met.
+type BulkSchedulerEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkSchedulerEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkSchedulerEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkSchedulerEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkSchedulerEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkSchedulerEventsResponseValidationError) ErrorName() string {
+	return "BulkSchedulerEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkSchedulerEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkSchedulerEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkSchedulerEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkSchedulerEventsResponseValidationError{}
+
+// Validate checks the field values on ExportSchedulerEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportSchedulerEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportSchedulerEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportSchedulerEventsRequestMultiError, or nil if none found.
+func (m *ExportSchedulerEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportSchedulerEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return ExportSchedulerEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportSchedulerEventsRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportSchedulerEventsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExportSchedulerEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportSchedulerEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportSchedulerEventsRequestMultiError) AllErrors() []error { return m }
+
+// ExportSchedulerEventsRequestValidationError is the validation error returned
+// by ExportSchedulerEventsRequest.Validate if the designated constraints
+// aren't met.
+type ExportSchedulerEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportSchedulerEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportSchedulerEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportSchedulerEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportSchedulerEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportSchedulerEventsRequestValidationError) ErrorName() string {
+	return "ExportSchedulerEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportSchedulerEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportSchedulerEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportSchedulerEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportSchedulerEventsRequestValidationError{}
+
+// Validate checks the field values on UpdateSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSchedulerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSchedulerEventRequestMultiError, or nil if none found.
+func (m *UpdateSchedulerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSchedulerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_UpdateSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := UpdateSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEvent() == nil {
+		err := UpdateSchedulerEventRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSchedulerEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSchedulerEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSchedulerEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() < 0 {
+		err := UpdateSchedulerEventRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateSchedulerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSchedulerEventRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateSchedulerEventRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSchedulerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSchedulerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSchedulerEventRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSchedulerEventRequestValidationError is the validation error returned
+// by UpdateSchedulerEventRequest.Validate if the designated constraints
+// aren't met.
+type UpdateSchedulerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSchedulerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSchedulerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSchedulerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSchedulerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSchedulerEventRequestValidationError) ErrorName() string {
+	return "UpdateSchedulerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSchedulerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSchedulerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSchedulerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSchedulerEventRequestValidationError{}
+
+var _UpdateSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on UpdateSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSchedulerEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSchedulerEventResponseMultiError, or nil if none found.
+func (m *UpdateSchedulerEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSchedulerEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return UpdateSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSchedulerEventResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateSchedulerEventResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSchedulerEventResponseValidationError is the validation error returned
+// by UpdateSchedulerEventResponse.Validate if the designated constraints
+// aren't met.
+type UpdateSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSchedulerEventResponseValidationError) ErrorName() string {
+	return "UpdateSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on PatchSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchSchedulerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchSchedulerEventRequestMultiError, or nil if none found.
+func (m *PatchSchedulerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchSchedulerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PatchSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PatchSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEvent() == nil {
+		err := PatchSchedulerEventRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchSchedulerEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchSchedulerEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchSchedulerEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchSchedulerEventRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchSchedulerEventRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchSchedulerEventRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() < 0 {
+		err := PatchSchedulerEventRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PatchSchedulerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchSchedulerEventRequestMultiError is an error wrapping multiple
+// validation errors returned by PatchSchedulerEventRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PatchSchedulerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchSchedulerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchSchedulerEventRequestMultiError) AllErrors() []error { return m }
+
+// PatchSchedulerEventRequestValidationError is the validation error returned
+// by PatchSchedulerEventRequest.Validate if the designated constraints aren't met.
+type PatchSchedulerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchSchedulerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchSchedulerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchSchedulerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchSchedulerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchSchedulerEventRequestValidationError) ErrorName() string {
+	return "PatchSchedulerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchSchedulerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchSchedulerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchSchedulerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchSchedulerEventRequestValidationError{}
+
+var _PatchSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on PatchSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchSchedulerEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchSchedulerEventResponseMultiError, or nil if none found.
+func (m *PatchSchedulerEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchSchedulerEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchSchedulerEventResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchSchedulerEventResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchSchedulerEventResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PatchSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchSchedulerEventResponseMultiError is an error wrapping multiple
+// validation errors returned by PatchSchedulerEventResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PatchSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// PatchSchedulerEventResponseValidationError is the validation error returned
+// by PatchSchedulerEventResponse.Validate if the designated constraints
+// aren't met.
+type PatchSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchSchedulerEventResponseValidationError) ErrorName() string {
+	return "PatchSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on UpdateEventStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateEventStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateEventStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateEventStatusRequestMultiError, or nil if none found.
+func (m *UpdateEventStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateEventStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for RunId
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return UpdateEventStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateEventStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateEventStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateEventStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateEventStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateEventStatusRequestMultiError) AllErrors() []error { return m }
+
+// UpdateEventStatusRequestValidationError is the validation error returned by
+// UpdateEventStatusRequest.Validate if the designated constraints aren't met.
+type UpdateEventStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateEventStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateEventStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateEventStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateEventStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateEventStatusRequestValidationError) ErrorName() string {
+	return "UpdateEventStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateEventStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateEventStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateEventStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateEventStatusRequestValidationError{}
+
+// Validate checks the field values on UpdateEventStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateEventStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateEventStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateEventStatusResponseMultiError, or nil if none found.
+func (m *UpdateEventStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateEventStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return UpdateEventStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateEventStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateEventStatusResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateEventStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateEventStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateEventStatusResponseMultiError) AllErrors() []error { return m }
+
+// UpdateEventStatusResponseValidationError is the validation error returned by
+// UpdateEventStatusResponse.Validate if the designated constraints aren't met.
+type UpdateEventStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateEventStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateEventStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateEventStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateEventStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateEventStatusResponseValidationError) ErrorName() string {
+	return "UpdateEventStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateEventStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateEventStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateEventStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateEventStatusResponseValidationError{}
+
+// Validate checks the field values on DeleteSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSchedulerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSchedulerEventRequestMultiError, or nil if none found.
+func (m *DeleteSchedulerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSchedulerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DeleteSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := DeleteSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSchedulerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSchedulerEventRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteSchedulerEventRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteSchedulerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSchedulerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSchedulerEventRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSchedulerEventRequestValidationError is the validation error returned
+// by DeleteSchedulerEventRequest.Validate if the designated constraints
+// aren't met.
+type DeleteSchedulerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSchedulerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSchedulerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSchedulerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSchedulerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSchedulerEventRequestValidationError) ErrorName() string {
+	return "DeleteSchedulerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSchedulerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSchedulerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSchedulerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSchedulerEventRequestValidationError{}
+
+var _DeleteSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on DeleteSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSchedulerEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSchedulerEventResponseMultiError, or nil if none found.
+func (m *DeleteSchedulerEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSchedulerEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSchedulerEventResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteSchedulerEventResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSchedulerEventResponseValidationError is the validation error returned
+// by DeleteSchedulerEventResponse.Validate if the designated constraints
+// aren't met.
+type DeleteSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSchedulerEventResponseValidationError) ErrorName() string {
+	return "DeleteSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on RestoreSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSchedulerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreSchedulerEventRequestMultiError, or nil if none found.
+func (m *RestoreSchedulerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSchedulerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RestoreSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := RestoreSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreSchedulerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreSchedulerEventRequestMultiError is an error wrapping multiple
+// validation errors returned by RestoreSchedulerEventRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreSchedulerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSchedulerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSchedulerEventRequestMultiError) AllErrors() []error { return m }
+
+// RestoreSchedulerEventRequestValidationError is the validation error returned
+// by RestoreSchedulerEventRequest.Validate if the designated constraints
+// aren't met.
+type RestoreSchedulerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSchedulerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSchedulerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSchedulerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSchedulerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSchedulerEventRequestValidationError) ErrorName() string {
+	return "RestoreSchedulerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSchedulerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSchedulerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSchedulerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSchedulerEventRequestValidationError{}
+
+var _RestoreSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on RestoreSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSchedulerEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSchedulerEventResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RestoreSchedulerEventResponseMultiError, or nil if none found.
+func (m *RestoreSchedulerEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSchedulerEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RestoreSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreSchedulerEventResponseMultiError is an error wrapping multiple
+// validation errors returned by RestoreSchedulerEventResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RestoreSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// RestoreSchedulerEventResponseValidationError is the validation error
+// returned by RestoreSchedulerEventResponse.Validate if the designated
+// constraints aren't met.
+type RestoreSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSchedulerEventResponseValidationError) ErrorName() string {
+	return "RestoreSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on PurgeSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeSchedulerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeSchedulerEventRequestMultiError, or nil if none found.
+func (m *PurgeSchedulerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeSchedulerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PurgeSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PurgeSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurgeSchedulerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeSchedulerEventRequestMultiError is an error wrapping multiple
+// validation errors returned by PurgeSchedulerEventRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PurgeSchedulerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeSchedulerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeSchedulerEventRequestMultiError) AllErrors() []error { return m }
+
+// PurgeSchedulerEventRequestValidationError is the validation error returned
+// by PurgeSchedulerEventRequest.Validate if the designated constraints aren't met.
+type PurgeSchedulerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeSchedulerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeSchedulerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeSchedulerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeSchedulerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeSchedulerEventRequestValidationError) ErrorName() string {
+	return "PurgeSchedulerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeSchedulerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeSchedulerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeSchedulerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeSchedulerEventRequestValidationError{}
+
+var _PurgeSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on PurgeSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeSchedulerEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeSchedulerEventResponseMultiError, or nil if none found.
+func (m *PurgeSchedulerEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeSchedulerEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return PurgeSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeSchedulerEventResponseMultiError is an error wrapping multiple
+// validation errors returned by PurgeSchedulerEventResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PurgeSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// PurgeSchedulerEventResponseValidationError is the validation error returned
+// by PurgeSchedulerEventResponse.Validate if the designated constraints
+// aren't met.
+type PurgeSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeSchedulerEventResponseValidationError) ErrorName() string {
+	return "PurgeSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on RunNowRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RunNowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunNowRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RunNowRequestMultiError, or
+// nil if none found.
+func (m *RunNowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunNowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RunNowRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := RunNowRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RunNowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunNowRequestMultiError is an error wrapping multiple validation errors
+// returned by RunNowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RunNowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunNowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunNowRequestMultiError) AllErrors() []error { return m }
+
+// RunNowRequestValidationError is the validation error returned by
+// RunNowRequest.Validate if the designated constraints aren't met.
+type RunNowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunNowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunNowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunNowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunNowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunNowRequestValidationError) ErrorName() string { return "RunNowRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunNowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunNowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunNowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunNowRequestValidationError{}
+
+var _RunNowRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on RunNowResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RunNowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunNowResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RunNowResponseMultiError,
+// or nil if none found.
+func (m *RunNowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunNowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RunId
+
+	if len(errors) > 0 {
+		return RunNowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunNowResponseMultiError is an error wrapping multiple validation errors
+// returned by RunNowResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RunNowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunNowResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunNowResponseMultiError) AllErrors() []error { return m }
+
+// RunNowResponseValidationError is the validation error returned by
+// RunNowResponse.Validate if the designated constraints aren't met.
+type RunNowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunNowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunNowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunNowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunNowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunNowResponseValidationError) ErrorName() string { return "RunNowResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunNowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunNowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunNowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunNowResponseValidationError{}
+
+// Validate checks the field values on PauseSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseSchedulerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseSchedulerEventRequestMultiError, or nil if none found.
+func (m *PauseSchedulerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseSchedulerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PauseSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PauseSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PauseSchedulerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseSchedulerEventRequestMultiError is an error wrapping multiple
+// validation errors returned by PauseSchedulerEventRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PauseSchedulerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseSchedulerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseSchedulerEventRequestMultiError) AllErrors() []error { return m }
+
+// PauseSchedulerEventRequestValidationError is the validation error returned
+// by PauseSchedulerEventRequest.Validate if the designated constraints aren't met.
+type PauseSchedulerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseSchedulerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseSchedulerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseSchedulerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseSchedulerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseSchedulerEventRequestValidationError) ErrorName() string {
+	return "PauseSchedulerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseSchedulerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseSchedulerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseSchedulerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseSchedulerEventRequestValidationError{}
+
+var _PauseSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on PauseSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseSchedulerEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseSchedulerEventResponseMultiError, or nil if none found.
+func (m *PauseSchedulerEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseSchedulerEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return PauseSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseSchedulerEventResponseMultiError is an error wrapping multiple
+// validation errors returned by PauseSchedulerEventResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PauseSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// PauseSchedulerEventResponseValidationError is the validation error returned
+// by PauseSchedulerEventResponse.Validate if the designated constraints
+// aren't met.
+type PauseSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseSchedulerEventResponseValidationError) ErrorName() string {
+	return "PauseSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on ResumeSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeSchedulerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeSchedulerEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeSchedulerEventRequestMultiError, or nil if none found.
+func (m *ResumeSchedulerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeSchedulerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ResumeSchedulerEventRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := ResumeSchedulerEventRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeSchedulerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeSchedulerEventRequestMultiError is an error wrapping multiple
+// validation errors returned by ResumeSchedulerEventRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ResumeSchedulerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeSchedulerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeSchedulerEventRequestMultiError) AllErrors() []error { return m }
+
+// ResumeSchedulerEventRequestValidationError is the validation error returned
+// by ResumeSchedulerEventRequest.Validate if the designated constraints
+// aren't met.
+type ResumeSchedulerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeSchedulerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeSchedulerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeSchedulerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeSchedulerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeSchedulerEventRequestValidationError) ErrorName() string {
+	return "ResumeSchedulerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeSchedulerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeSchedulerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeSchedulerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeSchedulerEventRequestValidationError{}
+
+var _ResumeSchedulerEventRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on ResumeSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeSchedulerEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeSchedulerEventResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeSchedulerEventResponseMultiError, or nil if none found.
+func (m *ResumeSchedulerEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeSchedulerEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return ResumeSchedulerEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeSchedulerEventResponseMultiError is an error wrapping multiple
+// validation errors returned by ResumeSchedulerEventResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ResumeSchedulerEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeSchedulerEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeSchedulerEventResponseMultiError) AllErrors() []error { return m }
+
+// ResumeSchedulerEventResponseValidationError is the validation error returned
+// by ResumeSchedulerEventResponse.Validate if the designated constraints
+// aren't met.
+type ResumeSchedulerEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeSchedulerEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeSchedulerEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeSchedulerEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeSchedulerEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeSchedulerEventResponseValidationError) ErrorName() string {
+	return "ResumeSchedulerEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeSchedulerEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeSchedulerEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeSchedulerEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeSchedulerEventResponseValidationError{}
+
+// Validate checks the field values on SkipNextRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkipNextRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkipNextRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkipNextRequestMultiError, or nil if none found.
+func (m *SkipNextRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkipNextRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_SkipNextRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := SkipNextRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SkipNextRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkipNextRequestMultiError is an error wrapping multiple validation errors
+// returned by SkipNextRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SkipNextRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkipNextRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkipNextRequestMultiError) AllErrors() []error { return m }
+
+// SkipNextRequestValidationError is the validation error returned by
+// SkipNextRequest.Validate if the designated constraints aren't met.
+type SkipNextRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkipNextRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkipNextRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkipNextRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkipNextRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkipNextRequestValidationError) ErrorName() string { return "SkipNextRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkipNextRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkipNextRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkipNextRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkipNextRequestValidationError{}
+
+var _SkipNextRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on SkipNextResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkipNextResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkipNextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkipNextResponseMultiError, or nil if none found.
+func (m *SkipNextResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkipNextResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SkippedAt
+
+	// no validation rules for SchedulerAt
+
+	if len(errors) > 0 {
+		return SkipNextResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkipNextResponseMultiError is an error wrapping multiple validation errors
+// returned by SkipNextResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SkipNextResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkipNextResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkipNextResponseMultiError) AllErrors() []error { return m }
+
+// SkipNextResponseValidationError is the validation error returned by
+// SkipNextResponse.Validate if the designated constraints aren't met.
+type SkipNextResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkipNextResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkipNextResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkipNextResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkipNextResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkipNextResponseValidationError) ErrorName() string { return "SkipNextResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkipNextResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkipNextResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkipNextResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkipNextResponseValidationError{}
+
+// Validate checks the field values on BackfillRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BackfillRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackfillRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackfillRequestMultiError, or nil if none found.
+func (m *BackfillRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackfillRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_BackfillRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := BackfillRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFrom() <= 0 {
+		err := BackfillRequestValidationError{
+			field:  "From",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTo() <= 0 {
+		err := BackfillRequestValidationError{
+			field:  "To",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BackfillRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackfillRequestMultiError is an error wrapping multiple validation errors
+// returned by BackfillRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BackfillRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackfillRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackfillRequestMultiError) AllErrors() []error { return m }
+
+// BackfillRequestValidationError is the validation error returned by
+// BackfillRequest.Validate if the designated constraints aren't met.
+type BackfillRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackfillRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackfillRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackfillRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackfillRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackfillRequestValidationError) ErrorName() string { return "BackfillRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BackfillRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackfillRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackfillRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackfillRequestValidationError{}
+
+var _BackfillRequest_Id_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on BackfillResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BackfillResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackfillResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackfillResponseMultiError, or nil if none found.
+func (m *BackfillResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackfillResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Skipped
+
+	if len(errors) > 0 {
+		return BackfillResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackfillResponseMultiError is an error wrapping multiple validation errors
+// returned by BackfillResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BackfillResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackfillResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackfillResponseMultiError) AllErrors() []error { return m }
+
+// BackfillResponseValidationError is the validation error returned by
+// BackfillResponse.Validate if the designated constraints aren't met.
+type BackfillResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackfillResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackfillResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackfillResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackfillResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackfillResponseValidationError) ErrorName() string { return "BackfillResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BackfillResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackfillResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackfillResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackfillResponseValidationError{}
+
+// Validate checks the field values on PreviewCrawlRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewCrawlRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewCrawlRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewCrawlRequestMultiError, or nil if none found.
+func (m *PreviewCrawlRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewCrawlRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEvent() == nil {
+		err := PreviewCrawlRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewCrawlRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewCrawlRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewCrawlRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreviewCrawlRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewCrawlRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewCrawlRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewCrawlRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewCrawlRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewCrawlRequestMultiError) AllErrors() []error { return m }
+
+// PreviewCrawlRequestValidationError is the validation error returned by
+// PreviewCrawlRequest.Validate if the designated constraints aren't met.
+type PreviewCrawlRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewCrawlRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewCrawlRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewCrawlRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewCrawlRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewCrawlRequestValidationError) ErrorName() string {
+	return "PreviewCrawlRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewCrawlRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewCrawlRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewCrawlRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewCrawlRequestValidationError{}
+
+// Validate checks the field values on PreviewCrawlResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewCrawlResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewCrawlResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewCrawlResponseMultiError, or nil if none found.
+func (m *PreviewCrawlResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewCrawlResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewCrawlResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewCrawlResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewCrawlResponseValidationError{
+				field:  "Preview",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreviewCrawlResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewCrawlResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewCrawlResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewCrawlResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewCrawlResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewCrawlResponseMultiError) AllErrors() []error { return m }
+
+// PreviewCrawlResponseValidationError is the validation error returned by
+// PreviewCrawlResponse.Validate if the designated constraints aren't met.
+type PreviewCrawlResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewCrawlResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewCrawlResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewCrawlResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewCrawlResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewCrawlResponseValidationError) ErrorName() string {
+	return "PreviewCrawlResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewCrawlResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewCrawlResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewCrawlResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewCrawlResponseValidationError{}
@@ -8,6 +8,7 @@ package schedulerv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,10 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SchedulerEventService_CreateSchedulerEvent_FullMethodName = "/scheduler.v1.SchedulerEventService/CreateSchedulerEvent"
-	SchedulerEventService_GetSchedulerEvents_FullMethodName   = "/scheduler.v1.SchedulerEventService/GetSchedulerEvents"
-	SchedulerEventService_UpdateSchedulerEvent_FullMethodName = "/scheduler.v1.SchedulerEventService/UpdateSchedulerEvent"
-	SchedulerEventService_UpdateEventStatus_FullMethodName    = "/scheduler.v1.SchedulerEventService/UpdateEventStatus"
+	SchedulerEventService_CreateSchedulerEvent_FullMethodName      = "/scheduler.v1.SchedulerEventService/CreateSchedulerEvent"
+	SchedulerEventService_GetSchedulerEvents_FullMethodName        = "/scheduler.v1.SchedulerEventService/GetSchedulerEvents"
+	SchedulerEventService_GetSchedulerEvent_FullMethodName         = "/scheduler.v1.SchedulerEventService/GetSchedulerEvent"
+	SchedulerEventService_ListSchedulerEvents_FullMethodName       = "/scheduler.v1.SchedulerEventService/ListSchedulerEvents"
+	SchedulerEventService_BulkCreateSchedulerEvents_FullMethodName = "/scheduler.v1.SchedulerEventService/BulkCreateSchedulerEvents"
+	SchedulerEventService_BulkUpsertSchedulerEvents_FullMethodName = "/scheduler.v1.SchedulerEventService/BulkUpsertSchedulerEvents"
+	SchedulerEventService_ExportSchedulerEvents_FullMethodName     = "/scheduler.v1.SchedulerEventService/ExportSchedulerEvents"
+	SchedulerEventService_UpdateSchedulerEvent_FullMethodName      = "/scheduler.v1.SchedulerEventService/UpdateSchedulerEvent"
+	SchedulerEventService_PatchSchedulerEvent_FullMethodName       = "/scheduler.v1.SchedulerEventService/PatchSchedulerEvent"
+	SchedulerEventService_UpdateEventStatus_FullMethodName         = "/scheduler.v1.SchedulerEventService/UpdateEventStatus"
+	SchedulerEventService_DeleteSchedulerEvent_FullMethodName      = "/scheduler.v1.SchedulerEventService/DeleteSchedulerEvent"
+	SchedulerEventService_RestoreSchedulerEvent_FullMethodName     = "/scheduler.v1.SchedulerEventService/RestoreSchedulerEvent"
+	SchedulerEventService_PurgeSchedulerEvent_FullMethodName       = "/scheduler.v1.SchedulerEventService/PurgeSchedulerEvent"
+	SchedulerEventService_RunNow_FullMethodName                    = "/scheduler.v1.SchedulerEventService/RunNow"
+	SchedulerEventService_PauseSchedulerEvent_FullMethodName       = "/scheduler.v1.SchedulerEventService/PauseSchedulerEvent"
+	SchedulerEventService_ResumeSchedulerEvent_FullMethodName      = "/scheduler.v1.SchedulerEventService/ResumeSchedulerEvent"
+	SchedulerEventService_SkipNext_FullMethodName                  = "/scheduler.v1.SchedulerEventService/SkipNext"
+	SchedulerEventService_Backfill_FullMethodName                  = "/scheduler.v1.SchedulerEventService/Backfill"
+	SchedulerEventService_PreviewCrawl_FullMethodName              = "/scheduler.v1.SchedulerEventService/PreviewCrawl"
 )
 
 // SchedulerEventServiceClient is the client API for SchedulerEventService service.
@@ -31,8 +47,25 @@ const (
 type SchedulerEventServiceClient interface {
 	CreateSchedulerEvent(ctx context.Context, in *CreateSchedulerEventRequest, opts ...grpc.CallOption) (*CreateSchedulerEventResponse, error)
 	GetSchedulerEvents(ctx context.Context, in *GetSchedulerEventsRequest, opts ...grpc.CallOption) (*GetSchedulerEventsResponse, error)
+	GetSchedulerEvent(ctx context.Context, in *GetSchedulerEventRequest, opts ...grpc.CallOption) (*GetSchedulerEventResponse, error)
+	ListSchedulerEvents(ctx context.Context, in *ListSchedulerEventsRequest, opts ...grpc.CallOption) (*ListSchedulerEventsResponse, error)
+	BulkCreateSchedulerEvents(ctx context.Context, in *BulkSchedulerEventsRequest, opts ...grpc.CallOption) (*BulkSchedulerEventsResponse, error)
+	BulkUpsertSchedulerEvents(ctx context.Context, in *BulkSchedulerEventsRequest, opts ...grpc.CallOption) (*BulkSchedulerEventsResponse, error)
+	ExportSchedulerEvents(ctx context.Context, in *ExportSchedulerEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
 	UpdateSchedulerEvent(ctx context.Context, in *UpdateSchedulerEventRequest, opts ...grpc.CallOption) (*UpdateSchedulerEventResponse, error)
+	PatchSchedulerEvent(ctx context.Context, in *PatchSchedulerEventRequest, opts ...grpc.CallOption) (*PatchSchedulerEventResponse, error)
 	UpdateEventStatus(ctx context.Context, in *UpdateEventStatusRequest, opts ...grpc.CallOption) (*UpdateEventStatusResponse, error)
+	DeleteSchedulerEvent(ctx context.Context, in *DeleteSchedulerEventRequest, opts ...grpc.CallOption) (*DeleteSchedulerEventResponse, error)
+	RestoreSchedulerEvent(ctx context.Context, in *RestoreSchedulerEventRequest, opts ...grpc.CallOption) (*RestoreSchedulerEventResponse, error)
+	// PurgeSchedulerEvent removes the event permanently, it needs the admin token
+	PurgeSchedulerEvent(ctx context.Context, in *PurgeSchedulerEventRequest, opts ...grpc.CallOption) (*PurgeSchedulerEventResponse, error)
+	RunNow(ctx context.Context, in *RunNowRequest, opts ...grpc.CallOption) (*RunNowResponse, error)
+	PauseSchedulerEvent(ctx context.Context, in *PauseSchedulerEventRequest, opts ...grpc.CallOption) (*PauseSchedulerEventResponse, error)
+	ResumeSchedulerEvent(ctx context.Context, in *ResumeSchedulerEventRequest, opts ...grpc.CallOption) (*ResumeSchedulerEventResponse, error)
+	SkipNext(ctx context.Context, in *SkipNextRequest, opts ...grpc.CallOption) (*SkipNextResponse, error)
+	Backfill(ctx context.Context, in *BackfillRequest, opts ...grpc.CallOption) (*BackfillResponse, error)
+	// PreviewCrawl runs one crawl of the event on a crawler worker without saving the event
+	PreviewCrawl(ctx context.Context, in *PreviewCrawlRequest, opts ...grpc.CallOption) (*PreviewCrawlResponse, error)
 }
 
 type schedulerEventServiceClient struct {
@@ -63,6 +96,65 @@ func (c *schedulerEventServiceClient) GetSchedulerEvents(ctx context.Context, in
 	return out, nil
 }
 
+func (c *schedulerEventServiceClient) GetSchedulerEvent(ctx context.Context, in *GetSchedulerEventRequest, opts ...grpc.CallOption) (*GetSchedulerEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSchedulerEventResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_GetSchedulerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) ListSchedulerEvents(ctx context.Context, in *ListSchedulerEventsRequest, opts ...grpc.CallOption) (*ListSchedulerEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulerEventsResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_ListSchedulerEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) BulkCreateSchedulerEvents(ctx context.Context, in *BulkSchedulerEventsRequest, opts ...grpc.CallOption) (*BulkSchedulerEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkSchedulerEventsResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_BulkCreateSchedulerEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) BulkUpsertSchedulerEvents(ctx context.Context, in *BulkSchedulerEventsRequest, opts ...grpc.CallOption) (*BulkSchedulerEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkSchedulerEventsResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_BulkUpsertSchedulerEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) ExportSchedulerEvents(ctx context.Context, in *ExportSchedulerEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SchedulerEventService_ServiceDesc.Streams[0], SchedulerEventService_ExportSchedulerEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSchedulerEventsRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SchedulerEventService_ExportSchedulerEventsClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
 func (c *schedulerEventServiceClient) UpdateSchedulerEvent(ctx context.Context, in *UpdateSchedulerEventRequest, opts ...grpc.CallOption) (*UpdateSchedulerEventResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateSchedulerEventResponse)
@@ -73,6 +165,16 @@ func (c *schedulerEventServiceClient) UpdateSchedulerEvent(ctx context.Context,
 	return out, nil
 }
 
+func (c *schedulerEventServiceClient) PatchSchedulerEvent(ctx context.Context, in *PatchSchedulerEventRequest, opts ...grpc.CallOption) (*PatchSchedulerEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchSchedulerEventResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_PatchSchedulerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerEventServiceClient) UpdateEventStatus(ctx context.Context, in *UpdateEventStatusRequest, opts ...grpc.CallOption) (*UpdateEventStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateEventStatusResponse)
@@ -83,14 +185,121 @@ func (c *schedulerEventServiceClient) UpdateEventStatus(ctx context.Context, in
 	return out, nil
 }
 
+func (c *schedulerEventServiceClient) DeleteSchedulerEvent(ctx context.Context, in *DeleteSchedulerEventRequest, opts ...grpc.CallOption) (*DeleteSchedulerEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSchedulerEventResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_DeleteSchedulerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) RestoreSchedulerEvent(ctx context.Context, in *RestoreSchedulerEventRequest, opts ...grpc.CallOption) (*RestoreSchedulerEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSchedulerEventResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_RestoreSchedulerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) PurgeSchedulerEvent(ctx context.Context, in *PurgeSchedulerEventRequest, opts ...grpc.CallOption) (*PurgeSchedulerEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeSchedulerEventResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_PurgeSchedulerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) RunNow(ctx context.Context, in *RunNowRequest, opts ...grpc.CallOption) (*RunNowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunNowResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_RunNow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) PauseSchedulerEvent(ctx context.Context, in *PauseSchedulerEventRequest, opts ...grpc.CallOption) (*PauseSchedulerEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseSchedulerEventResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_PauseSchedulerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) ResumeSchedulerEvent(ctx context.Context, in *ResumeSchedulerEventRequest, opts ...grpc.CallOption) (*ResumeSchedulerEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeSchedulerEventResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_ResumeSchedulerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) SkipNext(ctx context.Context, in *SkipNextRequest, opts ...grpc.CallOption) (*SkipNextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipNextResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_SkipNext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) Backfill(ctx context.Context, in *BackfillRequest, opts ...grpc.CallOption) (*BackfillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackfillResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_Backfill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerEventServiceClient) PreviewCrawl(ctx context.Context, in *PreviewCrawlRequest, opts ...grpc.CallOption) (*PreviewCrawlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCrawlResponse)
+	err := c.cc.Invoke(ctx, SchedulerEventService_PreviewCrawl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchedulerEventServiceServer is the server API for SchedulerEventService service.
 // All implementations must embed UnimplementedSchedulerEventServiceServer
 // for forward compatibility.
 type SchedulerEventServiceServer interface {
 	CreateSchedulerEvent(context.Context, *CreateSchedulerEventRequest) (*CreateSchedulerEventResponse, error)
 	GetSchedulerEvents(context.Context, *GetSchedulerEventsRequest) (*GetSchedulerEventsResponse, error)
+	GetSchedulerEvent(context.Context, *GetSchedulerEventRequest) (*GetSchedulerEventResponse, error)
+	ListSchedulerEvents(context.Context, *ListSchedulerEventsRequest) (*ListSchedulerEventsResponse, error)
+	BulkCreateSchedulerEvents(context.Context, *BulkSchedulerEventsRequest) (*BulkSchedulerEventsResponse, error)
+	BulkUpsertSchedulerEvents(context.Context, *BulkSchedulerEventsRequest) (*BulkSchedulerEventsResponse, error)
+	ExportSchedulerEvents(*ExportSchedulerEventsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
 	UpdateSchedulerEvent(context.Context, *UpdateSchedulerEventRequest) (*UpdateSchedulerEventResponse, error)
+	PatchSchedulerEvent(context.Context, *PatchSchedulerEventRequest) (*PatchSchedulerEventResponse, error)
 	UpdateEventStatus(context.Context, *UpdateEventStatusRequest) (*UpdateEventStatusResponse, error)
+	DeleteSchedulerEvent(context.Context, *DeleteSchedulerEventRequest) (*DeleteSchedulerEventResponse, error)
+	RestoreSchedulerEvent(context.Context, *RestoreSchedulerEventRequest) (*RestoreSchedulerEventResponse, error)
+	// PurgeSchedulerEvent removes the event permanently, it needs the admin token
+	PurgeSchedulerEvent(context.Context, *PurgeSchedulerEventRequest) (*PurgeSchedulerEventResponse, error)
+	RunNow(context.Context, *RunNowRequest) (*RunNowResponse, error)
+	PauseSchedulerEvent(context.Context, *PauseSchedulerEventRequest) (*PauseSchedulerEventResponse, error)
+	ResumeSchedulerEvent(context.Context, *ResumeSchedulerEventRequest) (*ResumeSchedulerEventResponse, error)
+	SkipNext(context.Context, *SkipNextRequest) (*SkipNextResponse, error)
+	Backfill(context.Context, *BackfillRequest) (*BackfillResponse, error)
+	// PreviewCrawl runs one crawl of the event on a crawler worker without saving the event
+	PreviewCrawl(context.Context, *PreviewCrawlRequest) (*PreviewCrawlResponse, error)
 	mustEmbedUnimplementedSchedulerEventServiceServer()
 }
 
@@ -107,12 +316,57 @@ func (UnimplementedSchedulerEventServiceServer) CreateSchedulerEvent(context.Con
 func (UnimplementedSchedulerEventServiceServer) GetSchedulerEvents(context.Context, *GetSchedulerEventsRequest) (*GetSchedulerEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSchedulerEvents not implemented")
 }
+func (UnimplementedSchedulerEventServiceServer) GetSchedulerEvent(context.Context, *GetSchedulerEventRequest) (*GetSchedulerEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSchedulerEvent not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) ListSchedulerEvents(context.Context, *ListSchedulerEventsRequest) (*ListSchedulerEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSchedulerEvents not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) BulkCreateSchedulerEvents(context.Context, *BulkSchedulerEventsRequest) (*BulkSchedulerEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkCreateSchedulerEvents not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) BulkUpsertSchedulerEvents(context.Context, *BulkSchedulerEventsRequest) (*BulkSchedulerEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkUpsertSchedulerEvents not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) ExportSchedulerEvents(*ExportSchedulerEventsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Error(codes.Unimplemented, "method ExportSchedulerEvents not implemented")
+}
 func (UnimplementedSchedulerEventServiceServer) UpdateSchedulerEvent(context.Context, *UpdateSchedulerEventRequest) (*UpdateSchedulerEventResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateSchedulerEvent not implemented")
 }
+func (UnimplementedSchedulerEventServiceServer) PatchSchedulerEvent(context.Context, *PatchSchedulerEventRequest) (*PatchSchedulerEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchSchedulerEvent not implemented")
+}
 func (UnimplementedSchedulerEventServiceServer) UpdateEventStatus(context.Context, *UpdateEventStatusRequest) (*UpdateEventStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateEventStatus not implemented")
 }
+func (UnimplementedSchedulerEventServiceServer) DeleteSchedulerEvent(context.Context, *DeleteSchedulerEventRequest) (*DeleteSchedulerEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSchedulerEvent not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) RestoreSchedulerEvent(context.Context, *RestoreSchedulerEventRequest) (*RestoreSchedulerEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreSchedulerEvent not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) PurgeSchedulerEvent(context.Context, *PurgeSchedulerEventRequest) (*PurgeSchedulerEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeSchedulerEvent not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) RunNow(context.Context, *RunNowRequest) (*RunNowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunNow not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) PauseSchedulerEvent(context.Context, *PauseSchedulerEventRequest) (*PauseSchedulerEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseSchedulerEvent not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) ResumeSchedulerEvent(context.Context, *ResumeSchedulerEventRequest) (*ResumeSchedulerEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeSchedulerEvent not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) SkipNext(context.Context, *SkipNextRequest) (*SkipNextResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipNext not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) Backfill(context.Context, *BackfillRequest) (*BackfillResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Backfill not implemented")
+}
+func (UnimplementedSchedulerEventServiceServer) PreviewCrawl(context.Context, *PreviewCrawlRequest) (*PreviewCrawlResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewCrawl not implemented")
+}
 func (UnimplementedSchedulerEventServiceServer) mustEmbedUnimplementedSchedulerEventServiceServer() {}
 func (UnimplementedSchedulerEventServiceServer) testEmbeddedByValue()                               {}
 
@@ -170,6 +424,89 @@ func _SchedulerEventService_GetSchedulerEvents_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulerEventService_GetSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).GetSchedulerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_GetSchedulerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).GetSchedulerEvent(ctx, req.(*GetSchedulerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_ListSchedulerEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulerEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).ListSchedulerEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_ListSchedulerEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).ListSchedulerEvents(ctx, req.(*ListSchedulerEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_BulkCreateSchedulerEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkSchedulerEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).BulkCreateSchedulerEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_BulkCreateSchedulerEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).BulkCreateSchedulerEvents(ctx, req.(*BulkSchedulerEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_BulkUpsertSchedulerEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkSchedulerEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).BulkUpsertSchedulerEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_BulkUpsertSchedulerEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).BulkUpsertSchedulerEvents(ctx, req.(*BulkSchedulerEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_ExportSchedulerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSchedulerEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchedulerEventServiceServer).ExportSchedulerEvents(m, &grpc.GenericServerStream[ExportSchedulerEventsRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SchedulerEventService_ExportSchedulerEventsServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
 func _SchedulerEventService_UpdateSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSchedulerEventRequest)
 	if err := dec(in); err != nil {
@@ -188,6 +525,24 @@ func _SchedulerEventService_UpdateSchedulerEvent_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulerEventService_PatchSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchSchedulerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).PatchSchedulerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_PatchSchedulerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).PatchSchedulerEvent(ctx, req.(*PatchSchedulerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SchedulerEventService_UpdateEventStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateEventStatusRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +561,168 @@ func _SchedulerEventService_UpdateEventStatus_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulerEventService_DeleteSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSchedulerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).DeleteSchedulerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_DeleteSchedulerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).DeleteSchedulerEvent(ctx, req.(*DeleteSchedulerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_RestoreSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSchedulerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).RestoreSchedulerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_RestoreSchedulerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).RestoreSchedulerEvent(ctx, req.(*RestoreSchedulerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_PurgeSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeSchedulerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).PurgeSchedulerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_PurgeSchedulerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).PurgeSchedulerEvent(ctx, req.(*PurgeSchedulerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_RunNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).RunNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_RunNow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).RunNow(ctx, req.(*RunNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_PauseSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSchedulerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).PauseSchedulerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_PauseSchedulerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).PauseSchedulerEvent(ctx, req.(*PauseSchedulerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_ResumeSchedulerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSchedulerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).ResumeSchedulerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_ResumeSchedulerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).ResumeSchedulerEvent(ctx, req.(*ResumeSchedulerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_SkipNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipNextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).SkipNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_SkipNext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).SkipNext(ctx, req.(*SkipNextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_Backfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).Backfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_Backfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).Backfill(ctx, req.(*BackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerEventService_PreviewCrawl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCrawlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerEventServiceServer).PreviewCrawl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerEventService_PreviewCrawl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerEventServiceServer).PreviewCrawl(ctx, req.(*PreviewCrawlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SchedulerEventService_ServiceDesc is the grpc.ServiceDesc for SchedulerEventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,15 +738,77 @@ var SchedulerEventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchedulerEvents",
 			Handler:    _SchedulerEventService_GetSchedulerEvents_Handler,
 		},
+		{
+			MethodName: "GetSchedulerEvent",
+			Handler:    _SchedulerEventService_GetSchedulerEvent_Handler,
+		},
+		{
+			MethodName: "ListSchedulerEvents",
+			Handler:    _SchedulerEventService_ListSchedulerEvents_Handler,
+		},
+		{
+			MethodName: "BulkCreateSchedulerEvents",
+			Handler:    _SchedulerEventService_BulkCreateSchedulerEvents_Handler,
+		},
+		{
+			MethodName: "BulkUpsertSchedulerEvents",
+			Handler:    _SchedulerEventService_BulkUpsertSchedulerEvents_Handler,
+		},
 		{
 			MethodName: "UpdateSchedulerEvent",
 			Handler:    _SchedulerEventService_UpdateSchedulerEvent_Handler,
 		},
+		{
+			MethodName: "PatchSchedulerEvent",
+			Handler:    _SchedulerEventService_PatchSchedulerEvent_Handler,
+		},
 		{
 			MethodName: "UpdateEventStatus",
 			Handler:    _SchedulerEventService_UpdateEventStatus_Handler,
 		},
+		{
+			MethodName: "DeleteSchedulerEvent",
+			Handler:    _SchedulerEventService_DeleteSchedulerEvent_Handler,
+		},
+		{
+			MethodName: "RestoreSchedulerEvent",
+			Handler:    _SchedulerEventService_RestoreSchedulerEvent_Handler,
+		},
+		{
+			MethodName: "PurgeSchedulerEvent",
+			Handler:    _SchedulerEventService_PurgeSchedulerEvent_Handler,
+		},
+		{
+			MethodName: "RunNow",
+			Handler:    _SchedulerEventService_RunNow_Handler,
+		},
+		{
+			MethodName: "PauseSchedulerEvent",
+			Handler:    _SchedulerEventService_PauseSchedulerEvent_Handler,
+		},
+		{
+			MethodName: "ResumeSchedulerEvent",
+			Handler:    _SchedulerEventService_ResumeSchedulerEvent_Handler,
+		},
+		{
+			MethodName: "SkipNext",
+			Handler:    _SchedulerEventService_SkipNext_Handler,
+		},
+		{
+			MethodName: "Backfill",
+			Handler:    _SchedulerEventService_Backfill_Handler,
+		},
+		{
+			MethodName: "PreviewCrawl",
+			Handler:    _SchedulerEventService_PreviewCrawl_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSchedulerEvents",
+			Handler:       _SchedulerEventService_ExportSchedulerEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/scheduler_event.proto",
 }
@@ -0,0 +1,494 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pkg/proto/scheduler_internal.proto
+
+package schedulerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportRunResultRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	RunId   string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// successed or failed
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	DurationMs int64  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Error      string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// id of the stored crawl result, empty when nothing is stored
+	ResultId      string `protobuf:"bytes,6,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRunResultRequest) Reset() {
+	*x = ReportRunResultRequest{}
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRunResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRunResultRequest) ProtoMessage() {}
+
+func (x *ReportRunResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRunResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportRunResultRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_internal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportRunResultRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ReportRunResultRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ReportRunResultRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReportRunResultRequest) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ReportRunResultRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ReportRunResultRequest) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+type ReportRunResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRunResultResponse) Reset() {
+	*x = ReportRunResultResponse{}
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRunResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRunResultResponse) ProtoMessage() {}
+
+func (x *ReportRunResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRunResultResponse.ProtoReflect.Descriptor instead.
+func (*ReportRunResultResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_internal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportRunResultResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type EventExistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventExistsRequest) Reset() {
+	*x = EventExistsRequest{}
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventExistsRequest) ProtoMessage() {}
+
+func (x *EventExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventExistsRequest.ProtoReflect.Descriptor instead.
+func (*EventExistsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_internal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventExistsRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type EventExistsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// false when the event is deleted, paused events exist
+	Exists        bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventExistsResponse) Reset() {
+	*x = EventExistsResponse{}
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventExistsResponse) ProtoMessage() {}
+
+func (x *EventExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventExistsResponse.ProtoReflect.Descriptor instead.
+func (*EventExistsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_internal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventExistsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+// CrawlerQueue is a queue consumed by the workers
+type CrawlerQueue struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Topic          string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Weight         int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	RateLimit      int32                  `protobuf:"varint,4,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	MaxConcurrency int32                  `protobuf:"varint,5,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CrawlerQueue) Reset() {
+	*x = CrawlerQueue{}
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlerQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlerQueue) ProtoMessage() {}
+
+func (x *CrawlerQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlerQueue.ProtoReflect.Descriptor instead.
+func (*CrawlerQueue) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_internal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CrawlerQueue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CrawlerQueue) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *CrawlerQueue) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CrawlerQueue) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *CrawlerQueue) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+type GetQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueuesRequest) Reset() {
+	*x = GetQueuesRequest{}
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuesRequest) ProtoMessage() {}
+
+func (x *GetQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuesRequest.ProtoReflect.Descriptor instead.
+func (*GetQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_internal_proto_rawDescGZIP(), []int{5}
+}
+
+type GetQueuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*CrawlerQueue        `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueuesResponse) Reset() {
+	*x = GetQueuesResponse{}
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuesResponse) ProtoMessage() {}
+
+func (x *GetQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_scheduler_internal_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuesResponse.ProtoReflect.Descriptor instead.
+func (*GetQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_scheduler_internal_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetQueuesResponse) GetQueues() []*CrawlerQueue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+var File_pkg_proto_scheduler_internal_proto protoreflect.FileDescriptor
+
+const file_pkg_proto_scheduler_internal_proto_rawDesc = "" +
+	"\n" +
+	"\"pkg/proto/scheduler_internal.proto\x12\fscheduler.v1\"\xb6\x01\n" +
+	"\x16ReportRunResultRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1b\n" +
+	"\tresult_id\x18\x06 \x01(\tR\bresultId\"1\n" +
+	"\x17ReportRunResultResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"/\n" +
+	"\x12EventExistsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\"-\n" +
+	"\x13EventExistsResponse\x12\x16\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\"\x98\x01\n" +
+	"\fCrawlerQueue\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x04 \x01(\x05R\trateLimit\x12'\n" +
+	"\x0fmax_concurrency\x18\x05 \x01(\x05R\x0emaxConcurrency\"\x12\n" +
+	"\x10GetQueuesRequest\"G\n" +
+	"\x11GetQueuesResponse\x122\n" +
+	"\x06queues\x18\x01 \x03(\v2\x1a.scheduler.v1.CrawlerQueueR\x06queues2\x9c\x02\n" +
+	"\x18SchedulerInternalService\x12^\n" +
+	"\x0fReportRunResult\x12$.scheduler.v1.ReportRunResultRequest\x1a%.scheduler.v1.ReportRunResultResponse\x12R\n" +
+	"\vEventExists\x12 .scheduler.v1.EventExistsRequest\x1a!.scheduler.v1.EventExistsResponse\x12L\n" +
+	"\tGetQueues\x12\x1e.scheduler.v1.GetQueuesRequest\x1a\x1f.scheduler.v1.GetQueuesResponseB\xa2\x01\n" +
+	"\x10com.scheduler.v1B\x16SchedulerInternalProtoP\x01Z%crawler-service/pkg/proto;schedulerv1\xa2\x02\x03SXX\xaa\x02\fScheduler.V1\xca\x02\fScheduler\\V1\xe2\x02\x18Scheduler\\V1\\GPBMetadata\xea\x02\rScheduler::V1b\x06proto3"
+
+var (
+	file_pkg_proto_scheduler_internal_proto_rawDescOnce sync.Once
+	file_pkg_proto_scheduler_internal_proto_rawDescData []byte
+)
+
+func file_pkg_proto_scheduler_internal_proto_rawDescGZIP() []byte {
+	file_pkg_proto_scheduler_internal_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_scheduler_internal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_scheduler_internal_proto_rawDesc), len(file_pkg_proto_scheduler_internal_proto_rawDesc)))
+	})
+	return file_pkg_proto_scheduler_internal_proto_rawDescData
+}
+
+var file_pkg_proto_scheduler_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pkg_proto_scheduler_internal_proto_goTypes = []any{
+	(*ReportRunResultRequest)(nil),  // 0: scheduler.v1.ReportRunResultRequest
+	(*ReportRunResultResponse)(nil), // 1: scheduler.v1.ReportRunResultResponse
+	(*EventExistsRequest)(nil),      // 2: scheduler.v1.EventExistsRequest
+	(*EventExistsResponse)(nil),     // 3: scheduler.v1.EventExistsResponse
+	(*CrawlerQueue)(nil),            // 4: scheduler.v1.CrawlerQueue
+	(*GetQueuesRequest)(nil),        // 5: scheduler.v1.GetQueuesRequest
+	(*GetQueuesResponse)(nil),       // 6: scheduler.v1.GetQueuesResponse
+}
+var file_pkg_proto_scheduler_internal_proto_depIdxs = []int32{
+	4, // 0: scheduler.v1.GetQueuesResponse.queues:type_name -> scheduler.v1.CrawlerQueue
+	0, // 1: scheduler.v1.SchedulerInternalService.ReportRunResult:input_type -> scheduler.v1.ReportRunResultRequest
+	2, // 2: scheduler.v1.SchedulerInternalService.EventExists:input_type -> scheduler.v1.EventExistsRequest
+	5, // 3: scheduler.v1.SchedulerInternalService.GetQueues:input_type -> scheduler.v1.GetQueuesRequest
+	1, // 4: scheduler.v1.SchedulerInternalService.ReportRunResult:output_type -> scheduler.v1.ReportRunResultResponse
+	3, // 5: scheduler.v1.SchedulerInternalService.EventExists:output_type -> scheduler.v1.EventExistsResponse
+	6, // 6: scheduler.v1.SchedulerInternalService.GetQueues:output_type -> scheduler.v1.GetQueuesResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_scheduler_internal_proto_init() }
+func file_pkg_proto_scheduler_internal_proto_init() {
+	if File_pkg_proto_scheduler_internal_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_scheduler_internal_proto_rawDesc), len(file_pkg_proto_scheduler_internal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_scheduler_internal_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_scheduler_internal_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_scheduler_internal_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_scheduler_internal_proto = out.File
+	file_pkg_proto_scheduler_internal_proto_goTypes = nil
+	file_pkg_proto_scheduler_internal_proto_depIdxs = nil
+}
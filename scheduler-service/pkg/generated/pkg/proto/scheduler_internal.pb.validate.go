@@ -0,0 +1,807 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/proto/scheduler_internal.proto
+
+package schedulerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReportRunResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportRunResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportRunResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportRunResultRequestMultiError, or nil if none found.
+func (m *ReportRunResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportRunResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for RunId
+
+	// no validation rules for Status
+
+	// no validation rules for DurationMs
+
+	// no validation rules for Error
+
+	// no validation rules for ResultId
+
+	if len(errors) > 0 {
+		return ReportRunResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportRunResultRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportRunResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportRunResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportRunResultRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportRunResultRequestMultiError) AllErrors() []error { return m }
+
+// ReportRunResultRequestValidationError is the validation error returned by
+// ReportRunResultRequest.Validate if the designated constraints aren't met.
+type ReportRunResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportRunResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportRunResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportRunResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportRunResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportRunResultRequestValidationError) ErrorName() string {
+	return "ReportRunResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportRunResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportRunResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportRunResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportRunResultRequestValidationError{}
+
+// Validate checks the field values on ReportRunResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportRunResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportRunResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportRunResultResponseMultiError, or nil if none found.
+func (m *ReportRunResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportRunResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return ReportRunResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportRunResultResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportRunResultResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReportRunResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportRunResultResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportRunResultResponseMultiError) AllErrors() []error { return m }
+
+// ReportRunResultResponseValidationError is the validation error returned by
+// ReportRunResultResponse.Validate if the designated constraints aren't met.
+type ReportRunResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportRunResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportRunResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportRunResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportRunResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportRunResultResponseValidationError) ErrorName() string {
+	return "ReportRunResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportRunResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportRunResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportRunResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportRunResultResponseValidationError{}
+
+// Validate checks the field values on EventExistsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EventExistsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventExistsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventExistsRequestMultiError, or nil if none found.
+func (m *EventExistsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventExistsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	if len(errors) > 0 {
+		return EventExistsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventExistsRequestMultiError is an error wrapping multiple validation errors
+// returned by EventExistsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EventExistsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventExistsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventExistsRequestMultiError) AllErrors() []error { return m }
+
+// EventExistsRequestValidationError is the validation error returned by
+// EventExistsRequest.Validate if the designated constraints aren't met.
+type EventExistsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventExistsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventExistsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventExistsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventExistsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventExistsRequestValidationError) ErrorName() string {
+	return "EventExistsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventExistsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventExistsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventExistsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventExistsRequestValidationError{}
+
+// Validate checks the field values on EventExistsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EventExistsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventExistsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventExistsResponseMultiError, or nil if none found.
+func (m *EventExistsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventExistsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Exists
+
+	if len(errors) > 0 {
+		return EventExistsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventExistsResponseMultiError is an error wrapping multiple validation
+// errors returned by EventExistsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EventExistsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventExistsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventExistsResponseMultiError) AllErrors() []error { return m }
+
+// EventExistsResponseValidationError is the validation error returned by
+// EventExistsResponse.Validate if the designated constraints aren't met.
+type EventExistsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventExistsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventExistsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventExistsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventExistsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventExistsResponseValidationError) ErrorName() string {
+	return "EventExistsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventExistsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventExistsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventExistsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventExistsResponseValidationError{}
+
+// Validate checks the field values on CrawlerQueue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CrawlerQueue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CrawlerQueue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CrawlerQueueMultiError, or
+// nil if none found.
+func (m *CrawlerQueue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CrawlerQueue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Topic
+
+	// no validation rules for Weight
+
+	// no validation rules for RateLimit
+
+	// no validation rules for MaxConcurrency
+
+	if len(errors) > 0 {
+		return CrawlerQueueMultiError(errors)
+	}
+
+	return nil
+}
+
+// CrawlerQueueMultiError is an error wrapping multiple validation errors
+// returned by CrawlerQueue.ValidateAll() if the designated constraints aren't met.
+type CrawlerQueueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CrawlerQueueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CrawlerQueueMultiError) AllErrors() []error { return m }
+
+// CrawlerQueueValidationError is the validation error returned by
+// CrawlerQueue.Validate if the designated constraints aren't met.
+type CrawlerQueueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CrawlerQueueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CrawlerQueueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CrawlerQueueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CrawlerQueueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CrawlerQueueValidationError) ErrorName() string { return "CrawlerQueueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CrawlerQueueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCrawlerQueue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CrawlerQueueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CrawlerQueueValidationError{}
+
+// Validate checks the field values on GetQueuesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQueuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueuesRequestMultiError, or nil if none found.
+func (m *GetQueuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetQueuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueuesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQueuesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQueuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueuesRequestMultiError) AllErrors() []error { return m }
+
+// GetQueuesRequestValidationError is the validation error returned by
+// GetQueuesRequest.Validate if the designated constraints aren't met.
+type GetQueuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueuesRequestValidationError) ErrorName() string { return "GetQueuesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQueuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueuesRequestValidationError{}
+
+// Validate checks the field values on GetQueuesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQueuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueuesResponseMultiError, or nil if none found.
+func (m *GetQueuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQueues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetQueuesResponseValidationError{
+						field:  fmt.Sprintf("Queues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetQueuesResponseValidationError{
+						field:  fmt.Sprintf("Queues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetQueuesResponseValidationError{
+					field:  fmt.Sprintf("Queues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetQueuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueuesResponseMultiError is an error wrapping multiple validation errors
+// returned by GetQueuesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetQueuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueuesResponseMultiError) AllErrors() []error { return m }
+
+// GetQueuesResponseValidationError is the validation error returned by
+// GetQueuesResponse.Validate if the designated constraints aren't met.
+type GetQueuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueuesResponseValidationError) ErrorName() string {
+	return "GetQueuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQueuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueuesResponseValidationError{}
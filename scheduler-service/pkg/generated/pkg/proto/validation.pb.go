@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pkg/proto/validation.proto
+
+package schedulerv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidationRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// grows on every saved change, it is returned as ETag as well
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// rules file as YAML, PUT accepts JSON as well
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationRules) Reset() {
+	*x = ValidationRules{}
+	mi := &file_pkg_proto_validation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationRules) ProtoMessage() {}
+
+func (x *ValidationRules) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_validation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationRules.ProtoReflect.Descriptor instead.
+func (*ValidationRules) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_validation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidationRules) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ValidationRules) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GetValidationRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidationRulesRequest) Reset() {
+	*x = GetValidationRulesRequest{}
+	mi := &file_pkg_proto_validation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidationRulesRequest) ProtoMessage() {}
+
+func (x *GetValidationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_validation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidationRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetValidationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_validation_proto_rawDescGZIP(), []int{1}
+}
+
+type GetValidationRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         *ValidationRules       `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidationRulesResponse) Reset() {
+	*x = GetValidationRulesResponse{}
+	mi := &file_pkg_proto_validation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidationRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidationRulesResponse) ProtoMessage() {}
+
+func (x *GetValidationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_validation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidationRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetValidationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_validation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetValidationRulesResponse) GetRules() *ValidationRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type PutValidationRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// content of the new rules, their version is set by the scheduler
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// version the change is based on, If-Match is used when it is 0, 0 without If-Match skips the check
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutValidationRulesRequest) Reset() {
+	*x = PutValidationRulesRequest{}
+	mi := &file_pkg_proto_validation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutValidationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutValidationRulesRequest) ProtoMessage() {}
+
+func (x *PutValidationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_validation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutValidationRulesRequest.ProtoReflect.Descriptor instead.
+func (*PutValidationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_validation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PutValidationRulesRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PutValidationRulesRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PutValidationRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         *ValidationRules       `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutValidationRulesResponse) Reset() {
+	*x = PutValidationRulesResponse{}
+	mi := &file_pkg_proto_validation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutValidationRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutValidationRulesResponse) ProtoMessage() {}
+
+func (x *PutValidationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_validation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutValidationRulesResponse.ProtoReflect.Descriptor instead.
+func (*PutValidationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_validation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PutValidationRulesResponse) GetRules() *ValidationRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ReloadValidationRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadValidationRulesRequest) Reset() {
+	*x = ReloadValidationRulesRequest{}
+	mi := &file_pkg_proto_validation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadValidationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadValidationRulesRequest) ProtoMessage() {}
+
+func (x *ReloadValidationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_validation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadValidationRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReloadValidationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_validation_proto_rawDescGZIP(), []int{5}
+}
+
+type ReloadValidationRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         *ValidationRules       `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadValidationRulesResponse) Reset() {
+	*x = ReloadValidationRulesResponse{}
+	mi := &file_pkg_proto_validation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadValidationRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadValidationRulesResponse) ProtoMessage() {}
+
+func (x *ReloadValidationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_validation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadValidationRulesResponse.ProtoReflect.Descriptor instead.
+func (*ReloadValidationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_validation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReloadValidationRulesResponse) GetRules() *ValidationRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+var File_pkg_proto_validation_proto protoreflect.FileDescriptor
+
+const file_pkg_proto_validation_proto_rawDesc = "" +
+	"\n" +
+	"\x1apkg/proto/validation.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\"E\n" +
+	"\x0fValidationRules\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\x1b\n" +
+	"\x19GetValidationRulesRequest\"Q\n" +
+	"\x1aGetValidationRulesResponse\x123\n" +
+	"\x05rules\x18\x01 \x01(\v2\x1d.scheduler.v1.ValidationRulesR\x05rules\"O\n" +
+	"\x19PutValidationRulesRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"Q\n" +
+	"\x1aPutValidationRulesResponse\x123\n" +
+	"\x05rules\x18\x01 \x01(\v2\x1d.scheduler.v1.ValidationRulesR\x05rules\"\x1e\n" +
+	"\x1cReloadValidationRulesRequest\"T\n" +
+	"\x1dReloadValidationRulesResponse\x123\n" +
+	"\x05rules\x18\x01 \x01(\v2\x1d.scheduler.v1.ValidationRulesR\x05rules2\xcd\x03\n" +
+	"\x11ValidationService\x12\x89\x01\n" +
+	"\x12GetValidationRules\x12'.scheduler.v1.GetValidationRulesRequest\x1a(.scheduler.v1.GetValidationRulesResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/validation/rules\x12\x8c\x01\n" +
+	"\x12PutValidationRules\x12'.scheduler.v1.PutValidationRulesRequest\x1a(.scheduler.v1.PutValidationRulesResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/api/v1/validation/rules\x12\x9c\x01\n" +
+	"\x15ReloadValidationRules\x12*.scheduler.v1.ReloadValidationRulesRequest\x1a+.scheduler.v1.ReloadValidationRulesResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/validation/rules:reloadB\x9b\x01\n" +
+	"\x10com.scheduler.v1B\x0fValidationProtoP\x01Z%crawler-service/pkg/proto;schedulerv1\xa2\x02\x03SXX\xaa\x02\fScheduler.V1\xca\x02\fScheduler\\V1\xe2\x02\x18Scheduler\\V1\\GPBMetadata\xea\x02\rScheduler::V1b\x06proto3"
+
+var (
+	file_pkg_proto_validation_proto_rawDescOnce sync.Once
+	file_pkg_proto_validation_proto_rawDescData []byte
+)
+
+func file_pkg_proto_validation_proto_rawDescGZIP() []byte {
+	file_pkg_proto_validation_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_validation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_validation_proto_rawDesc), len(file_pkg_proto_validation_proto_rawDesc)))
+	})
+	return file_pkg_proto_validation_proto_rawDescData
+}
+
+var file_pkg_proto_validation_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pkg_proto_validation_proto_goTypes = []any{
+	(*ValidationRules)(nil),               // 0: scheduler.v1.ValidationRules
+	(*GetValidationRulesRequest)(nil),     // 1: scheduler.v1.GetValidationRulesRequest
+	(*GetValidationRulesResponse)(nil),    // 2: scheduler.v1.GetValidationRulesResponse
+	(*PutValidationRulesRequest)(nil),     // 3: scheduler.v1.PutValidationRulesRequest
+	(*PutValidationRulesResponse)(nil),    // 4: scheduler.v1.PutValidationRulesResponse
+	(*ReloadValidationRulesRequest)(nil),  // 5: scheduler.v1.ReloadValidationRulesRequest
+	(*ReloadValidationRulesResponse)(nil), // 6: scheduler.v1.ReloadValidationRulesResponse
+}
+var file_pkg_proto_validation_proto_depIdxs = []int32{
+	0, // 0: scheduler.v1.GetValidationRulesResponse.rules:type_name -> scheduler.v1.ValidationRules
+	0, // 1: scheduler.v1.PutValidationRulesResponse.rules:type_name -> scheduler.v1.ValidationRules
+	0, // 2: scheduler.v1.ReloadValidationRulesResponse.rules:type_name -> scheduler.v1.ValidationRules
+	1, // 3: scheduler.v1.ValidationService.GetValidationRules:input_type -> scheduler.v1.GetValidationRulesRequest
+	3, // 4: scheduler.v1.ValidationService.PutValidationRules:input_type -> scheduler.v1.PutValidationRulesRequest
+	5, // 5: scheduler.v1.ValidationService.ReloadValidationRules:input_type -> scheduler.v1.ReloadValidationRulesRequest
+	2, // 6: scheduler.v1.ValidationService.GetValidationRules:output_type -> scheduler.v1.GetValidationRulesResponse
+	4, // 7: scheduler.v1.ValidationService.PutValidationRules:output_type -> scheduler.v1.PutValidationRulesResponse
+	6, // 8: scheduler.v1.ValidationService.ReloadValidationRules:output_type -> scheduler.v1.ReloadValidationRulesResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_validation_proto_init() }
+func file_pkg_proto_validation_proto_init() {
+	if File_pkg_proto_validation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_validation_proto_rawDesc), len(file_pkg_proto_validation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_validation_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_validation_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_validation_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_validation_proto = out.File
+	file_pkg_proto_validation_proto_goTypes = nil
+	file_pkg_proto_validation_proto_depIdxs = nil
+}
@@ -0,0 +1,845 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/proto/validation.proto
+
+package schedulerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ValidationRules with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ValidationRules) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidationRules with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidationRulesMultiError, or nil if none found.
+func (m *ValidationRules) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidationRules) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ValidationRulesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidationRulesMultiError is an error wrapping multiple validation errors
+// returned by ValidationRules.ValidateAll() if the designated constraints
+// aren't met.
+type ValidationRulesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidationRulesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidationRulesMultiError) AllErrors() []error { return m }
+
+// ValidationRulesValidationError is the validation error returned by
+// ValidationRules.Validate if the designated constraints aren't met.
+type ValidationRulesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidationRulesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidationRulesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidationRulesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidationRulesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidationRulesValidationError) ErrorName() string { return "ValidationRulesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ValidationRulesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidationRules.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidationRulesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidationRulesValidationError{}
+
+// Validate checks the field values on GetValidationRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetValidationRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetValidationRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetValidationRulesRequestMultiError, or nil if none found.
+func (m *GetValidationRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetValidationRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetValidationRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetValidationRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetValidationRulesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetValidationRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetValidationRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetValidationRulesRequestMultiError) AllErrors() []error { return m }
+
+// GetValidationRulesRequestValidationError is the validation error returned by
+// GetValidationRulesRequest.Validate if the designated constraints aren't met.
+type GetValidationRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetValidationRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetValidationRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetValidationRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetValidationRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetValidationRulesRequestValidationError) ErrorName() string {
+	return "GetValidationRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetValidationRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetValidationRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetValidationRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetValidationRulesRequestValidationError{}
+
+// Validate checks the field values on GetValidationRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetValidationRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetValidationRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetValidationRulesResponseMultiError, or nil if none found.
+func (m *GetValidationRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetValidationRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRules()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetValidationRulesResponseValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetValidationRulesResponseValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetValidationRulesResponseValidationError{
+				field:  "Rules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetValidationRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetValidationRulesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetValidationRulesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetValidationRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetValidationRulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetValidationRulesResponseMultiError) AllErrors() []error { return m }
+
+// GetValidationRulesResponseValidationError is the validation error returned
+// by GetValidationRulesResponse.Validate if the designated constraints aren't met.
+type GetValidationRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetValidationRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetValidationRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetValidationRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetValidationRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetValidationRulesResponseValidationError) ErrorName() string {
+	return "GetValidationRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetValidationRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetValidationRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetValidationRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetValidationRulesResponseValidationError{}
+
+// Validate checks the field values on PutValidationRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutValidationRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutValidationRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutValidationRulesRequestMultiError, or nil if none found.
+func (m *PutValidationRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutValidationRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return PutValidationRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutValidationRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by PutValidationRulesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PutValidationRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutValidationRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutValidationRulesRequestMultiError) AllErrors() []error { return m }
+
+// PutValidationRulesRequestValidationError is the validation error returned by
+// PutValidationRulesRequest.Validate if the designated constraints aren't met.
+type PutValidationRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutValidationRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutValidationRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutValidationRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutValidationRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutValidationRulesRequestValidationError) ErrorName() string {
+	return "PutValidationRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutValidationRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutValidationRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutValidationRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutValidationRulesRequestValidationError{}
+
+// Validate checks the field values on PutValidationRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutValidationRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutValidationRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutValidationRulesResponseMultiError, or nil if none found.
+func (m *PutValidationRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutValidationRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRules()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PutValidationRulesResponseValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PutValidationRulesResponseValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PutValidationRulesResponseValidationError{
+				field:  "Rules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PutValidationRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutValidationRulesResponseMultiError is an error wrapping multiple
+// validation errors returned by PutValidationRulesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PutValidationRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutValidationRulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutValidationRulesResponseMultiError) AllErrors() []error { return m }
+
+// PutValidationRulesResponseValidationError is the validation error returned
+// by PutValidationRulesResponse.Validate if the designated constraints aren't met.
+type PutValidationRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutValidationRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutValidationRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutValidationRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutValidationRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutValidationRulesResponseValidationError) ErrorName() string {
+	return "PutValidationRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutValidationRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutValidationRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutValidationRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutValidationRulesResponseValidationError{}
+
+// Validate checks the field values on ReloadValidationRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadValidationRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadValidationRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadValidationRulesRequestMultiError, or nil if none found.
+func (m *ReloadValidationRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadValidationRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReloadValidationRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadValidationRulesRequestMultiError is an error wrapping multiple
+// validation errors returned by ReloadValidationRulesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReloadValidationRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadValidationRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadValidationRulesRequestMultiError) AllErrors() []error { return m }
+
+// ReloadValidationRulesRequestValidationError is the validation error returned
+// by ReloadValidationRulesRequest.Validate if the designated constraints
+// aren't met.
+type ReloadValidationRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadValidationRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadValidationRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadValidationRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadValidationRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadValidationRulesRequestValidationError) ErrorName() string {
+	return "ReloadValidationRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadValidationRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadValidationRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadValidationRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadValidationRulesRequestValidationError{}
+
+// Validate checks the field values on ReloadValidationRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadValidationRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadValidationRulesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReloadValidationRulesResponseMultiError, or nil if none found.
+func (m *ReloadValidationRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadValidationRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRules()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReloadValidationRulesResponseValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReloadValidationRulesResponseValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReloadValidationRulesResponseValidationError{
+				field:  "Rules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReloadValidationRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadValidationRulesResponseMultiError is an error wrapping multiple
+// validation errors returned by ReloadValidationRulesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ReloadValidationRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadValidationRulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadValidationRulesResponseMultiError) AllErrors() []error { return m }
+
+// ReloadValidationRulesResponseValidationError is the validation error
+// returned by ReloadValidationRulesResponse.Validate if the designated
+// constraints aren't met.
+type ReloadValidationRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadValidationRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadValidationRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadValidationRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadValidationRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadValidationRulesResponseValidationError) ErrorName() string {
+	return "ReloadValidationRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadValidationRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadValidationRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadValidationRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadValidationRulesResponseValidationError{}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: pkg/proto/validation.proto
+
+package schedulerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ValidationService_GetValidationRules_FullMethodName    = "/scheduler.v1.ValidationService/GetValidationRules"
+	ValidationService_PutValidationRules_FullMethodName    = "/scheduler.v1.ValidationService/PutValidationRules"
+	ValidationService_ReloadValidationRules_FullMethodName = "/scheduler.v1.ValidationService/ReloadValidationRules"
+)
+
+// ValidationServiceClient is the client API for ValidationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ValidationService manages the rules of the event fields without a redeploy
+type ValidationServiceClient interface {
+	GetValidationRules(ctx context.Context, in *GetValidationRulesRequest, opts ...grpc.CallOption) (*GetValidationRulesResponse, error)
+	// PutValidationRules replaces the rules file, it is rejected with Aborted when the version is stale
+	PutValidationRules(ctx context.Context, in *PutValidationRulesRequest, opts ...grpc.CallOption) (*PutValidationRulesResponse, error)
+	// ReloadValidationRules reads the rules file again, the file is also reloaded when it changes
+	ReloadValidationRules(ctx context.Context, in *ReloadValidationRulesRequest, opts ...grpc.CallOption) (*ReloadValidationRulesResponse, error)
+}
+
+type validationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidationServiceClient(cc grpc.ClientConnInterface) ValidationServiceClient {
+	return &validationServiceClient{cc}
+}
+
+func (c *validationServiceClient) GetValidationRules(ctx context.Context, in *GetValidationRulesRequest, opts ...grpc.CallOption) (*GetValidationRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidationRulesResponse)
+	err := c.cc.Invoke(ctx, ValidationService_GetValidationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validationServiceClient) PutValidationRules(ctx context.Context, in *PutValidationRulesRequest, opts ...grpc.CallOption) (*PutValidationRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutValidationRulesResponse)
+	err := c.cc.Invoke(ctx, ValidationService_PutValidationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validationServiceClient) ReloadValidationRules(ctx context.Context, in *ReloadValidationRulesRequest, opts ...grpc.CallOption) (*ReloadValidationRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadValidationRulesResponse)
+	err := c.cc.Invoke(ctx, ValidationService_ReloadValidationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidationServiceServer is the server API for ValidationService service.
+// All implementations must embed UnimplementedValidationServiceServer
+// for forward compatibility.
+//
+// ValidationService manages the rules of the event fields without a redeploy
+type ValidationServiceServer interface {
+	GetValidationRules(context.Context, *GetValidationRulesRequest) (*GetValidationRulesResponse, error)
+	// PutValidationRules replaces the rules file, it is rejected with Aborted when the version is stale
+	PutValidationRules(context.Context, *PutValidationRulesRequest) (*PutValidationRulesResponse, error)
+	// ReloadValidationRules reads the rules file again, the file is also reloaded when it changes
+	ReloadValidationRules(context.Context, *ReloadValidationRulesRequest) (*ReloadValidationRulesResponse, error)
+	mustEmbedUnimplementedValidationServiceServer()
+}
+
+// UnimplementedValidationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedValidationServiceServer struct{}
+
+func (UnimplementedValidationServiceServer) GetValidationRules(context.Context, *GetValidationRulesRequest) (*GetValidationRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetValidationRules not implemented")
+}
+func (UnimplementedValidationServiceServer) PutValidationRules(context.Context, *PutValidationRulesRequest) (*PutValidationRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutValidationRules not implemented")
+}
+func (UnimplementedValidationServiceServer) ReloadValidationRules(context.Context, *ReloadValidationRulesRequest) (*ReloadValidationRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadValidationRules not implemented")
+}
+func (UnimplementedValidationServiceServer) mustEmbedUnimplementedValidationServiceServer() {}
+func (UnimplementedValidationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeValidationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ValidationServiceServer will
+// result in compilation errors.
+type UnsafeValidationServiceServer interface {
+	mustEmbedUnimplementedValidationServiceServer()
+}
+
+func RegisterValidationServiceServer(s grpc.ServiceRegistrar, srv ValidationServiceServer) {
+	// If the following call panics, it indicates UnimplementedValidationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ValidationService_ServiceDesc, srv)
+}
+
+func _ValidationService_GetValidationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidationServiceServer).GetValidationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidationService_GetValidationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidationServiceServer).GetValidationRules(ctx, req.(*GetValidationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidationService_PutValidationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutValidationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidationServiceServer).PutValidationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidationService_PutValidationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidationServiceServer).PutValidationRules(ctx, req.(*PutValidationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidationService_ReloadValidationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadValidationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidationServiceServer).ReloadValidationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidationService_ReloadValidationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidationServiceServer).ReloadValidationRules(ctx, req.(*ReloadValidationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ValidationService_ServiceDesc is the grpc.ServiceDesc for ValidationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ValidationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.v1.ValidationService",
+	HandlerType: (*ValidationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidationRules",
+			Handler:    _ValidationService_GetValidationRules_Handler,
+		},
+		{
+			MethodName: "PutValidationRules",
+			Handler:    _ValidationService_PutValidationRules_Handler,
+		},
+		{
+			MethodName: "ReloadValidationRules",
+			Handler:    _ValidationService_ReloadValidationRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/validation.proto",
+}
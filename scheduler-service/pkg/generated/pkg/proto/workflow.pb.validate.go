@@ -0,0 +1,1714 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/proto/workflow.proto
+
+package schedulerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on WorkflowStep with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WorkflowStep) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowStep with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkflowStepMultiError, or
+// nil if none found.
+func (m *WorkflowStep) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowStep) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	if len(errors) > 0 {
+		return WorkflowStepMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowStepMultiError is an error wrapping multiple validation errors
+// returned by WorkflowStep.ValidateAll() if the designated constraints aren't met.
+type WorkflowStepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowStepMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowStepMultiError) AllErrors() []error { return m }
+
+// WorkflowStepValidationError is the validation error returned by
+// WorkflowStep.Validate if the designated constraints aren't met.
+type WorkflowStepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowStepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowStepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowStepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowStepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowStepValidationError) ErrorName() string { return "WorkflowStepValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkflowStepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowStepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowStepValidationError{}
+
+// Validate checks the field values on Workflow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Workflow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Workflow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkflowMultiError, or nil
+// if none found.
+func (m *Workflow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Workflow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WorkflowValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WorkflowValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WorkflowValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return WorkflowMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowMultiError is an error wrapping multiple validation errors returned
+// by Workflow.ValidateAll() if the designated constraints aren't met.
+type WorkflowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowMultiError) AllErrors() []error { return m }
+
+// WorkflowValidationError is the validation error returned by
+// Workflow.Validate if the designated constraints aren't met.
+type WorkflowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowValidationError) ErrorName() string { return "WorkflowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkflowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowValidationError{}
+
+// Validate checks the field values on WorkflowRunStep with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowRunStep) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowRunStep with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowRunStepMultiError, or nil if none found.
+func (m *WorkflowRunStep) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowRunStep) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for RunId
+
+	// no validation rules for Status
+
+	// no validation rules for StartedAt
+
+	// no validation rules for FinishedAt
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return WorkflowRunStepMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowRunStepMultiError is an error wrapping multiple validation errors
+// returned by WorkflowRunStep.ValidateAll() if the designated constraints
+// aren't met.
+type WorkflowRunStepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowRunStepMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowRunStepMultiError) AllErrors() []error { return m }
+
+// WorkflowRunStepValidationError is the validation error returned by
+// WorkflowRunStep.Validate if the designated constraints aren't met.
+type WorkflowRunStepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowRunStepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowRunStepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowRunStepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowRunStepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowRunStepValidationError) ErrorName() string { return "WorkflowRunStepValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkflowRunStepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowRunStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowRunStepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowRunStepValidationError{}
+
+// Validate checks the field values on WorkflowRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WorkflowRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkflowRunMultiError, or
+// nil if none found.
+func (m *WorkflowRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for WorkflowId
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WorkflowRunValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WorkflowRunValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WorkflowRunValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for FinishedAt
+
+	if len(errors) > 0 {
+		return WorkflowRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowRunMultiError is an error wrapping multiple validation errors
+// returned by WorkflowRun.ValidateAll() if the designated constraints aren't met.
+type WorkflowRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowRunMultiError) AllErrors() []error { return m }
+
+// WorkflowRunValidationError is the validation error returned by
+// WorkflowRun.Validate if the designated constraints aren't met.
+type WorkflowRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowRunValidationError) ErrorName() string { return "WorkflowRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkflowRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowRunValidationError{}
+
+// Validate checks the field values on CreateWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWorkflowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWorkflowRequestMultiError, or nil if none found.
+func (m *CreateWorkflowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWorkflowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkflow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWorkflowRequestValidationError{
+					field:  "Workflow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWorkflowRequestValidationError{
+					field:  "Workflow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWorkflowRequestValidationError{
+				field:  "Workflow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateWorkflowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWorkflowRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWorkflowRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWorkflowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWorkflowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWorkflowRequestMultiError) AllErrors() []error { return m }
+
+// CreateWorkflowRequestValidationError is the validation error returned by
+// CreateWorkflowRequest.Validate if the designated constraints aren't met.
+type CreateWorkflowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWorkflowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWorkflowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWorkflowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWorkflowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWorkflowRequestValidationError) ErrorName() string {
+	return "CreateWorkflowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWorkflowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWorkflowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWorkflowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWorkflowRequestValidationError{}
+
+// Validate checks the field values on CreateWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWorkflowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWorkflowResponseMultiError, or nil if none found.
+func (m *CreateWorkflowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWorkflowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return CreateWorkflowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWorkflowResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWorkflowResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWorkflowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWorkflowResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWorkflowResponseMultiError) AllErrors() []error { return m }
+
+// CreateWorkflowResponseValidationError is the validation error returned by
+// CreateWorkflowResponse.Validate if the designated constraints aren't met.
+type CreateWorkflowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWorkflowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWorkflowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWorkflowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWorkflowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWorkflowResponseValidationError) ErrorName() string {
+	return "CreateWorkflowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWorkflowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWorkflowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWorkflowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWorkflowResponseValidationError{}
+
+// Validate checks the field values on GetWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkflowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkflowRequestMultiError, or nil if none found.
+func (m *GetWorkflowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkflowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetWorkflowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkflowRequestMultiError is an error wrapping multiple validation errors
+// returned by GetWorkflowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetWorkflowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkflowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkflowRequestMultiError) AllErrors() []error { return m }
+
+// GetWorkflowRequestValidationError is the validation error returned by
+// GetWorkflowRequest.Validate if the designated constraints aren't met.
+type GetWorkflowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkflowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkflowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkflowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkflowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkflowRequestValidationError) ErrorName() string {
+	return "GetWorkflowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkflowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkflowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkflowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkflowRequestValidationError{}
+
+// Validate checks the field values on GetWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkflowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkflowResponseMultiError, or nil if none found.
+func (m *GetWorkflowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkflowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkflow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWorkflowResponseValidationError{
+					field:  "Workflow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWorkflowResponseValidationError{
+					field:  "Workflow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWorkflowResponseValidationError{
+				field:  "Workflow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetWorkflowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkflowResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWorkflowResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkflowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkflowResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkflowResponseMultiError) AllErrors() []error { return m }
+
+// GetWorkflowResponseValidationError is the validation error returned by
+// GetWorkflowResponse.Validate if the designated constraints aren't met.
+type GetWorkflowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkflowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkflowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkflowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkflowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkflowResponseValidationError) ErrorName() string {
+	return "GetWorkflowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkflowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkflowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkflowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkflowResponseValidationError{}
+
+// Validate checks the field values on StartWorkflowRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartWorkflowRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartWorkflowRunRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartWorkflowRunRequestMultiError, or nil if none found.
+func (m *StartWorkflowRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartWorkflowRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkflowId
+
+	if len(errors) > 0 {
+		return StartWorkflowRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartWorkflowRunRequestMultiError is an error wrapping multiple validation
+// errors returned by StartWorkflowRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartWorkflowRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartWorkflowRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartWorkflowRunRequestMultiError) AllErrors() []error { return m }
+
+// StartWorkflowRunRequestValidationError is the validation error returned by
+// StartWorkflowRunRequest.Validate if the designated constraints aren't met.
+type StartWorkflowRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartWorkflowRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartWorkflowRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartWorkflowRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartWorkflowRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartWorkflowRunRequestValidationError) ErrorName() string {
+	return "StartWorkflowRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartWorkflowRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartWorkflowRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartWorkflowRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartWorkflowRunRequestValidationError{}
+
+// Validate checks the field values on StartWorkflowRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartWorkflowRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartWorkflowRunResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartWorkflowRunResponseMultiError, or nil if none found.
+func (m *StartWorkflowRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartWorkflowRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartWorkflowRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartWorkflowRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartWorkflowRunResponseValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartWorkflowRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartWorkflowRunResponseMultiError is an error wrapping multiple validation
+// errors returned by StartWorkflowRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartWorkflowRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartWorkflowRunResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartWorkflowRunResponseMultiError) AllErrors() []error { return m }
+
+// StartWorkflowRunResponseValidationError is the validation error returned by
+// StartWorkflowRunResponse.Validate if the designated constraints aren't met.
+type StartWorkflowRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartWorkflowRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartWorkflowRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartWorkflowRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartWorkflowRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartWorkflowRunResponseValidationError) ErrorName() string {
+	return "StartWorkflowRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartWorkflowRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartWorkflowRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartWorkflowRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartWorkflowRunResponseValidationError{}
+
+// Validate checks the field values on GetWorkflowRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkflowRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkflowRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkflowRunRequestMultiError, or nil if none found.
+func (m *GetWorkflowRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkflowRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetWorkflowRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkflowRunRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWorkflowRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkflowRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkflowRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkflowRunRequestMultiError) AllErrors() []error { return m }
+
+// GetWorkflowRunRequestValidationError is the validation error returned by
+// GetWorkflowRunRequest.Validate if the designated constraints aren't met.
+type GetWorkflowRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkflowRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkflowRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkflowRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkflowRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkflowRunRequestValidationError) ErrorName() string {
+	return "GetWorkflowRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkflowRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkflowRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkflowRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkflowRunRequestValidationError{}
+
+// Validate checks the field values on GetWorkflowRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkflowRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkflowRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkflowRunResponseMultiError, or nil if none found.
+func (m *GetWorkflowRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkflowRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWorkflowRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWorkflowRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWorkflowRunResponseValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetWorkflowRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkflowRunResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWorkflowRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkflowRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkflowRunResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkflowRunResponseMultiError) AllErrors() []error { return m }
+
+// GetWorkflowRunResponseValidationError is the validation error returned by
+// GetWorkflowRunResponse.Validate if the designated constraints aren't met.
+type GetWorkflowRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkflowRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkflowRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkflowRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkflowRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkflowRunResponseValidationError) ErrorName() string {
+	return "GetWorkflowRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkflowRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkflowRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkflowRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkflowRunResponseValidationError{}
+
+// Validate checks the field values on CancelWorkflowRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelWorkflowRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelWorkflowRunRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelWorkflowRunRequestMultiError, or nil if none found.
+func (m *CancelWorkflowRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelWorkflowRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelWorkflowRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelWorkflowRunRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelWorkflowRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelWorkflowRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelWorkflowRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelWorkflowRunRequestMultiError) AllErrors() []error { return m }
+
+// CancelWorkflowRunRequestValidationError is the validation error returned by
+// CancelWorkflowRunRequest.Validate if the designated constraints aren't met.
+type CancelWorkflowRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelWorkflowRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelWorkflowRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelWorkflowRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelWorkflowRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelWorkflowRunRequestValidationError) ErrorName() string {
+	return "CancelWorkflowRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelWorkflowRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelWorkflowRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelWorkflowRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelWorkflowRunRequestValidationError{}
+
+// Validate checks the field values on CancelWorkflowRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelWorkflowRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelWorkflowRunResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelWorkflowRunResponseMultiError, or nil if none found.
+func (m *CancelWorkflowRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelWorkflowRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelWorkflowRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelWorkflowRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelWorkflowRunResponseValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelWorkflowRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelWorkflowRunResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelWorkflowRunResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CancelWorkflowRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelWorkflowRunResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelWorkflowRunResponseMultiError) AllErrors() []error { return m }
+
+// CancelWorkflowRunResponseValidationError is the validation error returned by
+// CancelWorkflowRunResponse.Validate if the designated constraints aren't met.
+type CancelWorkflowRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelWorkflowRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelWorkflowRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelWorkflowRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelWorkflowRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelWorkflowRunResponseValidationError) ErrorName() string {
+	return "CancelWorkflowRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelWorkflowRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelWorkflowRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelWorkflowRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelWorkflowRunResponseValidationError{}
@@ -3,6 +3,8 @@ package utils
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -14,6 +16,8 @@ import (
 const (
 	END_LIMIT_TIME = "end_limit_time"
 	BLOCK_COUNT    = "count"
+	BLOCK_PATH     = "path"
+	BLOCK_KEY      = "key"
 )
 
 var DEFAUL_RATELIMI_OPTION = &RatelimitOpt{
@@ -25,6 +29,39 @@ var DEFAUL_RATELIMI_OPTION = &RatelimitOpt{
 
 type IRateLimit interface {
 	Allow(ctx context.Context, path, key string, limits ...redisratev9.Limit) (pass bool, err error)
+	// Check is Allow with the state of the limits, a key over a limit is blocked with an escalating duration
+	Check(ctx context.Context, path, key string, limits ...redisratev9.Limit) (*Decision, error)
+	// Consume takes one request from every limit without blocking the key when a limit is hit.
+	// It returns the result of the exhausted limit, or of the limit with the fewest requests left.
+	Consume(ctx context.Context, path, key string, limits ...redisratev9.Limit) (*redisratev9.Result, error)
+	// ListBlocks returns the blocked keys, including the blocks which are over but still counted
+	ListBlocks(ctx context.Context) ([]*Block, error)
+	// GetBlock is nil when the key has no block
+	GetBlock(ctx context.Context, path, key string) (*Block, error)
+	// Unblock removes the block and its count and refills the limits of the key
+	Unblock(ctx context.Context, path, key string) (bool, error)
+}
+
+// Block of a key, the next block of the key lasts CalculateBlockDuration(Count + 1)
+type Block struct {
+	Path    string
+	Key     string
+	Count   int
+	EndTime time.Time
+}
+
+func (_self *Block) IsActive() bool {
+	return time.Now().Before(_self.EndTime)
+}
+
+// Decision is the outcome of a request, the limit fields describe the limit with the fewest requests left
+type Decision struct {
+	Allowed    bool
+	Limit      redisratev9.Limit
+	Remaining  int
+	ResetAfter time.Duration
+	// RetryAfter is the wait before the next allowed request, it is 0 for the allowed requests
+	RetryAfter time.Duration
 }
 
 type RatelimitOpt struct {
@@ -93,59 +130,67 @@ func LimitCustom(rate, burst int, period time.Duration) redisratev9.Limit {
 }
 
 func (_self *RateLimit) Allow(ctx context.Context, path, key string, limits ...redisratev9.Limit) (pass bool, err error) {
-	ctx = logging.AppendPrefix(ctx, "Allow")
-	logging.Infof(ctx, "allow")
+	decision, err := _self.Check(ctx, path, key, limits...)
+	if err != nil {
+		return false, err
+	}
+	return decision.Allowed, nil
+}
+
+func (_self *RateLimit) Check(ctx context.Context, path, key string, limits ...redisratev9.Limit) (*Decision, error) {
+	ctx = logging.AppendPrefix(ctx, "Check")
 	if len(limits) == 0 {
-		return true, nil
+		return &Decision{Allowed: true}, nil
 	}
 	// check is the request blocked
 	redisKey := buildBlockedRedisKey(path, key)
-	isBlocked, err := _self.isBlocked(ctx, redisKey)
+	endTime, err := _self.getBlockEndTime(ctx, redisKey)
 	if err != nil {
-		return false, fmt.Errorf("request is blocked: %w", err)
+		return nil, fmt.Errorf("failed to get block of %s: %w", redisKey, err)
 	}
-	if isBlocked {
-		return false, nil
+	if wait := time.Until(endTime); wait > 0 {
+		return &Decision{Limit: limits[0], ResetAfter: wait, RetryAfter: wait}, nil
 	}
 
 	// rate limit execute
-	isLimited, err := _self.rateLimit(ctx, path, key, limits...)
+	result, err := _self.Consume(ctx, path, key, limits...)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	decision := &Decision{
+		Allowed:    result.Allowed > 0,
+		Limit:      result.Limit,
+		Remaining:  result.Remaining,
+		ResetAfter: result.ResetAfter,
 	}
-	if isLimited {
+	if !decision.Allowed {
 		// block if reach limit
-		if err := _self.block(ctx, path, key); err != nil {
-			return false, err
+		duration, err := _self.block(ctx, path, key)
+		if err != nil {
+			return nil, err
 		}
-		return false, nil // <- Not allowed, rate limit hit!
+		logging.Infof(ctx, "%s is blocked for %s", redisKey, duration)
+		decision.RetryAfter = max(duration, result.RetryAfter)
+		decision.ResetAfter = max(decision.RetryAfter, result.ResetAfter)
 	}
-	return true, nil
+	return decision, nil
 }
 
-func (_self *RateLimit) rateLimit(ctx context.Context, path, key string, limits ...redisratev9.Limit) (bool, error) {
+func (_self *RateLimit) Consume(ctx context.Context, path, key string, limits ...redisratev9.Limit) (*redisratev9.Result, error) {
+	var lowest *redisratev9.Result
 	for _, limit := range limits {
-		keyLimit := buildLimitRedisKey(path, key, limit)
-		result, err := _self.limiter.Allow(ctx, keyLimit, limit)
+		result, err := _self.limiter.Allow(ctx, buildLimitRedisKey(path, key, limit), limit)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 		if result.Allowed == 0 {
-			return true, nil
+			return result, nil
+		}
+		if lowest == nil || result.Remaining < lowest.Remaining {
+			lowest = result
 		}
 	}
-	return false, nil
-}
-
-func (_self *RateLimit) isBlocked(ctx context.Context, key string) (bool, error) {
-	endTime, err := _self.getBlockEndTime(ctx, key)
-	if err != nil {
-		return false, err
-	}
-	if endTime.IsZero() || time.Now().After(endTime) {
-		return false, nil
-	}
-	return true, nil
+	return lowest, nil
 }
 
 func buildBlockedRedisKey(path, key string) string {
@@ -156,13 +201,22 @@ func buildLimitRedisKey(path, key string, limit redisratev9.Limit) string {
 	return fmt.Sprintf("ratelimit.%s.%s.%d", path, key, limit.Rate)
 }
 
+// buildLimitRedisPattern matches the limits of every rate of the key, redis_rate prefixes them with "rate:"
+func buildLimitRedisPattern(path, key string) string {
+	escape := strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+	return "rate:" + escape.Replace(fmt.Sprintf("ratelimit.%s.%s.", path, key)) + "*"
+}
+
 func (_self *RateLimit) getBlockEndTime(ctx context.Context, key string) (time.Time, error) {
-	endTimeStr, err := _self.redisClient.HGet(ctx, key, BLOCK_COUNT).Result()
+	values, err := _self.redisClient.HMGet(ctx, key, END_LIMIT_TIME, BLOCK_COUNT).Result()
 	if err != nil && err != redis.Nil {
 		return time.Time{}, err
 	}
+	endTimeStr, _ := values[0].(string)
 	if endTimeStr == "" {
-		return time.Time{}, nil
+		count, _ := values[1].(string)
+		endTime, _ := legacyBlockEndTime(count)
+		return endTime, nil
 	}
 	endTime, err := time.Parse(time.RFC3339Nano, endTimeStr)
 	if err != nil {
@@ -171,29 +225,130 @@ func (_self *RateLimit) getBlockEndTime(ctx context.Context, key string) (time.T
 	return endTime, nil
 }
 
-func (_self *RateLimit) setBlockEndTime(ctx context.Context, key string, endTime time.Time) error {
-	return _self.redisClient.HSet(ctx, key, BLOCK_COUNT, endTime.Format(time.RFC3339Nano)).Err()
-}
-
-func (_self *RateLimit) block(ctx context.Context, path, key string) error {
+// block returns the duration of the new block of the key
+func (_self *RateLimit) block(ctx context.Context, path, key string) (time.Duration, error) {
 	blockedKey := buildBlockedRedisKey(path, key)
 	// increase block counter
 	count, err := _self.redisClient.HIncrBy(ctx, blockedKey, BLOCK_COUNT, 1).Result()
 	if err != nil {
-		return err
+		migrated, migrateErr := _self.migrateLegacyBlock(ctx, blockedKey)
+		if migrateErr != nil || !migrated {
+			return 0, err
+		}
+		if count, err = _self.redisClient.HIncrBy(ctx, blockedKey, BLOCK_COUNT, 1).Result(); err != nil {
+			return 0, err
+		}
 	}
 	// calculate new end time
-	newEndTime := _self.opts.CalculateBlockDuration(int(count))
-	// set block end time
-	err = _self.setBlockEndTime(ctx, blockedKey, time.Now().Add(newEndTime))
+	duration := _self.opts.CalculateBlockDuration(int(count))
+	// the end time has its own field, path and key are kept because both may contain dots
+	err = _self.redisClient.HSet(ctx, blockedKey,
+		END_LIMIT_TIME, time.Now().Add(duration).Format(time.RFC3339Nano),
+		BLOCK_PATH, path,
+		BLOCK_KEY, key,
+	).Err()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	// reset retention
-	_self.ResetRetention(ctx, blockedKey)
-	return nil
+	// the count is kept for the retention after the end of the block
+	return duration, _self.redisClient.Expire(ctx, blockedKey, duration+_self.opts.BlockRetention).Err()
+}
+
+// migrateLegacyBlock sets the count of a block written before the end time had its own field, its count
+// field holds the end time and is read as one block. It returns false when the count is already a number.
+func (_self *RateLimit) migrateLegacyBlock(ctx context.Context, blockedKey string) (bool, error) {
+	count, err := _self.redisClient.HGet(ctx, blockedKey, BLOCK_COUNT).Result()
+	if err != nil {
+		return false, err
+	}
+	if _, err := strconv.Atoi(count); err == nil {
+		return false, nil
+	}
+	return true, _self.redisClient.HSet(ctx, blockedKey, BLOCK_COUNT, 1).Err()
+}
+
+// legacyBlockEndTime reads the end time kept in the count field by the blocks written before the end time had its own field
+func legacyBlockEndTime(count string) (time.Time, bool) {
+	endTime, err := time.Parse(time.RFC3339Nano, count)
+	return endTime, err == nil
 }
 
 func (_self *RateLimit) ResetRetention(ctx context.Context, blockedKey string) error {
 	return _self.redisClient.Expire(ctx, blockedKey, _self.opts.BlockRetention).Err()
 }
+
+func (_self *RateLimit) GetBlock(ctx context.Context, path, key string) (*Block, error) {
+	values, err := _self.redisClient.HGetAll(ctx, buildBlockedRedisKey(path, key)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return parseBlock(path, key, values)
+}
+
+func (_self *RateLimit) ListBlocks(ctx context.Context) ([]*Block, error) {
+	var blocks []*Block
+	iter := _self.redisClient.Scan(ctx, 0, "blocked.*", 100).Iterator()
+	for iter.Next(ctx) {
+		values, err := _self.redisClient.HGetAll(ctx, iter.Val()).Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(values) == 0 {
+			// expired since the scan
+			continue
+		}
+		path, key := values[BLOCK_PATH], values[BLOCK_KEY]
+		if path == "" {
+			// blocks written before the path was stored, the first dot splits them
+			path, key, _ = strings.Cut(strings.TrimPrefix(iter.Val(), "blocked."), ".")
+		}
+		block, err := parseBlock(path, key, values)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+func (_self *RateLimit) Unblock(ctx context.Context, path, key string) (bool, error) {
+	deleted, err := _self.redisClient.Del(ctx, buildBlockedRedisKey(path, key)).Result()
+	if err != nil {
+		return false, err
+	}
+	// refill the limits of the key, otherwise its next request is blocked again
+	iter := _self.redisClient.Scan(ctx, 0, buildLimitRedisPattern(path, key), 100).Iterator()
+	for iter.Next(ctx) {
+		if err := _self.redisClient.Del(ctx, iter.Val()).Err(); err != nil {
+			return false, err
+		}
+	}
+	return deleted > 0, iter.Err()
+}
+
+func parseBlock(path, key string, values map[string]string) (*Block, error) {
+	block := &Block{Path: path, Key: key}
+	if count := values[BLOCK_COUNT]; count != "" {
+		parsed, err := strconv.Atoi(count)
+		if err != nil {
+			// a block written before the end time had its own field, it counts as one block
+			parsed = 1
+			block.EndTime, _ = legacyBlockEndTime(count)
+		}
+		block.Count = parsed
+	}
+	if endTime := values[END_LIMIT_TIME]; endTime != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("block %s.%s has invalid end time %q", path, key, endTime)
+		}
+		block.EndTime = parsed
+	}
+	return block, nil
+}
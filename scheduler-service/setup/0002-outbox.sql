@@ -0,0 +1,17 @@
+create table if not exists scheduler_outbox (
+    id bigserial PRIMARY KEY,
+    event_id int8 NOT NULL,
+    topic varchar(255) NOT NULL,
+    "key" varchar(255) NULL,
+    payload text NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'pending', -- pending, sent, failed
+    attempts int4 NOT NULL DEFAULT 0,
+    last_error text NULL,
+    next_attempt_at timestamptz NOT NULL DEFAULT current_timestamp,
+    sent_at timestamptz NULL,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+-- relay scans due pending messages
+create index if not exists idx_scheduler_outbox_pending on scheduler_outbox (next_attempt_at, id) where status = 'pending';
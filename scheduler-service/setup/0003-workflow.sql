@@ -0,0 +1,48 @@
+create table if not exists workflows (
+    id bigserial PRIMARY KEY,
+    name varchar(255) NOT NULL,
+    description text NULL,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+create table if not exists workflow_steps (
+    id bigserial PRIMARY KEY,
+    workflow_id int8 NOT NULL,
+    event_id int8 NOT NULL,
+    depends_on varchar(1024) NOT NULL DEFAULT '', -- comma separated event ids
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp,
+    UNIQUE (workflow_id, event_id)
+);
+
+create table if not exists workflow_runs (
+    id bigserial PRIMARY KEY,
+    workflow_id int8 NOT NULL,
+    status varchar(20) NOT NULL, -- running, successed, failed, cancelled
+    finished_at timestamptz NULL,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+create table if not exists event_runs (
+    id bigserial PRIMARY KEY,
+    run_id varchar(64) NOT NULL UNIQUE,
+    event_id int8 NOT NULL,
+    workflow_run_id int8 NOT NULL DEFAULT 0,
+    "trigger" varchar(20) NOT NULL, -- cron, workflow
+    status varchar(20) NOT NULL,
+    scheduled_at int8 NOT NULL DEFAULT 0,
+    depends_on varchar(1024) NOT NULL DEFAULT '',
+    error text NULL,
+    started_at timestamptz NULL,
+    finished_at timestamptz NULL,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+create index if not exists idx_event_runs_event_id on event_runs (event_id, id);
+create index if not exists idx_event_runs_workflow_run_id on event_runs (workflow_run_id) where workflow_run_id > 0;
+
+alter table scheduler_outbox add column if not exists run_id varchar(64) NULL;
+create index if not exists idx_scheduler_outbox_run_id on scheduler_outbox (run_id);
@@ -0,0 +1,32 @@
+-- keyset pagination compares (column, id), the sort columns must not be null
+update scheduler_events set created_at = current_timestamp where created_at is null;
+update scheduler_events set updated_at = created_at where updated_at is null;
+update scheduler_events set scheduler_at = 0 where scheduler_at is null;
+update scheduler_events set "domain" = '' where "domain" is null;
+update scheduler_events set queue = '' where queue is null;
+alter table scheduler_events
+    alter column created_at set default current_timestamp,
+    alter column created_at set not null,
+    alter column updated_at set default current_timestamp,
+    alter column updated_at set not null,
+    alter column scheduler_at set default 0,
+    alter column scheduler_at set not null,
+    alter column "domain" set default '',
+    alter column "domain" set not null,
+    alter column queue set default '',
+    alter column queue set not null;
+
+-- filters
+create index if not exists idx_scheduler_events_domain_queue on scheduler_events ("domain", queue);
+create index if not exists idx_scheduler_events_status on scheduler_events (status);
+
+-- order by <column>, id
+create index if not exists idx_scheduler_events_created_at on scheduler_events (created_at, id);
+create index if not exists idx_scheduler_events_updated_at on scheduler_events (updated_at, id);
+create index if not exists idx_scheduler_events_scheduler_at on scheduler_events (scheduler_at, id);
+create index if not exists idx_scheduler_events_domain_id on scheduler_events ("domain", id);
+create index if not exists idx_scheduler_events_queue_id on scheduler_events (queue, id);
+
+-- description search with ILIKE '%...%'
+create extension if not exists pg_trgm;
+create index if not exists idx_scheduler_events_description_trgm on scheduler_events using gin (description gin_trgm_ops);
@@ -0,0 +1,3 @@
+-- soft deleted events keep deleted_at, every query of the service filters on it
+alter table scheduler_events alter column deleted_at type timestamptz;
+create index if not exists idx_scheduler_events_deleted_at on scheduler_events (deleted_at);
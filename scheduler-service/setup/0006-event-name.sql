@@ -0,0 +1,3 @@
+-- stable key of events synced from manifests
+alter table scheduler_events add column if not exists "name" varchar(255) NOT NULL DEFAULT '';
+create unique index if not exists idx_scheduler_events_name on scheduler_events ("name") where "name" <> '' and deleted_at is null;
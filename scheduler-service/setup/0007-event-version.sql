@@ -0,0 +1,2 @@
+-- optimistic concurrency of the scheduler events
+alter table scheduler_events add column if not exists "version" bigint NOT NULL DEFAULT 1;
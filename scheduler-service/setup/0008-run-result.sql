@@ -0,0 +1,3 @@
+-- result of a run reported by the crawler
+alter table event_runs add column if not exists duration_ms bigint NOT NULL DEFAULT 0;
+alter table event_runs add column if not exists result_id varchar(64) NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+-- team owning the event, the API scopes viewers and editors to their team
+alter table scheduler_events add column if not exists team varchar(64) NOT NULL DEFAULT '';
+create index if not exists idx_scheduler_events_team on scheduler_events (team, id);
@@ -0,0 +1,12 @@
+-- quotas of the teams sharing the scheduler, a zero maximum is unlimited
+create table if not exists tenants (
+    id bigserial PRIMARY KEY,
+    "name" varchar(64) NOT NULL UNIQUE, -- team of the events
+    max_active_events int8 NOT NULL DEFAULT 0,
+    max_crawls_per_hour int8 NOT NULL DEFAULT 0,
+    max_queue_priority int4 NOT NULL DEFAULT 1, -- 0 normal, 1 priority
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+create index if not exists idx_scheduler_events_team_active on scheduler_events (team) where is_active = true and deleted_at is null;
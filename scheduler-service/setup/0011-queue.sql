@@ -0,0 +1,17 @@
+-- queues of the crawler workers, the workers reload them so a queue is added without a redeploy
+create table if not exists queues (
+    id bigserial PRIMARY KEY,
+    "name" varchar(64) NOT NULL UNIQUE, -- queue of the events
+    topic varchar(255) NOT NULL, -- kafka topic of the queue
+    weight int4 NOT NULL DEFAULT 1, -- share of the workers, above 1 is a priority queue for the tenant quotas
+    rate_limit int4 NOT NULL DEFAULT 10, -- messages read per second
+    max_concurrency int4 NOT NULL DEFAULT 1, -- crawls of the queue running at once on a worker
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+-- the queues which were configured on the services before
+insert into queues ("name", topic, weight, rate_limit, max_concurrency) values
+    ('normal', 'normal', 1, 10, 5),
+    ('priority', 'priority', 4, 10, 10)
+on conflict ("name") do nothing;
@@ -0,0 +1,29 @@
+-- messages given up by the crawler workers, mirrored from the dead-letter topic for inspection and replay
+create table if not exists dead_letters (
+    id bigserial PRIMARY KEY,
+    queue varchar(64) NOT NULL DEFAULT '', -- queue of the message
+    event_id int8 NOT NULL DEFAULT 0, -- event of the payload, 0 when the payload does not read
+    run_id varchar(255) NOT NULL DEFAULT '', -- run of the payload
+    topic varchar(255) NOT NULL DEFAULT '', -- topic of the queue, empty for the failed retries
+    "partition" int4 NOT NULL DEFAULT 0,
+    "offset" int8 NOT NULL DEFAULT 0,
+    "key" varchar(255) NOT NULL DEFAULT '',
+    payload text NOT NULL DEFAULT '',
+    error text NOT NULL DEFAULT '', -- reason of the failure
+    attempts int8 NOT NULL DEFAULT 0, -- crawls of the message before it was given up
+    status varchar(16) NOT NULL DEFAULT 'pending', -- pending, replayed or discarded
+    replays int4 NOT NULL DEFAULT 0,
+    dead_letter_partition int4 NOT NULL, -- position in the dead-letter topic
+    dead_letter_offset int8 NOT NULL,
+    failed_at timestamptz NOT NULL,
+    replayed_at timestamptz,
+    discarded_at timestamptz,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+-- a message redelivered by the dead-letter topic is stored once
+create unique index if not exists dead_letters_position_idx on dead_letters (dead_letter_partition, dead_letter_offset);
+
+create index if not exists dead_letters_status_queue_idx on dead_letters (status, queue, id);
+create index if not exists dead_letters_event_id_idx on dead_letters (event_id);
@@ -0,0 +1,2 @@
+-- retry policy of the failed crawls as JSON, null takes the defaults of the crawler
+alter table scheduler_events add column if not exists retry_policy text NULL;
@@ -0,0 +1,11 @@
+-- the payload of a dead letter is the message as read from the topic, it may not be text
+do $$
+begin
+    if (select data_type from information_schema.columns
+        where table_name = 'dead_letters' and column_name = 'payload') = 'text' then
+        alter table dead_letters
+            alter column payload drop default,
+            alter column payload type bytea using convert_to(payload, 'UTF8'),
+            alter column payload set default ''::bytea;
+    end if;
+end $$;
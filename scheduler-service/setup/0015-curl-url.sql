@@ -0,0 +1,4 @@
+-- the curl commands give their targets with --url, the crawler refuses the positional URLs of the older commands
+update scheduler_events
+set url = regexp_replace(url, '^curl (-L|--location) ''', 'curl \1 --url ''')
+where "method" = 'CURL' and url ~ '^curl (-L|--location) ''';
@@ -0,0 +1,14 @@
+-- team owning the workflow and its runs, the API scopes viewers and editors to their team like the events
+alter table workflows add column if not exists team varchar(64) NOT NULL DEFAULT '';
+alter table workflow_runs add column if not exists team varchar(64) NOT NULL DEFAULT '';
+
+-- the workflows created before take the team of their events
+update workflows w set team = e.team
+from workflow_steps s join scheduler_events e on e.id = s.event_id
+where s.workflow_id = w.id and w.team = '' and e.team <> '';
+update workflow_runs r set team = w.team
+from workflows w
+where w.id = r.workflow_id and r.team = '' and w.team <> '';
+
+create index if not exists idx_workflows_team on workflows (team, id);
+create index if not exists idx_workflow_runs_team on workflow_runs (team, id);
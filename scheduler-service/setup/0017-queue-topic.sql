@@ -0,0 +1,3 @@
+-- a topic belongs to one queue: the workers weight and rate limit a topic as one queue, and the
+-- dead-letter topic is mirrored into dead_letters instead of crawled
+create unique index if not exists queues_topic_idx on queues (topic);